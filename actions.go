@@ -5,57 +5,76 @@ import "github.com/redghc/t8go/helpers"
 // DrawPixel sets a pixel at the specified coordinates (x, y) in the display buffer.
 // This is the most basic drawing primitive - a single point on the display.
 func (t *T8Go) DrawPixel(x, y int16) {
-	t.SetPixel(x, y, true)
+	if t.traced() {
+		t.tracer("DrawPixel", x, y)
+	}
+
+	if t.transformed {
+		x, y = t.transform.Apply(x, y)
+	}
+
+	t.plot(int32(x), int32(y))
 }
 
 // DrawLine draws a line between two points (startX, startY) and (endX, endY)
 // using Bresenham's line algorithm for optimal pixel-perfect rendering.
 // Both origin and destination pixels are included in the line.
 func (t *T8Go) DrawLine(startX, startY, endX, endY int16) {
+	if t.traced() {
+		t.tracer("DrawLine", startX, startY, endX, endY)
+	}
+	t.drawLine(startX, startY, endX, endY)
+}
+
+// drawLine draws DrawLine without reporting it to the tracer.
+func (t *T8Go) drawLine(startX, startY, endX, endY int16) {
+	if t.transformed {
+		t.transformedLine(startX, startY, endX, endY)
+		return
+	}
+
 	// Fast paths: vertical and horizontal lines
 	if startX == endX {
-		startYPos, endYPos := startY, endY
-		if startYPos > endYPos {
-			startYPos, endYPos = endYPos, startYPos
-		}
-		t.DrawVLine(startX, startYPos, endYPos-startYPos+1)
+		t.vspan(int32(startX), int32(startY), int32(endY))
 		return
 	}
 
 	if startY == endY {
-		startXPos, endXPos := startX, endX
-		if startXPos > endXPos {
-			startXPos, endXPos = endXPos, startXPos
-		}
-		t.DrawHLine(startXPos, startY, endXPos-startXPos+1)
+		t.hspan(int32(startX), int32(endX), int32(startY))
+		return
+	}
+
+	// Work in int32: spans between distant int16 coordinates overflow int16.
+	x0, y0, x1, y1 := int32(startX), int32(startY), int32(endX), int32(endY)
+	if !t.visible32(min(x0, x1), min(y0, y1), max(x0, x1), max(y0, y1)) {
 		return
 	}
 
 	// Determine if the line is steep (more vertical than horizontal).
-	isSteep := helpers.AbsDiff(endY, startY) > helpers.AbsDiff(endX, startX)
+	isSteep := helpers.Abs(y1-y0) > helpers.Abs(x1-x0)
 	if isSteep {
-		startX, startY = startY, startX
-		endX, endY = endY, endX
+		x0, y0 = y0, x0
+		x1, y1 = y1, x1
 	}
 
 	// Ensure left-to-right progression.
-	if startX > endX {
-		startX, endX = endX, startX
-		startY, endY = endY, startY
+	if x0 > x1 {
+		x0, x1 = x1, x0
+		y0, y1 = y1, y0
 	}
 
-	deltaX := endX - startX
-	deltaY := helpers.Abs(endY - startY)
-	stepDirectionY := helpers.Direction(endY - startY)
+	deltaX := x1 - x0
+	deltaY := helpers.Abs(y1 - y0)
+	stepDirectionY := int32(helpers.Direction(y1 - y0))
 
 	errorAccumulator := deltaX / 2
-	currentYPos := startY
+	currentYPos := y0
 
-	for currentXPos := startX; currentXPos <= endX; currentXPos++ {
+	for currentXPos := x0; currentXPos <= x1; currentXPos++ {
 		if isSteep {
-			t.SetPixel(currentYPos, currentXPos, true)
+			t.plot(currentYPos, currentXPos)
 		} else {
-			t.SetPixel(currentXPos, currentYPos, true)
+			t.plot(currentXPos, currentYPos)
 		}
 
 		errorAccumulator -= deltaY
@@ -70,41 +89,209 @@ func (t *T8Go) DrawLine(startX, startY, endX, endY int16) {
 // The length parameter specifies the number of pixels to draw, including the origin pixel.
 // Supports negative length values (draws upward). No operation is performed if length is zero.
 func (t *T8Go) DrawVLine(originX, originY, length int16) {
+	if t.traced() {
+		t.tracer("DrawVLine", originX, originY, length)
+	}
+
 	direction := helpers.Direction(length)
 	if direction == 0 {
 		return
 	}
-
-	uLength := helpers.Abs(length)
-	for deltaY := range uLength {
-		t.SetPixel(originX, originY+deltaY*direction, true)
+	endY := int32(originY) + int32(length) - int32(direction)
+	if t.transformed {
+		t.drawLine(originX, originY, originX, helpers.ClampInt16(endY))
+		return
 	}
+	t.vspan(int32(originX), int32(originY), endY)
 }
 
 // DrawHLine draws a horizontal line starting at (originX, originY) with the specified length.
 // The length parameter specifies the number of pixels to draw, including the origin pixel.
 // Supports negative length values (draws to the left). No operation is performed if length is zero.
 func (t *T8Go) DrawHLine(originX, originY, length int16) {
+	if t.traced() {
+		t.tracer("DrawHLine", originX, originY, length)
+	}
+
 	direction := helpers.Direction(length)
 	if direction == 0 {
 		return
 	}
+	endX := int32(originX) + int32(length) - int32(direction)
+	if t.transformed {
+		t.drawLine(originX, originY, helpers.ClampInt16(endX), originY)
+		return
+	}
+	t.hspan(int32(originX), endX, int32(originY))
+}
+
+// vspan draws, in the current draw mode, the vertical run from (x, startY) to (x, endY), inclusive and in any order.
+// Coordinates are int32 so callers can pass unclipped sums without wrapping around;
+// the run is clipped to the visible area before rasterizing.
+func (t *T8Go) vspan(x, startY, endY int32) {
+	minX, minY, maxX, maxY := t.bounds()
+	if x < int32(minX) || x > int32(maxX) {
+		return
+	}
+	if startY > endY {
+		startY, endY = endY, startY
+	}
+	startY, endY = max(startY, int32(minY)), min(endY, int32(maxY))
+	if startY > endY {
+		return
+	}
+	if t.clipped() {
+		var ok bool
+		if startY, endY, ok = t.clipColumn(x, startY, endY); !ok {
+			return
+		}
+	}
+
+	if on, uniform := t.ink(); uniform && t.spans != nil && !t.filtered() && !t.tinted() {
+		t.countWrites(x, startY, 1, endY-startY+1)
+		t.spans.FillRect(int16(x), int16(startY), 1, int16(endY-startY+1), on)
+		return
+	}
+
+	for y := startY; y <= endY; y++ {
+		t.paint(int16(x), int16(y))
+	}
+}
+
+// hspan draws, in the current draw mode, the horizontal run from (startX, y) to (endX, y), inclusive and in any order.
+// Like vspan, it takes int32 coordinates and clips before rasterizing.
+func (t *T8Go) hspan(startX, endX, y int32) {
+	minX, minY, maxX, maxY := t.bounds()
+	if y < int32(minY) || y > int32(maxY) {
+		return
+	}
+	if startX > endX {
+		startX, endX = endX, startX
+	}
+	startX, endX = max(startX, int32(minX)), min(endX, int32(maxX))
+	if startX > endX {
+		return
+	}
+	if t.clipped() {
+		var ok bool
+		if startX, endX, ok = t.clipRow(y, startX, endX); !ok {
+			return
+		}
+	}
+
+	if on, uniform := t.ink(); uniform && t.spans != nil && !t.filtered() && !t.tinted() {
+		t.countWrites(startX, y, endX-startX+1, 1)
+		t.spans.DrawHSpan(int16(startX), int16(y), int16(endX-startX+1), on)
+		return
+	}
+
+	for x := startX; x <= endX; x++ {
+		t.paint(int16(x), int16(y))
+	}
+}
+
+// fillRect fills the inclusive rectangle (minX, minY)-(maxX, maxY) given in int32
+// coordinates in the current draw mode, clipped to the visible area.
+func (t *T8Go) fillRect(minX, minY, maxX, maxY int32) {
+	boundsMinX, boundsMinY, boundsMaxX, boundsMaxY := t.bounds()
+	minX, maxX = max(minX, int32(boundsMinX)), min(maxX, int32(boundsMaxX))
+	minY, maxY = max(minY, int32(boundsMinY)), min(maxY, int32(boundsMaxY))
+	if minX > maxX || minY > maxY {
+		return
+	}
+
+	if on, uniform := t.ink(); uniform && t.spans != nil && !t.filtered() && !t.tinted() && !t.clipped() {
+		t.countWrites(minX, minY, maxX-minX+1, maxY-minY+1)
+		t.spans.FillRect(int16(minX), int16(minY), int16(maxX-minX+1), int16(maxY-minY+1), on)
+		return
+	}
+
+	for y := minY; y <= maxY; y++ {
+		t.hspan(minX, maxX, y)
+	}
+}
+
+// writeRect sets the inclusive rectangle (minX, minY)-(maxX, maxY) given in
+// int32 coordinates to on, ignoring the draw mode and color, clipped to the
+// visible area.
+func (t *T8Go) writeRect(minX, minY, maxX, maxY int32, on bool) {
+	boundsMinX, boundsMinY, boundsMaxX, boundsMaxY := t.bounds()
+	minX, maxX = max(minX, int32(boundsMinX)), min(maxX, int32(boundsMaxX))
+	minY, maxY = max(minY, int32(boundsMinY)), min(maxY, int32(boundsMaxY))
+	if minX > maxX || minY > maxY {
+		return
+	}
+	t.countWrites(minX, minY, maxX-minX+1, maxY-minY+1)
+
+	fast := t.spans != nil && !t.filtered()
+	if fast && !t.clipped() {
+		t.spans.FillRect(int16(minX), int16(minY), int16(maxX-minX+1), int16(maxY-minY+1), on)
+		return
+	}
 
-	uLength := helpers.Abs(length)
-	for deltaX := range uLength {
-		t.SetPixel(originX+deltaX*direction, originY, true)
+	for y := minY; y <= maxY; y++ {
+		startX, endX, ok := minX, maxX, true
+		if t.clipped() {
+			startX, endX, ok = t.clipRow(y, startX, endX)
+		}
+		switch {
+		case !ok:
+		case fast:
+			t.spans.FillRect(int16(startX), int16(y), int16(endX-startX+1), 1, on)
+		default:
+			for x := startX; x <= endX; x++ {
+				t.writePixel(int16(x), int16(y), on)
+			}
+		}
 	}
 }
 
+// plot draws the pixel at (x, y) given in int32 coordinates in the current
+// draw mode; pixels outside the visible area, including those beyond the
+// int16 range, are skipped.
+func (t *T8Go) plot(x, y int32) {
+	minX, minY, maxX, maxY := t.bounds()
+	if x < int32(minX) || x > int32(maxX) || y < int32(minY) || y > int32(maxY) {
+		return
+	}
+	t.paint(int16(x), int16(y))
+}
+
+// boxBounds returns the inclusive, normalized bounds of the box that starts at
+// (originX, originY) and extends |width| x |height| pixels in the direction of
+// the signs. The bounds are int32, so sizes near the int16 limits cannot wrap.
+// ok is false when width or height is zero.
+func boxBounds(originX, originY, width, height int16) (minX, minY, maxX, maxY int32, ok bool) {
+	if width == 0 || height == 0 {
+		return 0, 0, 0, 0, false
+	}
+
+	farX := int32(originX) + (helpers.Abs(int32(width))-1)*int32(helpers.Direction(width))
+	farY := int32(originY) + (helpers.Abs(int32(height))-1)*int32(helpers.Direction(height))
+	return min(int32(originX), farX), min(int32(originY), farY),
+		max(int32(originX), farX), max(int32(originY), farY), true
+}
+
+// visible32 is visible for int32 inclusive bounds in normalized order.
+func (t *T8Go) visible32(minX, minY, maxX, maxY int32) bool {
+	boundsMinX, boundsMinY, boundsMaxX, boundsMaxY := t.bounds()
+	return maxX >= int32(boundsMinX) && minX <= int32(boundsMaxX) &&
+		maxY >= int32(boundsMinY) && minY <= int32(boundsMaxY)
+}
+
 // DrawLineAngle draws a line from (originX, originY) with the specified length and angle.
 // The angle is specified in units of 0-255, where 64=90°, 128=180°, 192=270°.
 // The length includes the origin pixel. Quality matches Bresenham's algorithm by delegating to DrawLine.
 func (t *T8Go) DrawLineAngle(originX, originY, length int16, angle uint8) {
+	if t.traced() {
+		t.tracer("DrawLineAngle", originX, originY, length, int16(angle))
+	}
+
 	if length == 0 {
 		return
 	}
 	endX, endY := helpers.AngleEndpoint(originX, originY, length, angle)
-	t.DrawLine(originX, originY, endX, endY)
+	t.drawLine(originX, originY, endX, endY)
 }
 
 // DrawBox draws a rectangular outline starting from (originX, originY) with specified dimensions.
@@ -112,82 +299,123 @@ func (t *T8Go) DrawLineAngle(originX, originY, length int16, angle uint8) {
 // Supports negative width/height values to draw in the opposite direction.
 // Must be at least 2x2 in absolute size to form a valid frame outline.
 func (t *T8Go) DrawBox(originX, originY, width, height int16) {
-	directionX := helpers.Direction(width)
-	directionY := helpers.Direction(height)
+	if t.traced() {
+		t.tracer("DrawBox", originX, originY, width, height)
+	}
+	t.drawBox(originX, originY, width, height)
+}
+
+// drawBox draws DrawBox without reporting it to the tracer.
+func (t *T8Go) drawBox(originX, originY, width, height int16) {
+	if t.transformed {
+		t.transformedBox(originX, originY, width, height)
+		return
+	}
 
-	uWidth := helpers.Abs(width)
-	uHeight := helpers.Abs(height)
+	minX, minY, maxX, maxY, ok := boxBounds(originX, originY, width, height)
 
 	// Need at least 2 pixels in each dimension to form a proper outline
-	if uWidth <= 1 || uHeight <= 1 {
+	if !ok || maxX == minX || maxY == minY || !t.visible32(minX, minY, maxX, maxY) {
 		return
 	}
 
-	// Calculate far corner coordinates based on direction
-	maxX := originX + (uWidth-1)*directionX
-	maxY := originY + (uHeight-1)*directionY
-
 	// Top and bottom horizontal edges
-	t.DrawHLine(originX, originY, width)
-	t.DrawHLine(originX, maxY, width)
+	t.hspan(minX, maxX, minY)
+	t.hspan(minX, maxX, maxY)
 
 	// Left and right vertical edges (excluding the corners already drawn)
-	t.DrawVLine(originX, originY+directionY, height-2*directionY)
-	t.DrawVLine(maxX, originY+directionY, height-2*directionY)
+	if maxY-minY > 1 {
+		t.vspan(minX, minY+1, maxY-1)
+		t.vspan(maxX, minY+1, maxY-1)
+	}
 }
 
 // DrawBoxCoords draws a rectangular outline between two corners:
 // top-left (startX, startY) and bottom-right (endX, endY), inclusive.
 // The order of coordinates does not matter; they are normalized internally.
 func (t *T8Go) DrawBoxCoords(startX, startY, endX, endY int16) {
+	if t.traced() {
+		t.tracer("DrawBoxCoords", startX, startY, endX, endY)
+	}
+
 	originX, originY, width, height := helpers.NormalizeRect(startX, startY, endX, endY)
-	t.DrawBox(originX, originY, width, height)
+	t.drawBox(originX, originY, width, height)
 }
 
 // DrawRoundBox draws a rectangular outline with rounded corners.
 // The cornerRadius parameter controls the curvature of the corners.
 // Corner radius is automatically clamped to fit within the rectangle dimensions.
 func (t *T8Go) DrawRoundBox(originX, originY, width, height, cornerRadius int16) {
-	uWidth := helpers.Abs(width)
-	uHeight := helpers.Abs(height)
-	if uWidth <= 1 || uHeight <= 1 {
+	if t.traced() {
+		t.tracer("DrawRoundBox", originX, originY, width, height, cornerRadius)
+	}
+	t.drawRoundBox(originX, originY, width, height, cornerRadius)
+}
+
+// drawRoundBox draws DrawRoundBox without reporting it to the tracer.
+func (t *T8Go) drawRoundBox(originX, originY, width, height, cornerRadius int16) {
+	if t.transformed {
+		t.transformedRoundBox(originX, originY, width, height, cornerRadius)
 		return
 	}
-	if cornerRadius < 0 {
-		cornerRadius = 0
+
+	minX, minY, maxX, maxY, ok := boxBounds(originX, originY, width, height)
+	if !ok || maxX == minX || maxY == minY {
+		return
 	}
 
-	// Fast path: if cornerRadius == 0, just draw a box.
-	if cornerRadius == 0 {
-		t.DrawBox(originX, originY, width, height)
+	// Fast path: if cornerRadius <= 0, just draw a box.
+	if cornerRadius <= 0 {
+		t.drawBox(originX, originY, width, height)
+		return
+	}
+	if !t.visible32(minX, minY, maxX, maxY) {
 		return
 	}
 
 	// Clamp so there is at least 1px of straight edge per side.
-	limit := min(uWidth, uHeight)
-	maxCorner := (limit - 1) / 2
-	cornerRadius = min(cornerRadius, maxCorner)
+	limit := min(maxX-minX, maxY-minY) + 1
+	radius := min(int32(cornerRadius), (limit-1)/2)
 
-	// Normalize bounds.
-	rawMaxX := originX + width - 1
-	rawMaxY := originY + height - 1
-	minX, maxX := min(originX, rawMaxX), max(originX, rawMaxX)
-	minY, maxY := min(originY, rawMaxY), max(originY, rawMaxY)
+	left, right := minX+radius, maxX-radius
+	top, bottom := minY+radius, maxY-radius
 
-	hLen := (maxX - minX + 1) - 2*cornerRadius
-	vLen := (maxY - minY + 1) - 2*cornerRadius
+	// Straight edges, between the ends of the corners.
+	if right-left > 1 {
+		t.hspan(left+1, right-1, minY)
+		t.hspan(left+1, right-1, maxY)
+	}
+	if bottom-top > 1 {
+		t.vspan(minX, top+1, bottom-1)
+		t.vspan(maxX, top+1, bottom-1)
+	}
 
-	// Straight edges.
-	t.DrawHLine(minX+cornerRadius, minY, hLen)
-	t.DrawHLine(minX+cornerRadius, maxY, hLen)
-	t.DrawVLine(minX, minY+cornerRadius, vLen)
-	t.DrawVLine(maxX, minY+cornerRadius, vLen)
+	// Rounded corners, walked together so the pixels they share are drawn once.
+	circleOctant(int16(radius), func(offsetX, offsetY int16) {
+		ox, oy := int32(offsetX), int32(offsetY)
+		t.drawRoundCorners(ox, oy, left, top, right, bottom)
+		if ox != oy {
+			t.drawRoundCorners(oy, ox, left, top, right, bottom)
+		}
+	})
+}
 
-	// Rounded corners.
-	t.DrawCircle(minX+cornerRadius, minY+cornerRadius, cornerRadius, DrawTopLeft)
-	t.DrawCircle(maxX-cornerRadius, minY+cornerRadius, cornerRadius, DrawTopRight)
-	t.DrawCircle(maxX-cornerRadius, maxY-cornerRadius, cornerRadius, DrawBottomRight)
-	t.DrawCircle(minX+cornerRadius, maxY-cornerRadius, cornerRadius, DrawBottomLeft)
+// drawRoundCorners plots the pixel offsetX, offsetY away from each corner
+// center of a rounded box, outwards. When the centers of two corners meet,
+// the pixel they share on the axis between them is plotted once.
+func (t *T8Go) drawRoundCorners(offsetX, offsetY, left, top, right, bottom int32) {
+	splitX := offsetX != 0 || left != right
+	splitY := offsetY != 0 || top != bottom
+	t.plot(left-offsetX, top-offsetY)
+	if splitX {
+		t.plot(right+offsetX, top-offsetY)
+	}
+	if splitY {
+		t.plot(left-offsetX, bottom+offsetY)
+	}
+	if splitX && splitY {
+		t.plot(right+offsetX, bottom+offsetY)
+	}
 }
 
 // DrawBoxFill draws a filled rectangle starting from (originX, originY) with specified dimensions.
@@ -195,125 +423,206 @@ func (t *T8Go) DrawRoundBox(originX, originY, width, height, cornerRadius int16)
 // Supports negative width/height values to draw in the opposite direction.
 // No operation is performed if width or height is zero.
 func (t *T8Go) DrawBoxFill(originX, originY, width, height int16) {
-	directionY := helpers.Direction(height)
-	directionX := helpers.Direction(width)
+	if t.traced() {
+		t.tracer("DrawBoxFill", originX, originY, width, height)
+	}
+	t.drawBoxFill(originX, originY, width, height)
+}
 
-	if directionX == 0 || directionY == 0 {
+// drawBoxFill draws DrawBoxFill without reporting it to the tracer.
+func (t *T8Go) drawBoxFill(originX, originY, width, height int16) {
+	if t.transformed {
+		t.transformedBoxFill(originX, originY, width, height)
 		return
 	}
 
-	uHeight := helpers.Abs(height)
-
-	for offsetY := range uHeight {
-		t.DrawHLine(
-			originX,
-			originY+offsetY*directionY,
-			width,
-		)
+	minX, minY, maxX, maxY, ok := boxBounds(originX, originY, width, height)
+	if !ok {
+		return
 	}
+	t.fillRect(minX, minY, maxX, maxY)
 }
 
 // DrawBoxFillCoords draws a filled rectangle between two corners:
 // top-left (startX, startY) and bottom-right (endX, endY), inclusive.
 // The order of coordinates does not matter; they are normalized internally.
 func (t *T8Go) DrawBoxFillCoords(startX, startY, endX, endY int16) {
+	if t.traced() {
+		t.tracer("DrawBoxFillCoords", startX, startY, endX, endY)
+	}
+
 	originX, originY, width, height := helpers.NormalizeRect(startX, startY, endX, endY)
-	t.DrawBoxFill(originX, originY, width, height)
+	t.drawBoxFill(originX, originY, width, height)
 }
 
 // DrawRoundBoxFill draws a filled rectangle with rounded corners.
 // The cornerRadius parameter controls the curvature of the corners.
 // Corner radius is automatically clamped to fit within the rectangle dimensions.
 func (t *T8Go) DrawRoundBoxFill(originX, originY, width, height, cornerRadius int16) {
-	uWidth := helpers.Abs(width)
-	uHeight := helpers.Abs(height)
-	if uWidth <= 0 || uHeight <= 0 {
+	if t.traced() {
+		t.tracer("DrawRoundBoxFill", originX, originY, width, height, cornerRadius)
+	}
+	t.drawRoundBoxFill(originX, originY, width, height, cornerRadius)
+}
+
+// drawRoundBoxFill draws DrawRoundBoxFill without reporting it to the tracer.
+func (t *T8Go) drawRoundBoxFill(originX, originY, width, height, cornerRadius int16) {
+	if t.transformed {
+		t.transformedRoundBoxFill(originX, originY, width, height, cornerRadius)
 		return
 	}
-	if cornerRadius < 0 {
-		cornerRadius = 0
+
+	minX, minY, maxX, maxY, ok := boxBounds(originX, originY, width, height)
+	if !ok {
+		return
 	}
 
-	// Fast path: if cornerRadius == 0, just draw a box filled.
-	if cornerRadius == 0 {
-		t.DrawBoxFill(originX, originY, width, height)
+	// Fast path: if cornerRadius <= 0, just draw a box filled.
+	if cornerRadius <= 0 {
+		t.fillRect(minX, minY, maxX, maxY)
+		return
+	}
+	if !t.visible32(minX, minY, maxX, maxY) {
 		return
 	}
 
 	// Clamp so there is at least 1px of straight edge per side.
-	limit := min(uWidth, uHeight)
-	maxCorner := (limit - 1) / 2
-	cornerRadius = min(cornerRadius, maxCorner)
+	limit := min(maxX-minX, maxY-minY) + 1
+	radius := min(int32(cornerRadius), (limit-1)/2)
+
+	// Middle rows, then the rows of the rounded corners above and below them.
+	left, right := minX+radius, maxX-radius
+	top, bottom := minY+radius, maxY-radius
+	t.fillRect(minX, top, maxX, bottom)
+	circleRows(int16(radius), func(offsetY, halfWidth int16) {
+		if offsetY == 0 {
+			return
+		}
+		t.hspan(left-int32(halfWidth), right+int32(halfWidth), top-int32(offsetY))
+		t.hspan(left-int32(halfWidth), right+int32(halfWidth), bottom+int32(offsetY))
+	})
+}
+
+// DrawTriangle draws the outline of a triangle connecting three points.
+// The triangle is drawn by connecting (x1,y1) to (x2,y2) to (x3,y3) and back to (x1,y1).
+func (t *T8Go) DrawTriangle(x1, y1, x2, y2, x3, y3 int16) {
+	if t.traced() {
+		t.tracer("DrawTriangle", x1, y1, x2, y2, x3, y3)
+	}
+	t.drawTriangle(x1, y1, x2, y2, x3, y3)
+}
 
-	// Normalize bounds.
-	rawMaxX := originX + width - 1
-	rawMaxY := originY + height - 1
-	minX, maxX := min(originX, rawMaxX), max(originX, rawMaxX)
-	minY, maxY := min(originY, rawMaxY), max(originY, rawMaxY)
+// drawTriangle draws DrawTriangle without reporting it to the tracer.
+func (t *T8Go) drawTriangle(x1, y1, x2, y2, x3, y3 int16) {
+	if t.transformed {
+		t.transformedTriangle(x1, y1, x2, y2, x3, y3)
+		return
+	}
 
-	// Middle slabs: draw both orientations to handle capsules in either axis.
-	centerWidth := (maxX - minX + 1) - 2*cornerRadius  // vertical middle slab width
-	centerHeight := (maxY - minY + 1) - 2*cornerRadius // horizontal middle slab height
+	// Edges sharing pixels with the ones before leave them out, so corners
+	// and the pixels next to sharp corners are drawn once.
+	ab := newLineSegment(int32(x1), int32(y1), int32(x2), int32(y2))
+	bc := newLineSegment(int32(x2), int32(y2), int32(x3), int32(y3))
+	ca := newLineSegment(int32(x3), int32(y3), int32(x1), int32(y1))
+	t.drawSegment(&ab, nil, nil)
+	t.drawSegment(&bc, &ab, nil)
+	t.drawSegment(&ca, &ab, &bc)
+}
 
-	// Vertical middle slab (covers tall shapes and general case).
-	if centerWidth > 0 {
-		t.DrawBoxFill(minX+cornerRadius, minY, centerWidth, uHeight)
+// newLineSegment returns the pixels DrawLine draws from (x0, y0) to (x1, y1).
+func newLineSegment(x0, y0, x1, y1 int32) lineSegment {
+	steep := helpers.Abs(y1-y0) > helpers.Abs(x1-x0)
+	if steep {
+		x0, y0 = y0, x0
+		x1, y1 = y1, x1
+	}
+	if x0 > x1 {
+		x0, x1 = x1, x0
+		y0, y1 = y1, y0
+	}
+	return lineSegment{
+		startX: x0, startY: y0,
+		deltaX: x1 - x0, deltaY: helpers.Abs(y1 - y0),
+		stepY: int32(helpers.Direction(y1 - y0)),
+		steep: steep,
 	}
+}
 
-	// Horizontal middle slab (covers wide shapes and general case).
-	if centerHeight > 0 {
-		t.DrawBoxFill(minX, minY+cornerRadius, uWidth, centerHeight)
+// rise returns how far the walk of s has risen step pixels from its start:
+// the number of times the Bresenham error term of DrawLine went negative.
+func (s *lineSegment) rise(step int32) int32 {
+	if s.deltaX == 0 {
+		return 0
 	}
+	return int32((int64(step)*int64(s.deltaY) - int64(s.deltaX/2) + int64(s.deltaX) - 1) / int64(s.deltaX))
+}
 
-	// Rounded corners (quarter-disc fills).
-	t.DrawCircleFill(minX+cornerRadius, minY+cornerRadius, cornerRadius, DrawTopLeft)
-	t.DrawCircleFill(maxX-cornerRadius, minY+cornerRadius, cornerRadius, DrawTopRight)
-	t.DrawCircleFill(maxX-cornerRadius, maxY-cornerRadius, cornerRadius, DrawBottomRight)
-	t.DrawCircleFill(minX+cornerRadius, maxY-cornerRadius, cornerRadius, DrawBottomLeft)
+// contains reports whether s draws the pixel at (x, y).
+func (s *lineSegment) contains(x, y int32) bool {
+	if s.steep {
+		x, y = y, x
+	}
+	step := x - s.startX
+	return step >= 0 && step <= s.deltaX && y == s.startY+s.stepY*s.rise(step)
 }
 
-// DrawTriangle draws the outline of a triangle connecting three points.
-// The triangle is drawn by connecting (x1,y1) to (x2,y2) to (x3,y3) and back to (x1,y1).
-func (t *T8Go) DrawTriangle(x1, y1, x2, y2, x3, y3 int16) {
-	t.DrawLine(x1, y1, x2, y2)
-	t.DrawLine(x2, y2, x3, y3)
-	t.DrawLine(x3, y3, x1, y1)
+// drawSegment draws the pixels of s, leaving out those of skipA and skipB
+// (either may be nil).
+func (t *T8Go) drawSegment(s, skipA, skipB *lineSegment) {
+	endY := s.startY + s.stepY*s.deltaY
+	minX, minY, maxX, maxY := s.startX, min(s.startY, endY), s.startX+s.deltaX, max(s.startY, endY)
+	if s.steep {
+		minX, minY, maxX, maxY = minY, minX, maxY, maxX
+	}
+	if !t.visible32(minX, minY, maxX, maxY) {
+		return
+	}
+
+	for step := int32(0); step <= s.deltaX; step++ {
+		x, y := s.startX+step, s.startY+s.stepY*s.rise(step)
+		if s.steep {
+			x, y = y, x
+		}
+		if skipA != nil && skipA.contains(x, y) || skipB != nil && skipB.contains(x, y) {
+			continue
+		}
+		t.plot(x, y)
+	}
 }
 
 // DrawTriangleFill draws a filled triangle connecting three points.
 // The triangle is filled using scanline rendering to ensure complete coverage
 // with inclusive edges and no gaps.
 func (t *T8Go) DrawTriangleFill(x1, y1, x2, y2, x3, y3 int16) {
-	t.DrawTriangle(x1, y1, x2, y2, x3, y3)
+	if t.traced() {
+		t.tracer("DrawTriangleFill", x1, y1, x2, y2, x3, y3)
+	}
+	t.drawTriangleFill(x1, y1, x2, y2, x3, y3)
+}
+
+// drawTriangleFill draws DrawTriangleFill without reporting it to the tracer.
+func (t *T8Go) drawTriangleFill(x1, y1, x2, y2, x3, y3 int16) {
+	if t.transformed {
+		t.transformedTriangleFill(x1, y1, x2, y2, x3, y3)
+		return
+	}
 
 	// Degenerate horizontal line (all y equal)
 	if y1 == y2 && y2 == y3 {
 		left := min(x1, min(x2, x3))
 		right := max(x1, max(x2, x3))
-		t.DrawHLine(left, y1, right-left+1)
+		t.hspan(int32(left), int32(right), int32(y1))
 		return
 	}
 
-	minY := min(y1, min(y2, y3))
-	maxY := max(y1, max(y2, y3))
-
-	// Accumulate edge pixels into spans keyed by Y (map value's zero state has initialized=false).
-	spans := make(map[int16]scanSpan, int(maxY-minY)+1)
+	// Accumulate edge pixels into the reusable per-row spans, then fill
+	// every row once, edges included.
+	spans := t.rows
+	minY, maxY := spans.reset(min(y1, min(y2, y3)), max(y1, max(y2, y3)))
 	scanAddLineToSpans(spans, x1, y1, x2, y2)
 	scanAddLineToSpans(spans, x2, y2, x3, y3)
 	scanAddLineToSpans(spans, x3, y3, x1, y1)
-
-	for y := minY; y <= maxY; y++ {
-		row := spans[y]
-		if !row.initialized {
-			continue
-		}
-		startXPos, endXPos := row.minX, row.maxX
-		if startXPos > endXPos {
-			startXPos, endXPos = endXPos, startXPos
-		}
-		t.DrawHLine(startXPos, y, endXPos-startXPos+1)
-	}
+	t.fillSpans(minY, maxY)
 }
 
 // DrawCircle draws an outlined circle centered at (centerX, centerY) with the specified radius.
@@ -321,70 +630,45 @@ func (t *T8Go) DrawTriangleFill(x1, y1, x2, y2, x3, y3 int16) {
 // The mask parameter controls which quadrants are drawn using DrawQuadrants flags.
 // Use DrawNone or DrawAll to draw the complete circle.
 func (t *T8Go) DrawCircle(centerX, centerY, radius int16, mask DrawQuadrants) {
-	if radius <= 0 {
-		return
-	}
-
-	// Midpoint circle algorithm with integer arithmetic.
-	errorAccumulator := int16(1 - radius)
-	deltaX := int16(1)
-	deltaY := int16(-2 * radius)
-	offsetX := int16(0)
-	offsetY := radius
-
-	t.drawCircleSection(offsetX, offsetY, centerX, centerY, mask)
-
-	for offsetX < offsetY {
-		if errorAccumulator >= 0 {
-			offsetY--
-			deltaY += 2
-			errorAccumulator += deltaY
-		}
-		offsetX++
-		deltaX += 2
-		errorAccumulator += deltaX
-
-		t.drawCircleSection(offsetX, offsetY, centerX, centerY, mask)
+	if t.traced() {
+		t.tracer("DrawCircle", centerX, centerY, radius, int16(mask))
 	}
+	t.drawCircle(centerX, centerY, radius, mask)
 }
 
-// drawCircleSection plots the symmetric points of the circle for the given offsets,
-// filtered by the mask to draw only the selected quadrants.
-func (t *T8Go) drawCircleSection(offsetX, offsetY, centerX, centerY int16, mask DrawQuadrants) {
-	if mask.has(DrawTopRight) {
-		t.DrawPixel(centerX+offsetX, centerY-offsetY)
-		t.DrawPixel(centerX+offsetY, centerY-offsetX)
-	}
-	if mask.has(DrawTopLeft) {
-		t.DrawPixel(centerX-offsetX, centerY-offsetY)
-		t.DrawPixel(centerX-offsetY, centerY-offsetX)
-	}
-	if mask.has(DrawBottomRight) {
-		t.DrawPixel(centerX+offsetX, centerY+offsetY)
-		t.DrawPixel(centerX+offsetY, centerY+offsetX)
-	}
-	if mask.has(DrawBottomLeft) {
-		t.DrawPixel(centerX-offsetX, centerY+offsetY)
-		t.DrawPixel(centerX-offsetY, centerY+offsetX)
+// drawCircle draws DrawCircle without reporting it to the tracer.
+func (t *T8Go) drawCircle(centerX, centerY, radius int16, mask DrawQuadrants) {
+	if t.transformed {
+		t.transformedCircle(centerX, centerY, radius, mask)
+		return
 	}
-}
 
-// DrawCircleFill draws a filled circle centered at (centerX, centerY) with the specified radius.
-// The mask parameter controls which quadrants are filled using DrawQuadrants flags.
-// Use DrawNone or DrawAll to fill the complete circle disc.
-func (t *T8Go) DrawCircleFill(centerX, centerY, radius int16, mask DrawQuadrants) {
 	if radius <= 0 {
 		return
 	}
 
-	// Midpoint circle algorithm with integer arithmetic.
+	// Skip quadrants that are entirely outside the visible area.
+	mask = t.visibleQuadrants(centerX, centerY, radius, radius, mask)
+	if mask == DrawNone {
+		return
+	}
+
+	circleOctant(radius, func(offsetX, offsetY int16) {
+		t.drawCircleSection(offsetX, offsetY, centerX, centerY, mask)
+	})
+}
+
+// circleOctant walks a midpoint circle of the given radius and calls point
+// once for every pixel of its first octant, from (0, radius) to the diagonal,
+// so offsetX <= offsetY. The other octants mirror it.
+func circleOctant(radius int16, point func(offsetX, offsetY int16)) {
 	errorAccumulator := int16(1 - radius)
 	deltaX := int16(1)
 	deltaY := int16(-2 * radius)
 	offsetX := int16(0)
 	offsetY := radius
 
-	t.drawCircleFillSection(offsetX, offsetY, centerX, centerY, mask)
+	point(offsetX, offsetY)
 
 	for offsetX < offsetY {
 		if errorAccumulator >= 0 {
@@ -396,248 +680,123 @@ func (t *T8Go) DrawCircleFill(centerX, centerY, radius int16, mask DrawQuadrants
 		deltaX += 2
 		errorAccumulator += deltaX
 
-		t.drawCircleFillSection(offsetX, offsetY, centerX, centerY, mask)
+		// Past the diagonal the points mirror the previous one.
+		if offsetX > offsetY {
+			return
+		}
+		point(offsetX, offsetY)
 	}
 }
 
-// drawCircleFillSection draws the vertical spans for the 8-way symmetric points of a circle,
-// filtered by the mask to fill only the selected quadrants.
-func (t *T8Go) drawCircleFillSection(offsetX, offsetY, centerX, centerY int16, mask DrawQuadrants) {
+// drawCircleSection plots the symmetric points of the circle for the given offsets,
+// filtered by the mask to draw only the selected quadrants. Points shared by two
+// octants or quadrants are plotted once.
+func (t *T8Go) drawCircleSection(offsetX, offsetY, centerX, centerY int16, mask DrawQuadrants) {
+	cx, cy := int32(centerX), int32(centerY)
+	ox, oy := int32(offsetX), int32(offsetY)
+	if ox == 0 {
+		t.drawAxisPoints(cx, cy, 0, oy, mask)
+		t.drawAxisPoints(cx, cy, oy, 0, mask)
+		return
+	}
+
+	// On the diagonal both octants of a quadrant meet in one point.
+	mirror := ox != oy
 	if mask.has(DrawTopRight) {
-		t.DrawVLine(centerX+offsetX, centerY-offsetY, offsetY+1)
-		t.DrawVLine(centerX+offsetY, centerY-offsetX, offsetX+1)
+		t.plot(cx+ox, cy-oy)
+		if mirror {
+			t.plot(cx+oy, cy-ox)
+		}
 	}
 	if mask.has(DrawTopLeft) {
-		t.DrawVLine(centerX-offsetX, centerY-offsetY, offsetY+1)
-		t.DrawVLine(centerX-offsetY, centerY-offsetX, offsetX+1)
+		t.plot(cx-ox, cy-oy)
+		if mirror {
+			t.plot(cx-oy, cy-ox)
+		}
 	}
 	if mask.has(DrawBottomRight) {
-		t.DrawVLine(centerX+offsetX, centerY, offsetY+1)
-		t.DrawVLine(centerX+offsetY, centerY, offsetX+1)
+		t.plot(cx+ox, cy+oy)
+		if mirror {
+			t.plot(cx+oy, cy+ox)
+		}
 	}
 	if mask.has(DrawBottomLeft) {
-		t.DrawVLine(centerX-offsetX, centerY, offsetY+1)
-		t.DrawVLine(centerX-offsetY, centerY, offsetX+1)
+		t.plot(cx-ox, cy+oy)
+		if mirror {
+			t.plot(cx-oy, cy+ox)
+		}
 	}
 }
 
-// DrawEllipse draws an outlined ellipse centered at (centerX, centerY) with specified radii.
-// The radiusX and radiusY parameters define the horizontal and vertical extents.
-// The mask parameter controls which quadrants are drawn using DrawQuadrants flags.
-// Use DrawNone or DrawAll to draw the complete ellipse outline.
-// No operation is performed if either radius is less than or equal to zero.
-func (t *T8Go) DrawEllipse(centerX, centerY, radiusX, radiusY int16, mask DrawQuadrants) {
-	if radiusX <= 0 || radiusY <= 0 {
-		return
-	}
-
-	// Use int32 internally to avoid overflow on products.
-	rx := int32(radiusX)
-	ry := int32(radiusY)
-	rx2 := rx * rx
-	ry2 := ry * ry
-	rx2x2 := rx2 * 2
-	ry2x2 := ry2 * 2
-
-	// Region 1 (|dy/dx| < 1)
-	offsetX := rx
-	offsetY := int32(0)
-	errorAccumulator := (1 - 2*rx) * ry2
-	deltaX := rx2
-	deltaY := rx2
-	stopX := ry2x2 * rx
-	stopY := int32(0)
-
-	for stopX >= stopY {
-		t.drawEllipseSection(int16(offsetX), int16(offsetY), centerX, centerY, mask)
-
-		offsetY++
-		stopY += rx2x2
-		errorAccumulator += deltaY
-		deltaY += rx2x2
-
-		if 2*errorAccumulator+deltaX > 0 {
-			offsetX--
-			stopX -= ry2x2
-			errorAccumulator += (1 - 2*offsetX) * ry2
-			deltaX += ry2x2
+// drawAxisPoints plots the two points offsetX, offsetY away from the center
+// on one axis (one of the offsets is zero). Each is shared by the two
+// quadrants on its sides and plotted once if either is in mask.
+func (t *T8Go) drawAxisPoints(centerX, centerY, offsetX, offsetY int32, mask DrawQuadrants) {
+	top := mask.has(DrawTopLeft) || mask.has(DrawTopRight)
+	bottom := mask.has(DrawBottomLeft) || mask.has(DrawBottomRight)
+	left := mask.has(DrawTopLeft) || mask.has(DrawBottomLeft)
+	right := mask.has(DrawTopRight) || mask.has(DrawBottomRight)
+	if offsetX == 0 {
+		if top {
+			t.plot(centerX, centerY-offsetY)
 		}
-	}
-
-	// Region 2 (|dy/dx| >= 1)
-	offsetX = 0
-	offsetY = ry
-	errorAccumulator = (1 - 2*ry) * rx2
-	deltaX = ry2
-	deltaY = rx2
-	stopX = 0
-	stopY = rx2x2 * ry
-
-	for stopX <= stopY {
-		t.drawEllipseSection(int16(offsetX), int16(offsetY), centerX, centerY, mask)
-
-		offsetX++
-		stopX += ry2x2
-		errorAccumulator += deltaX
-		deltaX += ry2x2
-
-		if 2*errorAccumulator+deltaY > 0 {
-			offsetY--
-			stopY -= rx2x2
-			errorAccumulator += (1 - 2*offsetY) * rx2
-			deltaY += rx2x2
+		if bottom {
+			t.plot(centerX, centerY+offsetY)
 		}
-	}
-}
-
-// drawEllipseSection plots the symmetric points of an ellipse for the given offsets,
-// filtered by the mask to draw only the selected quadrants.
-func (t *T8Go) drawEllipseSection(offsetX, offsetY, centerX, centerY int16, mask DrawQuadrants) {
-	if offsetY < 0 {
 		return
 	}
-	if mask.has(DrawTopRight) {
-		t.DrawPixel(centerX+offsetX, centerY-offsetY)
-	}
-	if mask.has(DrawTopLeft) {
-		t.DrawPixel(centerX-offsetX, centerY-offsetY)
-	}
-	if mask.has(DrawBottomRight) {
-		t.DrawPixel(centerX+offsetX, centerY+offsetY)
+	if right {
+		t.plot(centerX+offsetX, centerY)
 	}
-	if mask.has(DrawBottomLeft) {
-		t.DrawPixel(centerX-offsetX, centerY+offsetY)
+	if left {
+		t.plot(centerX-offsetX, centerY)
 	}
 }
 
-// DrawEllipseFill draws a filled ellipse centered at (centerX, centerY) with specified radii.
-// The radiusX and radiusY parameters define the horizontal and vertical extents.
+// DrawCircleFill draws a filled circle centered at (centerX, centerY) with the specified radius.
 // The mask parameter controls which quadrants are filled using DrawQuadrants flags.
-// Use DrawNone or DrawAll to fill the complete ellipse area.
-// No operation is performed if either radius is less than or equal to zero.
-func (t *T8Go) DrawEllipseFill(centerX, centerY, radiusX, radiusY int16, mask DrawQuadrants) {
-	if radiusX <= 0 || radiusY <= 0 {
-		return
-	}
-
-	rx := int32(radiusX)
-	ry := int32(radiusY)
-	rx2 := rx * rx   // rx^2
-	ry2 := ry * ry   // ry^2
-	rx2x2 := rx2 * 2 // 2*rx^2
-	ry2x2 := ry2 * 2 // 2*ry^2
-
-	// Region 1 (horizontal-dominant): stopX >= stopY
-	offsetX := int32(radiusX)
-	offsetY := int32(0)
-
-	deltaXChange := (1 - 2*int32(radiusX)) * ry2
-	deltaYChange := rx2
-	errorAccumulator := int32(0)
-
-	stopX := ry2x2 * int32(radiusX)
-	stopY := int32(0)
-
-	for stopX >= stopY {
-		t.drawEllipseFillSection(int16(offsetX), int16(offsetY), centerX, centerY, mask)
-
-		offsetY++
-		stopY += rx2x2
-		errorAccumulator += deltaYChange
-		deltaYChange += rx2x2
-
-		if 2*errorAccumulator+deltaXChange > 0 {
-			offsetX--
-			stopX -= ry2x2
-			errorAccumulator += deltaXChange
-			deltaXChange += ry2x2
-		}
-	}
-
-	// Region 2 (vertical-dominant): stopX <= stopY
-	offsetX = 0
-	offsetY = int32(radiusY)
-
-	deltaXChange = ry2
-	deltaYChange = (1 - 2*int32(radiusY)) * rx2
-	errorAccumulator = 0
-
-	stopX = 0
-	stopY = rx2x2 * int32(radiusY)
-
-	for stopX <= stopY {
-		t.drawEllipseFillSection(int16(offsetX), int16(offsetY), centerX, centerY, mask)
-
-		offsetX++
-		stopX += ry2x2
-		errorAccumulator += deltaXChange
-		deltaXChange += ry2x2
-
-		if 2*errorAccumulator+deltaYChange > 0 {
-			offsetY--
-			stopY -= rx2x2
-			errorAccumulator += deltaYChange
-			deltaYChange += rx2x2
-		}
+// Use DrawNone or DrawAll to fill the complete circle disc.
+func (t *T8Go) DrawCircleFill(centerX, centerY, radius int16, mask DrawQuadrants) {
+	if t.traced() {
+		t.tracer("DrawCircleFill", centerX, centerY, radius, int16(mask))
 	}
+	t.drawCircleFill(centerX, centerY, radius, mask)
 }
 
-// drawEllipseFillSection draws vertical spans for the 4-way symmetric points of an ellipse,
-// filtered by the mask to fill only the selected quadrants.
-// For each (offsetX, offsetY), spans have length offsetY+1.
-func (t *T8Go) drawEllipseFillSection(offsetX, offsetY, centerX, centerY int16, mask DrawQuadrants) {
-	if offsetY < 0 {
+// drawCircleFill draws DrawCircleFill without reporting it to the tracer.
+func (t *T8Go) drawCircleFill(centerX, centerY, radius int16, mask DrawQuadrants) {
+	if t.transformed {
+		t.transformedCircleFill(centerX, centerY, radius, mask)
 		return
 	}
 
-	// Upper quadrants: start at y0 - offsetY, length offsetY+1
-	if mask.has(DrawTopRight) {
-		t.DrawVLine(centerX+offsetX, centerY-offsetY, offsetY+1)
-	}
-	if mask.has(DrawTopLeft) {
-		t.DrawVLine(centerX-offsetX, centerY-offsetY, offsetY+1)
-	}
-
-	// Lower quadrants: start at y0, length offsetY+1
-	if mask.has(DrawBottomRight) {
-		t.DrawVLine(centerX+offsetX, centerY, offsetY+1)
-	}
-	if mask.has(DrawBottomLeft) {
-		t.DrawVLine(centerX-offsetX, centerY, offsetY+1)
-	}
-}
-
-// DrawArc draws an outlined arc (partial circle) centered at (centerX, centerY) with the specified radius.
-// Angles are expressed in 0-255 units where 0=0°, 64=90°, 128=180°, 192=270°.
-// The arc is rendered from angleStart (inclusive) to angleEnd (exclusive).
-// If angleStart equals angleEnd, a complete circle is drawn.
-//
-// Angle reference:
-//   - 0   =   0° (right)
-//   - 64  =  90° (up)
-//   - 128 = 180° (left)
-//   - 192 = 270° (down)
-//   - 255 = 360° (wraps to 0)
-func (t *T8Go) DrawArc(centerX, centerY, radius int16, angleStart, angleEnd uint8) {
 	if radius <= 0 {
 		return
 	}
 
-	// Fast path: full arc
-	isFullArc := angleStart == angleEnd
-	if isFullArc {
-		t.DrawCircle(centerX, centerY, radius, DrawAll)
+	// Skip quadrants that are entirely outside the visible area.
+	mask = t.visibleQuadrants(centerX, centerY, radius, radius, mask)
+	if mask == DrawNone {
 		return
 	}
 
-	// Midpoint circle algorithm (integer arithmetic).
+	circleRows(radius, func(offsetY, halfWidth int16) {
+		t.fillQuadrantRows(centerX, centerY, offsetY, halfWidth, mask)
+	})
+}
+
+// circleRows walks a midpoint circle of the given radius and calls row exactly once
+// for every offsetY in 0..radius with the half width of the circle at that distance
+// from the center.
+func circleRows(radius int16, row func(offsetY, halfWidth int16)) {
 	errorAccumulator := int16(1 - radius)
 	deltaX := int16(1)
 	deltaY := int16(-2 * radius)
 	offsetX := int16(0)
 	offsetY := radius
+	prevX, prevY := offsetX, offsetY
 
-	t.drawArcSection(offsetX, offsetY, centerX, centerY, angleStart, angleEnd)
+	row(0, radius)
 
 	for offsetX < offsetY {
 		if errorAccumulator >= 0 {
@@ -649,148 +808,94 @@ func (t *T8Go) DrawArc(centerX, centerY, radius int16, angleStart, angleEnd uint
 		deltaX += 2
 		errorAccumulator += deltaX
 
-		t.drawArcSection(offsetX, offsetY, centerX, centerY, angleStart, angleEnd)
-	}
-}
-
-// drawArcSection plots the 8 symmetric points for the given offsets if their angles
-// fall within the requested range. Uses 0..255 unit angles (64 = 90°).
-func (t *T8Go) drawArcSection(offsetX, offsetY, centerX, centerY int16, angleStart, angleEnd uint8) {
-	// Base angle in the first octant [0..64], approximated using integer math.
-	// The helper is assumed to be monotonic and tuned for small integer inputs.
-	baseOctantAngle := helpers.ApproxAtanUnit64(offsetX, offsetY) // uint8 in [0..64]
-
-	// Compose angles (0..255) for the 8-way symmetry. All done in uint8 space.
-	a0 := baseOctantAngle                      // (+y, -x) →   0.. 64
-	a1 := 64 - baseOctantAngle                 // (+x, -y) →   0.. 64
-	a2 := 64 + baseOctantAngle                 // (-x, -y) →  64..128
-	a3 := 128 - baseOctantAngle                // (-y, -x) →  64..128
-	a4 := 128 + baseOctantAngle                // (-y, +x) → 128..192
-	a5 := 192 - baseOctantAngle                // (-x, +y) → 128..192
-	a6 := 192 + baseOctantAngle                // (+x, +y) → 192..256
-	a7 := uint8(256 - uint16(baseOctantAngle)) // (+y, +x) → wrap to 0..255
+		// Rows near the center: one per step, offsetY pixels wide.
+		if offsetX <= offsetY {
+			row(offsetX, offsetY)
+		}
 
-	// Only plot points whose angle falls inside [angleStart, angleEnd).
-	// If the caller asked for a full arc, this function is not invoked (fast-path above).
-	if helpers.InAngleRange(a0, angleStart, angleEnd) {
-		t.DrawPixel(centerX+offsetY, centerY-offsetX)
-	}
-	if helpers.InAngleRange(a1, angleStart, angleEnd) {
-		t.DrawPixel(centerX+offsetX, centerY-offsetY)
-	}
-	if helpers.InAngleRange(a2, angleStart, angleEnd) {
-		t.DrawPixel(centerX-offsetX, centerY-offsetY)
-	}
-	if helpers.InAngleRange(a3, angleStart, angleEnd) {
-		t.DrawPixel(centerX-offsetY, centerY-offsetX)
-	}
-	if helpers.InAngleRange(a4, angleStart, angleEnd) {
-		t.DrawPixel(centerX-offsetY, centerY+offsetX)
-	}
-	if helpers.InAngleRange(a5, angleStart, angleEnd) {
-		t.DrawPixel(centerX-offsetX, centerY+offsetY)
-	}
-	if helpers.InAngleRange(a6, angleStart, angleEnd) {
-		t.DrawPixel(centerX+offsetX, centerY+offsetY)
-	}
-	if helpers.InAngleRange(a7, angleStart, angleEnd) {
-		t.DrawPixel(centerX+offsetY, centerY+offsetX)
+		// Rows near the top and bottom: reported once offsetY moves on, with the widest offsetX seen.
+		if offsetY != prevY {
+			row(prevY, prevX)
+			prevY = offsetY
+		}
+		prevX = offsetX
 	}
 }
 
-// DrawArcFill draws a filled arc (sector/pie slice) centered at (centerX, centerY) with the specified radius.
-// Angles are expressed in 0-255 units where 64=90°, 128=180°, 192=270°.
-// The arc is rendered from angleStart (inclusive) to angleEnd (exclusive).
-// If angleStart equals angleEnd, a complete filled circle is drawn.
-func (t *T8Go) DrawArcFill(centerX, centerY, radius int16, angleStart, angleEnd uint8) {
-	if radius <= 0 {
+// fillQuadrantRows fills the rows centerY-offsetY and centerY+offsetY of a circle or
+// ellipse, halfWidth pixels to each side of centerX, for the quadrants selected by mask.
+// The center row (offsetY == 0) is shared by the upper and lower quadrants and drawn once.
+func (t *T8Go) fillQuadrantRows(centerX, centerY, offsetY, halfWidth int16, mask DrawQuadrants) {
+	cx, cy, oy := int32(centerX), int32(centerY), int32(offsetY)
+	if offsetY == 0 {
+		left := mask.has(DrawTopLeft) || mask.has(DrawBottomLeft)
+		right := mask.has(DrawTopRight) || mask.has(DrawBottomRight)
+		t.fillHalfRow(cx, cy, halfWidth, left, right)
 		return
 	}
 
-	// Fast path: full sector -> full circle fill
-	if angleStart == angleEnd {
-		t.DrawCircleFill(centerX, centerY, radius, DrawAll)
-		return
-	}
-
-	// Perimeter sampling (midpoint circle) → accumulate spans and arc endpoints.
-	spans := make(map[int16]scanSpan, int(radius)*2+3)
-	accum := arcAccum{bestStartAngleDiff: 255, bestEndAngleDiff: 255}
-
-	errorAccumulator := int16(1 - radius)
-	deltaX := int16(1)
-	deltaY := int16(-2 * radius)
-	offsetX := int16(0)
-	offsetY := radius
-
-	accum.arcProcessPerimeter(spans, centerX, centerY, offsetX, offsetY, angleStart, angleEnd)
-
-	for offsetX < offsetY {
-		if errorAccumulator >= 0 {
-			offsetY--
-			deltaY += 2
-			errorAccumulator += deltaY
-		}
-		offsetX++
-		deltaX += 2
-		errorAccumulator += deltaX
+	t.fillHalfRow(cx, cy-oy, halfWidth, mask.has(DrawTopLeft), mask.has(DrawTopRight))
+	t.fillHalfRow(cx, cy+oy, halfWidth, mask.has(DrawBottomLeft), mask.has(DrawBottomRight))
+}
 
-		accum.arcProcessPerimeter(spans, centerX, centerY, offsetX, offsetY, angleStart, angleEnd)
+// fillHalfRow fills the left and/or right half of row y centered at centerX.
+// Both halves include the center column, which is drawn once when both are selected.
+func (t *T8Go) fillHalfRow(centerX, y int32, halfWidth int16, left, right bool) {
+	startX, endX := centerX, centerX
+	if left {
+		startX -= int32(halfWidth)
 	}
+	if right {
+		endX += int32(halfWidth)
+	}
+	if left || right {
+		t.hspan(startX, endX, y)
+	}
+}
 
-	// Add radial boundaries (center → endpoints) into spans.
-	updateSpan(spans, centerX, centerY)
-	scanAddLineToSpans(spans, centerX, centerY, accum.startEndX, accum.startEndY)
-	scanAddLineToSpans(spans, centerX, centerY, accum.endEndX, accum.endEndY)
-
-	// Paint consolidated spans.
-	for yPos, row := range spans {
-		if row.IsEmpty() {
-			continue
-		}
-		startXPos, endXPos := row.minX, row.maxX
-		if startXPos > endXPos {
-			startXPos, endXPos = endXPos, startXPos
+// fillSpans fills the rows minY..maxY of the reusable per-row spans, each
+// once from its leftmost to its rightmost point. Rows without points are
+// skipped.
+func (t *T8Go) fillSpans(minY, maxY int16) {
+	for y := minY; y <= maxY; y++ {
+		row := t.rows[y]
+		if row.initialized {
+			t.hspan(int32(row.minX), int32(row.maxX), int32(y))
 		}
-		length := endXPos - startXPos + 1
-		t.DrawHLine(startXPos, yPos, length)
 	}
 }
 
 // updateSpan widens the span at (yPos) to include xPos.
-func updateSpan(spans map[int16]scanSpan, xPos, yPos int16) {
-	row := spans[yPos]
-	row.AddPoint(xPos)
-	spans[yPos] = row
+// Rows outside the display are ignored; xPos saturates at the int16 limits.
+func updateSpan(spans scanlines, xPos, yPos int32) {
+	if yPos < 0 || int(yPos) >= len(spans) {
+		return
+	}
+	spans[yPos].AddPoint(helpers.ClampInt16(xPos))
 }
 
-// scanAddLineToSpans rasterizes a line into spans using Bresenham rules (no clipping).
-func scanAddLineToSpans(spans map[int16]scanSpan, x0, y0, x1, y1 int16) {
+// scanAddLineToSpans rasterizes a line into spans using Bresenham rules (rows are clipped to the display).
+// It works in int32 so edges between distant int16 coordinates cannot wrap around.
+func scanAddLineToSpans(spans scanlines, startX, startY, endX, endY int16) {
+	x0, y0, x1, y1 := int32(startX), int32(startY), int32(endX), int32(endY)
+
 	// Vertical
 	if x0 == x1 {
-		startYPos, endYPos := y0, y1
-		if startYPos > endYPos {
-			startYPos, endYPos = endYPos, startYPos
-		}
+		startYPos, endYPos := max(min(y0, y1), 0), min(max(y0, y1), int32(len(spans))-1)
 		for currentYPos := startYPos; currentYPos <= endYPos; currentYPos++ {
 			updateSpan(spans, x0, currentYPos)
 		}
 		return
 	}
-	// Horizontal
+	// Horizontal: only the endpoints widen the span
 	if y0 == y1 {
-		startXPos, endXPos := x0, x1
-		if startXPos > endXPos {
-			startXPos, endXPos = endXPos, startXPos
-		}
-		for currentXPos := startXPos; currentXPos <= endXPos; currentXPos++ {
-			updateSpan(spans, currentXPos, y0)
-		}
+		updateSpan(spans, x0, y0)
+		updateSpan(spans, x1, y0)
 		return
 	}
 
 	// General Bresenham (mirrors your DrawLine semantics).
-	steep := helpers.AbsDiff(y1, y0) > helpers.AbsDiff(x1, x0)
+	steep := helpers.Abs(y1-y0) > helpers.Abs(x1-x0)
 	if steep {
 		x0, y0 = y0, x0
 		x1, y1 = y1, x1
@@ -802,7 +907,7 @@ func scanAddLineToSpans(spans map[int16]scanSpan, x0, y0, x1, y1 int16) {
 
 	deltaX := x1 - x0
 	deltaY := helpers.Abs(y1 - y0)
-	stepDirectionY := helpers.Direction(y1 - y0)
+	stepDirectionY := int32(helpers.Direction(y1 - y0))
 
 	errorAccumulator := deltaX / 2
 	currentYPos := y0
@@ -0,0 +1,259 @@
+//go:build !t8go_minimal
+
+package t8go
+
+import "github.com/redghc/t8go/helpers"
+
+// DrawArc draws an outlined arc (partial circle) centered at (centerX, centerY) with the specified radius.
+// Angles are expressed in 0-255 units where 0=0°, 64=90°, 128=180°, 192=270°.
+// The arc is rendered from angleStart (inclusive) to angleEnd (exclusive).
+// If angleStart equals angleEnd, a complete circle is drawn.
+//
+// Angle reference:
+//   - 0   =   0° (right)
+//   - 64  =  90° (up)
+//   - 128 = 180° (left)
+//   - 192 = 270° (down)
+//   - 255 = 360° (wraps to 0)
+func (t *T8Go) DrawArc(centerX, centerY, radius int16, angleStart, angleEnd uint8) {
+	if t.traced() {
+		t.tracer("DrawArc", centerX, centerY, radius, int16(angleStart), int16(angleEnd))
+	}
+	t.drawArc(centerX, centerY, radius, angleStart, angleEnd)
+}
+
+// drawArc draws DrawArc without reporting it to the tracer.
+func (t *T8Go) drawArc(centerX, centerY, radius int16, angleStart, angleEnd uint8) {
+	if t.transformed {
+		t.transformedArc(centerX, centerY, radius, angleStart, angleEnd)
+		return
+	}
+
+	cx, cy, r := int32(centerX), int32(centerY), int32(radius)
+	if radius <= 0 || !t.visible32(cx-r, cy-r, cx+r, cy+r) {
+		return
+	}
+
+	// Fast path: full arc
+	isFullArc := angleStart == angleEnd
+	if isFullArc {
+		t.drawCircle(centerX, centerY, radius, DrawAll)
+		return
+	}
+
+	circleOctant(radius, func(offsetX, offsetY int16) {
+		t.drawArcSection(offsetX, offsetY, centerX, centerY, angleStart, angleEnd)
+	})
+}
+
+// drawArcSection plots the 8 symmetric points for the given offsets if their angles
+// fall within the requested range. Uses 0..255 unit angles (64 = 90°).
+func (t *T8Go) drawArcSection(offsetX, offsetY, centerX, centerY int16, angleStart, angleEnd uint8) {
+	// Base angle in the first octant [0..64], approximated using integer math.
+	// The helper is assumed to be monotonic and tuned for small integer inputs.
+	baseOctantAngle := helpers.ApproxAtanUnit64(offsetX, offsetY) // uint8 in [0..64]
+
+	// Compose angles (0..255) for the 8-way symmetry. All done in uint8 space.
+	a0 := baseOctantAngle                      // (+y, -x) →   0.. 64
+	a1 := 64 - baseOctantAngle                 // (+x, -y) →   0.. 64
+	a2 := 64 + baseOctantAngle                 // (-x, -y) →  64..128
+	a3 := 128 - baseOctantAngle                // (-y, -x) →  64..128
+	a4 := 128 + baseOctantAngle                // (-y, +x) → 128..192
+	a5 := 192 - baseOctantAngle                // (-x, +y) → 128..192
+	a6 := 192 + baseOctantAngle                // (+x, +y) → 192..256
+	a7 := uint8(256 - uint16(baseOctantAngle)) // (+y, +x) → wrap to 0..255
+
+	cx, cy := int32(centerX), int32(centerY)
+	ox, oy := int32(offsetX), int32(offsetY)
+
+	// Only plot points whose angle falls inside [angleStart, angleEnd).
+	// If the caller asked for a full arc, this function is not invoked (fast-path above).
+	// Points shared by two octants are plotted once: on the axes the second point of
+	// each pair repeats the first at the same angle, and on the diagonal the two
+	// octants of a quadrant meet, so the point is drawn if either angle is in range.
+	inRange := func(angle uint8) bool { return helpers.InAngleRange(angle, angleStart, angleEnd) }
+	switch {
+	case ox == 0:
+		t.plotArcPoint(cx+oy, cy, inRange(a0))
+		t.plotArcPoint(cx, cy-oy, inRange(a1))
+		t.plotArcPoint(cx-oy, cy, inRange(a3))
+		t.plotArcPoint(cx, cy+oy, inRange(a5))
+	case ox == oy:
+		t.plotArcPoint(cx+ox, cy-oy, inRange(a0) || inRange(a1))
+		t.plotArcPoint(cx-ox, cy-oy, inRange(a2) || inRange(a3))
+		t.plotArcPoint(cx-ox, cy+oy, inRange(a4) || inRange(a5))
+		t.plotArcPoint(cx+ox, cy+oy, inRange(a6) || inRange(a7))
+	default:
+		t.plotArcPoint(cx+oy, cy-ox, inRange(a0))
+		t.plotArcPoint(cx+ox, cy-oy, inRange(a1))
+		t.plotArcPoint(cx-ox, cy-oy, inRange(a2))
+		t.plotArcPoint(cx-oy, cy-ox, inRange(a3))
+		t.plotArcPoint(cx-oy, cy+ox, inRange(a4))
+		t.plotArcPoint(cx-ox, cy+oy, inRange(a5))
+		t.plotArcPoint(cx+ox, cy+oy, inRange(a6))
+		t.plotArcPoint(cx+oy, cy+ox, inRange(a7))
+	}
+}
+
+// plotArcPoint plots the point (x, y) of an arc if it is in the arc's range.
+func (t *T8Go) plotArcPoint(x, y int32, inRange bool) {
+	if inRange {
+		t.plot(x, y)
+	}
+}
+
+// DrawArcFill draws a filled arc (sector/pie slice) centered at (centerX, centerY) with the specified radius.
+// Angles are expressed in 0-255 units where 64=90°, 128=180°, 192=270°.
+// The arc is rendered from angleStart (inclusive) to angleEnd (exclusive).
+// If angleStart equals angleEnd, a complete filled circle is drawn.
+func (t *T8Go) DrawArcFill(centerX, centerY, radius int16, angleStart, angleEnd uint8) {
+	if t.traced() {
+		t.tracer("DrawArcFill", centerX, centerY, radius, int16(angleStart), int16(angleEnd))
+	}
+	t.drawArcFill(centerX, centerY, radius, angleStart, angleEnd)
+}
+
+// drawArcFill draws DrawArcFill without reporting it to the tracer.
+func (t *T8Go) drawArcFill(centerX, centerY, radius int16, angleStart, angleEnd uint8) {
+	if t.transformed {
+		t.transformedArcFill(centerX, centerY, radius, angleStart, angleEnd)
+		return
+	}
+
+	cx, cy, r := int32(centerX), int32(centerY), int32(radius)
+	if radius <= 0 || !t.visible32(cx-r, cy-r, cx+r, cy+r) {
+		return
+	}
+
+	// Fast path: full sector -> full circle fill
+	if angleStart == angleEnd {
+		t.drawCircleFill(centerX, centerY, radius, DrawAll)
+		return
+	}
+
+	t.fillSector(centerX, centerY, radius, -1, angleStart, angleEnd)
+}
+
+// DrawArcThick draws an arc thickness pixels thick, grown inwards from the
+// arc of DrawArc: the part of the ring of DrawCircleThick between the two
+// angles, with straight radial ends. If angleStart equals angleEnd, the
+// whole ring is drawn. Thicknesses of 1 or less draw like DrawArc.
+func (t *T8Go) DrawArcThick(centerX, centerY, radius, thickness int16, angleStart, angleEnd uint8) {
+	if t.traced() {
+		t.tracer("DrawArcThick", centerX, centerY, radius, thickness, int16(angleStart), int16(angleEnd))
+	}
+	t.drawArcThick(centerX, centerY, radius, thickness, angleStart, angleEnd)
+}
+
+// drawArcThick draws DrawArcThick without reporting it to the tracer.
+func (t *T8Go) drawArcThick(centerX, centerY, radius, thickness int16, angleStart, angleEnd uint8) {
+	if t.transformed {
+		t.transformedArcThick(centerX, centerY, radius, thickness, angleStart, angleEnd)
+		return
+	}
+
+	if thickness <= 1 {
+		t.drawArc(centerX, centerY, radius, angleStart, angleEnd)
+		return
+	}
+
+	cx, cy, r := int32(centerX), int32(centerY), int32(radius)
+	if radius <= 0 || !t.visible32(cx-r, cy-r, cx+r, cy+r) {
+		return
+	}
+	if angleStart == angleEnd {
+		t.drawCircleThick(centerX, centerY, radius, thickness, DrawAll)
+		return
+	}
+
+	t.fillSector(centerX, centerY, radius, int32(radius)-int32(thickness), angleStart, angleEnd)
+}
+
+// fillSector fills the part of the disc of the given radius between the two
+// angles, leaving out the disc of radius inner (none when negative).
+func (t *T8Go) fillSector(centerX, centerY, radius int16, inner int32, angleStart, angleEnd uint8) {
+	cx, cy := int32(centerX), int32(centerY)
+	sector := arcSector{
+		startX: int32(helpers.Cos256(angleStart)),
+		startY: int32(helpers.Sin256(angleStart)),
+		endX:   int32(helpers.Cos256(angleEnd)),
+		endY:   int32(helpers.Sin256(angleEnd)),
+		sweep:  angleEnd - angleStart,
+	}
+
+	// Intersect every row of the circle with the two radial half-planes.
+	// Rows above the center have a positive mathematical Y (screen Y grows downward).
+	circleRows(radius, func(offsetY, halfWidth int16) {
+		hole, ok := discHalfWidth(inner, int32(offsetY))
+		if !ok {
+			hole = -1
+		}
+		t.fillSectorRow(cx, cy-int32(offsetY), offsetY, halfWidth, hole, sector)
+		if offsetY != 0 {
+			t.fillSectorRow(cx, cy+int32(offsetY), -offsetY, halfWidth, hole, sector)
+		}
+	})
+}
+
+// fillSectorRow fills the pixels of screen row y that lie within halfWidth of centerX,
+// farther than hole from it, and inside the sector. rowY is the row's offset from the
+// center with Y pointing up. Pixels on either radial edge are included.
+func (t *T8Go) fillSectorRow(centerX, y int32, rowY, halfWidth int16, hole int32, sector arcSector) {
+	// Counterclockwise of the start edge: cross(start, p) >= 0.
+	startLo, startHi := halfPlaneRow(-sector.startY, sector.startX*int32(rowY), halfWidth)
+	// Clockwise of the end edge: cross(p, end) >= 0.
+	endLo, endHi := halfPlaneRow(sector.endY, -sector.endX*int32(rowY), halfWidth)
+
+	switch {
+	case sector.sweep < 128: // Convex sector: inside both half-planes
+		t.fillSectorSpan(centerX, y, hole, max(startLo, endLo), min(startHi, endHi))
+	case sector.sweep == 128: // Half disc: both edges lie on the same line
+		t.fillSectorSpan(centerX, y, hole, startLo, startHi)
+	case startLo > startHi:
+		t.fillSectorSpan(centerX, y, hole, endLo, endHi)
+	case endLo > endHi:
+		t.fillSectorSpan(centerX, y, hole, startLo, startHi)
+	case startLo <= endHi+1 && endLo <= startHi+1: // Reflex sector, overlapping runs merge
+		t.fillSectorSpan(centerX, y, hole, min(startLo, endLo), max(startHi, endHi))
+	default: // Reflex sector, two separate runs
+		t.fillSectorSpan(centerX, y, hole, startLo, startHi)
+		t.fillSectorSpan(centerX, y, hole, endLo, endHi)
+	}
+}
+
+// fillSectorSpan draws the run [lo, hi] (relative to centerX) of row y, if not empty,
+// leaving out the pixels within hole of centerX.
+func (t *T8Go) fillSectorSpan(centerX, y, hole, lo, hi int32) {
+	if hole >= 0 && lo <= hole && hi >= -hole {
+		t.fillSectorSpan(centerX, y, -1, lo, -hole-1)
+		t.fillSectorSpan(centerX, y, -1, hole+1, hi)
+		return
+	}
+	if lo <= hi {
+		t.hspan(centerX+lo, centerX+hi, y)
+	}
+}
+
+// halfPlaneRow returns the run [lo, hi] of x in -halfWidth..halfWidth satisfying
+// u*x + v >= 0. The run is empty when lo > hi.
+func halfPlaneRow(u, v int32, halfWidth int16) (lo, hi int32) {
+	lo, hi = -int32(halfWidth), int32(halfWidth)
+	switch {
+	case u > 0:
+		lo = max(lo, -floorDiv(v, u)) // x >= ceil(-v / u)
+	case u < 0:
+		hi = min(hi, floorDiv(v, -u)) // x <= floor(v / -u)
+	case v < 0:
+		return 1, 0
+	}
+	return lo, hi
+}
+
+// floorDiv returns floor(a / b) for b > 0.
+func floorDiv(a, b int32) int32 {
+	quotient := a / b
+	if a%b != 0 && a < 0 {
+		quotient--
+	}
+	return quotient
+}
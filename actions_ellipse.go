@@ -0,0 +1,259 @@
+//go:build !t8go_minimal
+
+package t8go
+
+import "github.com/redghc/t8go/helpers"
+
+// DrawEllipse draws an outlined ellipse centered at (centerX, centerY) with specified radii.
+// The radiusX and radiusY parameters define the horizontal and vertical extents.
+// The mask parameter controls which quadrants are drawn using DrawQuadrants flags.
+// Use DrawNone or DrawAll to draw the complete ellipse outline.
+// No operation is performed if either radius is less than or equal to zero.
+func (t *T8Go) DrawEllipse(centerX, centerY, radiusX, radiusY int16, mask DrawQuadrants) {
+	if t.traced() {
+		t.tracer("DrawEllipse", centerX, centerY, radiusX, radiusY, int16(mask))
+	}
+	t.drawEllipse(centerX, centerY, radiusX, radiusY, mask)
+}
+
+// drawEllipse draws DrawEllipse without reporting it to the tracer.
+func (t *T8Go) drawEllipse(centerX, centerY, radiusX, radiusY int16, mask DrawQuadrants) {
+	if t.transformed {
+		t.transformedEllipse(centerX, centerY, radiusX, radiusY, mask)
+		return
+	}
+
+	if radiusX <= 0 || radiusY <= 0 {
+		return
+	}
+
+	// Skip quadrants that are entirely outside the visible area.
+	mask = t.visibleQuadrants(centerX, centerY, radiusX, radiusY, mask)
+	if mask == DrawNone {
+		return
+	}
+
+	// Use int64 internally: 2*rx^2*ry overflows int32 for radii beyond ~1000 pixels.
+	rx := int64(radiusX)
+	ry := int64(radiusY)
+	rx2 := rx * rx
+	ry2 := ry * ry
+	rx2x2 := rx2 * 2
+	ry2x2 := ry2 * 2
+
+	// Region 1 (|dy/dx| < 1)
+	offsetX := rx
+	offsetY := int64(0)
+	errorAccumulator := int64(0)
+	deltaX := (1 - 2*rx) * ry2
+	deltaY := rx2
+	stopX := ry2x2 * rx
+	stopY := int64(0)
+
+	lastX, lastY := offsetX, offsetY
+	for stopX >= stopY {
+		t.drawEllipseSection(int16(offsetX), int16(offsetY), centerX, centerY, mask)
+		lastX, lastY = offsetX, offsetY
+
+		offsetY++
+		stopY += rx2x2
+		errorAccumulator += deltaY
+		deltaY += rx2x2
+
+		if 2*errorAccumulator+deltaX > 0 {
+			offsetX--
+			stopX -= ry2x2
+			errorAccumulator += deltaX
+			deltaX += ry2x2
+		}
+	}
+
+	// Region 2 (|dy/dx| >= 1)
+	offsetX = 0
+	offsetY = ry
+	errorAccumulator = 0
+	deltaX = ry2
+	deltaY = (1 - 2*ry) * rx2
+	stopX = 0
+	stopY = rx2x2 * ry
+
+	for stopX <= stopY {
+		// Region 2 ends where region 1 did: past it, its points repeat those of region 1.
+		if offsetY < lastY || offsetY == lastY && offsetX >= lastX {
+			break
+		}
+		t.drawEllipseSection(int16(offsetX), int16(offsetY), centerX, centerY, mask)
+
+		offsetX++
+		stopX += ry2x2
+		errorAccumulator += deltaX
+		deltaX += ry2x2
+
+		if 2*errorAccumulator+deltaY > 0 {
+			offsetY--
+			stopY -= rx2x2
+			errorAccumulator += deltaY
+			deltaY += rx2x2
+		}
+	}
+}
+
+// drawEllipseSection plots the symmetric points of an ellipse for the given offsets,
+// filtered by the mask to draw only the selected quadrants. Points on the axes,
+// shared by two quadrants, are plotted once.
+func (t *T8Go) drawEllipseSection(offsetX, offsetY, centerX, centerY int16, mask DrawQuadrants) {
+	if offsetY < 0 {
+		return
+	}
+
+	cx, cy := int32(centerX), int32(centerY)
+	ox, oy := int32(offsetX), int32(offsetY)
+	if ox == 0 || oy == 0 {
+		t.drawAxisPoints(cx, cy, ox, oy, mask)
+		return
+	}
+	if mask.has(DrawTopRight) {
+		t.plot(cx+ox, cy-oy)
+	}
+	if mask.has(DrawTopLeft) {
+		t.plot(cx-ox, cy-oy)
+	}
+	if mask.has(DrawBottomRight) {
+		t.plot(cx+ox, cy+oy)
+	}
+	if mask.has(DrawBottomLeft) {
+		t.plot(cx-ox, cy+oy)
+	}
+}
+
+// DrawEllipseFill draws a filled ellipse centered at (centerX, centerY) with specified radii.
+// The radiusX and radiusY parameters define the horizontal and vertical extents.
+// The mask parameter controls which quadrants are filled using DrawQuadrants flags.
+// Use DrawNone or DrawAll to fill the complete ellipse area.
+// No operation is performed if either radius is less than or equal to zero.
+func (t *T8Go) DrawEllipseFill(centerX, centerY, radiusX, radiusY int16, mask DrawQuadrants) {
+	if t.traced() {
+		t.tracer("DrawEllipseFill", centerX, centerY, radiusX, radiusY, int16(mask))
+	}
+	t.drawEllipseFill(centerX, centerY, radiusX, radiusY, mask)
+}
+
+// drawEllipseFill draws DrawEllipseFill without reporting it to the tracer.
+func (t *T8Go) drawEllipseFill(centerX, centerY, radiusX, radiusY int16, mask DrawQuadrants) {
+	if t.transformed {
+		t.transformedEllipseFill(centerX, centerY, radiusX, radiusY, mask)
+		return
+	}
+
+	if radiusX <= 0 || radiusY <= 0 {
+		return
+	}
+
+	// Skip quadrants that are entirely outside the visible area.
+	mask = t.visibleQuadrants(centerX, centerY, radiusX, radiusY, mask)
+	if mask == DrawNone {
+		return
+	}
+
+	// Collect the widest offsetX of every row first, so each row is filled exactly once.
+	// The midpoint terms use int64: 2*rx^2*ry overflows int32 for radii beyond ~1000 pixels.
+	spans := t.rows
+	spans.reset(helpers.ClampInt16(int32(centerY)-int32(radiusY)), helpers.ClampInt16(int32(centerY)+int32(radiusY)))
+
+	rx := int64(radiusX)
+	ry := int64(radiusY)
+	rx2 := rx * rx   // rx^2
+	ry2 := ry * ry   // ry^2
+	rx2x2 := rx2 * 2 // 2*rx^2
+	ry2x2 := ry2 * 2 // 2*ry^2
+
+	// Region 1 (horizontal-dominant): stopX >= stopY
+	offsetX := int64(radiusX)
+	offsetY := int64(0)
+
+	deltaXChange := (1 - 2*int64(radiusX)) * ry2
+	deltaYChange := rx2
+	errorAccumulator := int64(0)
+
+	stopX := ry2x2 * int64(radiusX)
+	stopY := int64(0)
+
+	for stopX >= stopY {
+		addEllipseRow(spans, centerY, int16(offsetX), int16(offsetY))
+
+		offsetY++
+		stopY += rx2x2
+		errorAccumulator += deltaYChange
+		deltaYChange += rx2x2
+
+		if 2*errorAccumulator+deltaXChange > 0 {
+			offsetX--
+			stopX -= ry2x2
+			errorAccumulator += deltaXChange
+			deltaXChange += ry2x2
+		}
+	}
+
+	// Region 2 (vertical-dominant): stopX <= stopY
+	offsetX = 0
+	offsetY = int64(radiusY)
+
+	deltaXChange = ry2
+	deltaYChange = (1 - 2*int64(radiusY)) * rx2
+	errorAccumulator = 0
+
+	stopX = 0
+	stopY = rx2x2 * int64(radiusY)
+
+	for stopX <= stopY {
+		addEllipseRow(spans, centerY, int16(offsetX), int16(offsetY))
+
+		offsetX++
+		stopX += ry2x2
+		errorAccumulator += deltaXChange
+		deltaXChange += ry2x2
+
+		if 2*errorAccumulator+deltaYChange > 0 {
+			offsetY--
+			stopY -= rx2x2
+			errorAccumulator += deltaYChange
+			deltaYChange += rx2x2
+		}
+	}
+
+	// Fill from the outermost rows inwards; each row is at least as wide as the rows beyond it.
+	widest := int16(-1)
+	for rowY := radiusY; rowY >= 0; rowY-- {
+		slot, ok := ellipseRowSlot(spans, centerY, rowY)
+		if !ok {
+			continue
+		}
+		if row := spans[slot]; !row.IsEmpty() {
+			widest = max(widest, row.maxX)
+		}
+		if widest >= 0 {
+			t.fillQuadrantRows(centerX, centerY, rowY, widest, mask)
+		}
+	}
+}
+
+// addEllipseRow records offsetX as a candidate half width for the rows centerY±offsetY.
+func addEllipseRow(spans scanlines, centerY, offsetX, offsetY int16) {
+	if slot, ok := ellipseRowSlot(spans, centerY, offsetY); ok {
+		spans[slot].AddPoint(offsetX)
+	}
+}
+
+// ellipseRowSlot returns the display row used to store the half width of the
+// symmetric rows centerY±offsetY: the lower row when it is visible, otherwise
+// the upper one. It returns false when neither row is visible.
+func ellipseRowSlot(spans scanlines, centerY, offsetY int16) (int16, bool) {
+	height := int32(len(spans))
+	if lower := int32(centerY) + int32(offsetY); lower >= 0 && lower < height {
+		return int16(lower), true
+	}
+	if upper := int32(centerY) - int32(offsetY); upper >= 0 && upper < height {
+		return int16(upper), true
+	}
+	return 0, false
+}
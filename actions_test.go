@@ -0,0 +1,81 @@
+package t8go_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/drawtest"
+	"github.com/redghc/t8go/drivers/memory"
+)
+
+// newContext returns a context drawing on a 128 x 64 memory display.
+func newContext(tb testing.TB) t8go.IDisplayDrawer {
+	tb.Helper()
+	display, err := memory.New(memory.Config{Width: 128, Height: 64})
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return t8go.New(display)
+}
+
+func BenchmarkDrawTriangleFill(b *testing.B) {
+	ctx := newContext(b)
+	b.ReportAllocs()
+	for b.Loop() {
+		ctx.DrawTriangleFill(4, 60, 64, 2, 124, 50)
+	}
+}
+
+func BenchmarkDrawArcFill(b *testing.B) {
+	ctx := newContext(b)
+	b.ReportAllocs()
+	for b.Loop() {
+		ctx.DrawArcFill(64, 32, 30, 20, 180)
+	}
+}
+
+func TestFillsDoNotAllocate(t *testing.T) {
+	ctx := newContext(t)
+	fills := map[string]func(){
+		"DrawTriangleFill": func() { ctx.DrawTriangleFill(4, 60, 64, 2, 124, 50) },
+		"DrawArcFill":      func() { ctx.DrawArcFill(64, 32, 30, 20, 180) },
+	}
+	for name, fill := range fills {
+		if allocs := testing.AllocsPerRun(100, fill); allocs != 0 {
+			t.Errorf("%s: %v allocations per call, want 0", name, allocs)
+		}
+	}
+}
+
+// extremes are the int16 values substituted into the arguments of every
+// primitive by TestPrimitivesAtExtremes.
+var extremes = []int16{math.MinInt16, math.MinInt16 + 1, -1, 0, 1, math.MaxInt16 - 1, math.MaxInt16}
+
+func TestPrimitivesAtExtremes(t *testing.T) {
+	base := [6]int16{10, 8, 30, 20, 4, 9}
+	for _, primitive := range drawtest.Primitives {
+		var table [][6]int16
+		for _, value := range extremes {
+			// Every argument at once, then one coordinate or radius at a time
+			table = append(table, [6]int16{value, value, value, value, value, value})
+			for i := range primitive.Args {
+				args := base
+				args[i] = value
+				table = append(table, args)
+			}
+		}
+		table = append(table,
+			[6]int16{math.MinInt16, math.MaxInt16, math.MinInt16, math.MaxInt16, math.MinInt16, math.MaxInt16},
+			[6]int16{math.MaxInt16, math.MinInt16, math.MaxInt16, math.MinInt16, math.MaxInt16, math.MinInt16},
+		)
+
+		t.Run(primitive.Name, func(t *testing.T) {
+			for _, args := range table {
+				if err := drawtest.CheckPrimitive(primitive, args); err != nil {
+					t.Error(err)
+				}
+			}
+		})
+	}
+}
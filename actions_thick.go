@@ -0,0 +1,217 @@
+package t8go
+
+import "github.com/redghc/t8go/helpers"
+
+// DrawLineThick draws a line width pixels thick from (startX, startY) to
+// (endX, endY), centered on the path of DrawLine (one pixel more on the
+// right of the direction of travel for even widths). Lines 3 pixels or wider
+// get round ends, so lines sharing endpoints join without gaps or notches,
+// and a polyline can be drawn segment by segment. Widths of 1 or less draw
+// like DrawLine.
+func (t *T8Go) DrawLineThick(startX, startY, endX, endY, width int16) {
+	if t.traced() {
+		t.tracer("DrawLineThick", startX, startY, endX, endY, width)
+	}
+	t.drawLineThick(startX, startY, endX, endY, width)
+}
+
+// drawLineThick draws DrawLineThick without reporting it to the tracer.
+func (t *T8Go) drawLineThick(startX, startY, endX, endY, width int16) {
+	if t.transformed {
+		t.transformedLineThick(startX, startY, endX, endY, width)
+		return
+	}
+
+	if width <= 1 {
+		t.drawLine(startX, startY, endX, endY)
+		return
+	}
+
+	// Pixels on each side of the center line
+	left := int32(width-1) / 2
+	right := int32(width-1) - left
+
+	x0, y0, x1, y1 := int32(startX), int32(startY), int32(endX), int32(endY)
+	if !t.visible32(min(x0, x1)-right, min(y0, y1)-right, max(x0, x1)+right, max(y0, y1)+right) {
+		return
+	}
+	if left == 0 && x0 == x1 && y0 == y1 {
+		t.fillRect(x0, y0, x0+right, y0+right)
+		return
+	}
+
+	// Collect the body and the round ends into the reusable per-row spans
+	// and fill every row once, so the ends do not overlap the body.
+	spans := t.rows
+	minY, maxY := spans.reset(helpers.ClampInt16(min(y0, y1)-right), helpers.ClampInt16(max(y0, y1)+right))
+	if x0 != x1 || y0 != y1 {
+		// Unit normal of the direction, as a fraction of the length. Long
+		// lines are shortened first so the squares fit in 32 bits.
+		dx, dy := x1-x0, y1-y0
+		for helpers.Abs(dx) > 16383 || helpers.Abs(dy) > 16383 {
+			dx, dy = dx/2, dy/2
+		}
+		length := max(isqrt32(uint32(dx*dx+dy*dy)), 1)
+		leftX, leftY := roundDiv32(dy*left, length), roundDiv32(-dx*left, length)
+		rightX, rightY := roundDiv32(-dy*right, length), roundDiv32(dx*right, length)
+
+		scanAddQuadToSpans(spans,
+			x0+leftX, y0+leftY, x1+leftX, y1+leftY,
+			x1+rightX, y1+rightY, x0+rightX, y0+rightY,
+		)
+	}
+	if left > 0 {
+		scanAddDiscToSpans(spans, x0, y0, int16(left))
+		scanAddDiscToSpans(spans, x1, y1, int16(left))
+	}
+	t.fillSpans(minY, maxY)
+}
+
+// DrawBoxThick draws the outline of a box like DrawBox, with edges thickness
+// pixels thick grown inwards, so the outer size stays width x height. Boxes
+// too small for the hole are filled. Thicknesses of 1 or less draw like
+// DrawBox.
+func (t *T8Go) DrawBoxThick(originX, originY, width, height, thickness int16) {
+	if t.traced() {
+		t.tracer("DrawBoxThick", originX, originY, width, height, thickness)
+	}
+	t.drawBoxThick(originX, originY, width, height, thickness)
+}
+
+// drawBoxThick draws DrawBoxThick without reporting it to the tracer.
+func (t *T8Go) drawBoxThick(originX, originY, width, height, thickness int16) {
+	if t.transformed {
+		t.transformedBoxThick(originX, originY, width, height, thickness)
+		return
+	}
+
+	if thickness <= 1 {
+		t.drawBox(originX, originY, width, height)
+		return
+	}
+
+	minX, minY, maxX, maxY, ok := boxBounds(originX, originY, width, height)
+	if !ok || !t.visible32(minX, minY, maxX, maxY) {
+		return
+	}
+
+	edge := int32(thickness)
+	if maxX-minX+1 <= 2*edge || maxY-minY+1 <= 2*edge {
+		t.fillRect(minX, minY, maxX, maxY)
+		return
+	}
+	t.fillRect(minX, minY, maxX, minY+edge-1)
+	t.fillRect(minX, maxY-edge+1, maxX, maxY)
+	t.fillRect(minX, minY+edge, minX+edge-1, maxY-edge)
+	t.fillRect(maxX-edge+1, minY+edge, maxX, maxY-edge)
+}
+
+// DrawCircleThick draws a ring thickness pixels thick, grown inwards from
+// the circle of DrawCircle, so its outer edge matches DrawCircleFill. The
+// mask selects the quadrants like DrawCircle; a thickness reaching the
+// center draws a disc. Thicknesses of 1 or less draw like DrawCircle.
+func (t *T8Go) DrawCircleThick(centerX, centerY, radius, thickness int16, mask DrawQuadrants) {
+	if t.traced() {
+		t.tracer("DrawCircleThick", centerX, centerY, radius, thickness, int16(mask))
+	}
+	t.drawCircleThick(centerX, centerY, radius, thickness, mask)
+}
+
+// drawCircleThick draws DrawCircleThick without reporting it to the tracer.
+func (t *T8Go) drawCircleThick(centerX, centerY, radius, thickness int16, mask DrawQuadrants) {
+	if t.transformed {
+		t.transformedCircleThick(centerX, centerY, radius, thickness, mask)
+		return
+	}
+
+	if thickness <= 1 {
+		t.drawCircle(centerX, centerY, radius, mask)
+		return
+	}
+	if radius <= 0 {
+		return
+	}
+
+	mask = t.visibleQuadrants(centerX, centerY, radius, radius, mask)
+	if mask == DrawNone {
+		return
+	}
+
+	cx, cy := int32(centerX), int32(centerY)
+	inner := int32(radius) - int32(thickness)
+	circleRows(radius, func(offsetY, halfWidth int16) {
+		hole, ok := discHalfWidth(inner, int32(offsetY))
+		if !ok {
+			t.fillQuadrantRows(centerX, centerY, offsetY, halfWidth, mask)
+			return
+		}
+
+		top, bottom := cy-int32(offsetY), cy+int32(offsetY)
+		left := mask.has(DrawTopLeft) || offsetY == 0 && mask.has(DrawBottomLeft)
+		right := mask.has(DrawTopRight) || offsetY == 0 && mask.has(DrawBottomRight)
+		t.fillRingRow(cx, top, int32(halfWidth), hole, left, right)
+		if offsetY != 0 {
+			t.fillRingRow(cx, bottom, int32(halfWidth), hole, mask.has(DrawBottomLeft), mask.has(DrawBottomRight))
+		}
+	})
+}
+
+// fillRingRow fills the left and/or right part of row y of a ring centered
+// at centerX, from hole+1 to halfWidth pixels away from the center.
+func (t *T8Go) fillRingRow(centerX, y, halfWidth, hole int32, left, right bool) {
+	if hole >= halfWidth {
+		return
+	}
+	if left {
+		t.hspan(centerX-halfWidth, centerX-hole-1, y)
+	}
+	if right {
+		t.hspan(centerX+hole+1, centerX+halfWidth, y)
+	}
+}
+
+// fillQuad fills the convex quadrilateral with the given corners, in order
+// around its edge, using the reusable per-row spans.
+func (t *T8Go) fillQuad(x1, y1, x2, y2, x3, y3, x4, y4 int32) {
+	minY, maxY := t.rows.reset(
+		helpers.ClampInt16(min(y1, y2, y3, y4)),
+		helpers.ClampInt16(max(y1, y2, y3, y4)),
+	)
+	scanAddQuadToSpans(t.rows, x1, y1, x2, y2, x3, y3, x4, y4)
+	t.fillSpans(minY, maxY)
+}
+
+// scanAddQuadToSpans widens spans to cover the edges of the quadrilateral
+// with the given corners, in order around its edge.
+func scanAddQuadToSpans(spans scanlines, x1, y1, x2, y2, x3, y3, x4, y4 int32) {
+	corners := [...]int16{
+		helpers.ClampInt16(x1), helpers.ClampInt16(y1),
+		helpers.ClampInt16(x2), helpers.ClampInt16(y2),
+		helpers.ClampInt16(x3), helpers.ClampInt16(y3),
+		helpers.ClampInt16(x4), helpers.ClampInt16(y4),
+	}
+	for i := 0; i < 8; i += 2 {
+		next := (i + 2) % 8
+		scanAddLineToSpans(spans, corners[i], corners[i+1], corners[next], corners[next+1])
+	}
+}
+
+// scanAddDiscToSpans widens spans to cover the disc DrawCircleFill draws
+// with the given center and radius.
+func scanAddDiscToSpans(spans scanlines, centerX, centerY int32, radius int16) {
+	circleRows(radius, func(offsetY, halfWidth int16) {
+		updateSpan(spans, centerX-int32(halfWidth), centerY-int32(offsetY))
+		updateSpan(spans, centerX+int32(halfWidth), centerY-int32(offsetY))
+		updateSpan(spans, centerX-int32(halfWidth), centerY+int32(offsetY))
+		updateSpan(spans, centerX+int32(halfWidth), centerY+int32(offsetY))
+	})
+}
+
+// roundDiv32 returns numerator / denominator rounded to the nearest integer,
+// halves away from zero, for a positive denominator.
+func roundDiv32(numerator, denominator int32) int32 {
+	if numerator < 0 {
+		return -((-numerator + denominator/2) / denominator)
+	}
+	return (numerator + denominator/2) / denominator
+}
@@ -0,0 +1,121 @@
+// Package anim provides tweening and easing for animating integer properties
+// such as positions and sizes. All easing curves use fixed-point arithmetic,
+// so animations run on microcontrollers without a floating point unit.
+package anim
+
+import (
+	"time"
+
+	"github.com/redghc/t8go/fixed"
+)
+
+// * ----- Tween -----
+
+// Update advances the tween by dt and writes the new value to Target.
+// Returns true while the tween is still running.
+func (tw *Tween) Update(dt time.Duration) bool {
+	if tw.done {
+		return false
+	}
+
+	tw.elapsed += dt
+	if tw.elapsed >= tw.Duration {
+		tw.elapsed = tw.Duration
+		tw.done = true
+	}
+
+	tw.value = tw.valueAt(tw.Progress())
+	if tw.Target != nil {
+		*tw.Target = tw.value
+	}
+
+	if tw.done && tw.OnDone != nil {
+		tw.OnDone()
+	}
+	return !tw.done
+}
+
+// Progress returns the linear (non-eased) progress of the tween in 0..Unit.
+func (tw *Tween) Progress() uint16 {
+	if tw.Duration <= 0 || tw.elapsed >= tw.Duration {
+		return Unit
+	}
+	return uint16(int64(tw.elapsed) * Unit / int64(tw.Duration))
+}
+
+// Value returns the value computed by the last Update call.
+func (tw *Tween) Value() int16 {
+	return tw.value
+}
+
+// Done reports whether the tween has completed.
+func (tw *Tween) Done() bool {
+	return tw.done
+}
+
+// Reset rewinds the tween to its start so it can be played again.
+func (tw *Tween) Reset() {
+	tw.elapsed = 0
+	tw.value = tw.From
+	tw.done = false
+}
+
+// valueAt interpolates between From and To for the given linear progress.
+func (tw *Tween) valueAt(progress uint16) int16 {
+	easing := tw.Easing
+	if easing == nil {
+		easing = Linear
+	}
+
+	eased := fixed.Q8(easing(int16(progress))).Q16()
+	return fixed.Q16FromInt(tw.From).Lerp(fixed.Q16FromInt(tw.To), eased).Round()
+}
+
+// * ----- Manager -----
+
+// NewManager creates a manager that can run up to capacity tweens at once.
+func NewManager(capacity int) *Manager {
+	return &Manager{
+		tweens: make([]*Tween, 0, capacity),
+	}
+}
+
+// Add registers a tween with the manager, rewinding it to its start.
+// Returns ErrManagerFull if the manager has no free slot.
+func (m *Manager) Add(tw *Tween) error {
+	if tw == nil {
+		return ErrNilTween
+	}
+	if len(m.tweens) == cap(m.tweens) {
+		return ErrManagerFull
+	}
+
+	tw.Reset()
+	m.tweens = append(m.tweens, tw)
+	return nil
+}
+
+// Update advances all active tweens by dt and removes the ones that completed.
+func (m *Manager) Update(dt time.Duration) {
+	active := m.tweens[:0]
+	for _, tw := range m.tweens {
+		if tw.Update(dt) {
+			active = append(active, tw)
+		}
+	}
+
+	// Drop references to finished tweens so they can be collected.
+	clear(m.tweens[len(active):])
+	m.tweens = active
+}
+
+// Len returns the number of active tweens.
+func (m *Manager) Len() int {
+	return len(m.tweens)
+}
+
+// Clear removes all tweens without completing them.
+func (m *Manager) Clear() {
+	clear(m.tweens)
+	m.tweens = m.tweens[:0]
+}
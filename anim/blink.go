@@ -0,0 +1,106 @@
+package anim
+
+import "time"
+
+// NewBlinker creates a blinker that can manage up to capacity regions.
+func NewBlinker(capacity int) *Blinker {
+	return &Blinker{
+		entries: make([]blinkEntry, capacity),
+		dirty:   make([]Region, 0, capacity),
+	}
+}
+
+// Add registers a region that toggles every interval. The draw callback is
+// invoked with the new state on each toggle and must redraw the region.
+// Regions start visible. Returns the entry ID used by Remove and Reset.
+func (b *Blinker) Add(region Region, interval time.Duration, draw func(on bool)) (int, error) {
+	if interval <= 0 {
+		return -1, ErrInterval
+	}
+
+	for id := range b.entries {
+		if b.entries[id].used {
+			continue
+		}
+		b.entries[id] = blinkEntry{
+			region:   region,
+			interval: interval,
+			draw:     draw,
+			visible:  true,
+			used:     true,
+		}
+		return id, nil
+	}
+	return -1, ErrBlinkerFull
+}
+
+// Remove unregisters a blinking region.
+func (b *Blinker) Remove(id int) error {
+	if !b.valid(id) {
+		return ErrInvalidID
+	}
+	b.entries[id] = blinkEntry{}
+	return nil
+}
+
+// Reset makes a region visible and restarts its interval, for example to keep
+// a cursor solid while the user is typing. The region is redrawn if it was hidden.
+func (b *Blinker) Reset(id int) error {
+	if !b.valid(id) {
+		return ErrInvalidID
+	}
+
+	entry := &b.entries[id]
+	entry.elapsed = 0
+	if !entry.visible {
+		entry.visible = true
+		if entry.draw != nil {
+			entry.draw(true)
+		}
+	}
+	return nil
+}
+
+// Visible reports the current state of a registered region.
+func (b *Blinker) Visible(id int) bool {
+	return b.valid(id) && b.entries[id].visible
+}
+
+// Update advances all regions by dt, redrawing the ones that toggled.
+// Returns the dirty regions that need to be flushed to the display; the
+// returned slice is reused by the next call to Update.
+func (b *Blinker) Update(dt time.Duration) []Region {
+	b.dirty = b.dirty[:0]
+
+	for id := range b.entries {
+		entry := &b.entries[id]
+		if !entry.used {
+			continue
+		}
+
+		entry.elapsed += dt
+		if entry.elapsed < entry.interval {
+			continue
+		}
+
+		// Skip whole periods after long stalls instead of toggling repeatedly.
+		toggles := entry.elapsed / entry.interval
+		entry.elapsed %= entry.interval
+		if toggles%2 == 0 {
+			continue
+		}
+
+		entry.visible = !entry.visible
+		if entry.draw != nil {
+			entry.draw(entry.visible)
+		}
+		b.dirty = append(b.dirty, entry.region)
+	}
+
+	return b.dirty
+}
+
+// valid reports whether id refers to a registered entry.
+func (b *Blinker) valid(id int) bool {
+	return id >= 0 && id < len(b.entries) && b.entries[id].used
+}
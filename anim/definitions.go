@@ -0,0 +1,77 @@
+package anim
+
+import (
+	"time"
+
+	"github.com/redghc/t8go"
+)
+
+// Unit is the fixed-point representation of 1.0 used for animation progress
+// (fixed.Q8One). Progress values range from 0 (start) to Unit (end) and can be
+// converted with fixed.Q8(progress).
+const Unit = 256
+
+// Easing maps a linear progress value (0..Unit) to an eased progress value.
+// Implementations must return 0 for 0 and Unit for Unit; intermediate values
+// may leave the range on either side for elastic or back-style curves, which
+// is why the eased value is signed.
+type Easing func(progress int16) int16
+
+// Tween animates a single int16 property from From to To over Duration.
+// The Target pointer, when non-nil, is updated on every Update call.
+type Tween struct {
+	Target   *int16        // Property being animated (optional)
+	From     int16         // Start value
+	To       int16         // End value
+	Duration time.Duration // Total animation time (zero completes immediately)
+	Easing   Easing        // Easing curve (defaults to Linear)
+	OnDone   func()        // Called once when the tween completes (optional)
+
+	elapsed time.Duration // Time advanced so far
+	value   int16         // Last computed value
+	done    bool          // Whether the tween has completed
+}
+
+// Manager advances a set of active tweens once per frame.
+// Its capacity is fixed at construction to avoid allocations while animating.
+type Manager struct {
+	tweens []*Tween // Active tweens, in insertion order
+}
+
+// Timeline sequences tweens and callbacks at fixed start offsets, so multi-step
+// animations can be described declaratively. Its capacity is fixed at construction.
+type Timeline struct {
+	entries []timelineEntry // Scheduled tweens and callbacks
+	elapsed time.Duration   // Time since the timeline started
+	Loop    bool            // Restart from the beginning after the last entry completes
+}
+
+// timelineEntry is a single tween or callback scheduled on a timeline.
+type timelineEntry struct {
+	start    time.Duration // Offset from the start of the timeline
+	tween    *Tween        // Tween to play (nil for callbacks)
+	callback func()        // Callback to invoke (nil for tweens)
+	started  bool          // Whether the tween started or the callback fired
+}
+
+// Region is a rectangular screen area affected by an animation.
+// It is the same type as t8go.Rect, so regions can be merged and clipped
+// with the Rect helpers.
+type Region = t8go.Rect
+
+// Blinker toggles registered regions on and off at fixed intervals, such as a
+// text cursor or an alarm indicator. Its capacity is fixed at construction.
+type Blinker struct {
+	entries []blinkEntry // Registered blink entries (slot index is the entry ID)
+	dirty   []Region     // Regions toggled by the last Update
+}
+
+// blinkEntry stores the state of a single blinking region.
+type blinkEntry struct {
+	region   Region        // Screen area redrawn on every toggle
+	interval time.Duration // Time between toggles
+	elapsed  time.Duration // Time since the last toggle
+	draw     func(on bool) // Redraws the region in its new state
+	visible  bool          // Current blink state
+	used     bool          // Whether the slot holds a registered entry
+}
@@ -0,0 +1,52 @@
+package anim
+
+import "github.com/redghc/t8go/fixed"
+
+// Linear returns the progress unchanged.
+func Linear(progress int16) int16 {
+	return progress
+}
+
+// EaseIn starts slowly and accelerates towards the end (quadratic).
+func EaseIn(progress int16) int16 {
+	p := fixed.Q8(progress)
+	return int16(p.Mul(p))
+}
+
+// EaseOut starts quickly and decelerates towards the end (quadratic).
+func EaseOut(progress int16) int16 {
+	inv := fixed.Q8One - fixed.Q8(progress)
+	return int16(fixed.Q8One - inv.Mul(inv))
+}
+
+// EaseInOut accelerates during the first half and decelerates during the second half.
+func EaseInOut(progress int16) int16 {
+	if progress < Unit/2 {
+		p := fixed.Q8(progress)
+		return int16(2 * p.Mul(p))
+	}
+	inv := fixed.Q8One - fixed.Q8(progress)
+	return int16(fixed.Q8One - 2*inv.Mul(inv))
+}
+
+// Bounce ends with a decaying bounce, like a ball dropped onto the floor.
+// The curve uses the classic piecewise-quadratic form scaled to Unit.
+func Bounce(progress int16) int16 {
+	const n1 fixed.Q16 = 495616 // 7.5625 in Q16.16
+
+	var offset, base fixed.Q16
+	switch {
+	case progress < 93: // 1/2.75
+		offset, base = 0, 0
+	case progress < 186: // 2/2.75
+		offset, base = 35747, 49152 // 1.5/2.75, 0.75
+	case progress < 233: // 2.5/2.75
+		offset, base = 53620, 61440 // 2.25/2.75, 0.9375
+	default:
+		offset, base = 62557, 64512 // 2.625/2.75, 0.984375
+	}
+
+	p := fixed.Q8(progress).Q16() - offset
+	value := n1.Mul(p).Mul(p) + base
+	return int16(min(value.Q8(), fixed.Q8One))
+}
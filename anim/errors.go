@@ -0,0 +1,13 @@
+package anim
+
+import "errors"
+
+// Common errors returned by the anim package.
+var (
+	ErrManagerFull  = errors.New("animation manager is full") // No free slot for another tween
+	ErrNilTween     = errors.New("tween cannot be nil")       // Nil tween passed to the manager
+	ErrTimelineFull = errors.New("timeline is full")          // No free slot for another entry
+	ErrBlinkerFull  = errors.New("blinker is full")           // No free slot for another region
+	ErrInterval     = errors.New("invalid blink interval")    // Interval is zero or negative
+	ErrInvalidID    = errors.New("invalid blink entry ID")    // ID does not refer to a registered entry
+)
@@ -0,0 +1,128 @@
+package anim
+
+import "time"
+
+// NewTimeline creates a timeline that can hold up to capacity tweens and callbacks.
+func NewTimeline(capacity int) *Timeline {
+	return &Timeline{
+		entries: make([]timelineEntry, 0, capacity),
+	}
+}
+
+// AddTween schedules a tween to start at the given offset from the timeline start.
+func (tl *Timeline) AddTween(start time.Duration, tw *Tween) error {
+	if tw == nil {
+		return ErrNilTween
+	}
+	return tl.add(timelineEntry{start: start, tween: tw})
+}
+
+// Append schedules a tween to start when the current last entry ends.
+func (tl *Timeline) Append(tw *Tween) error {
+	return tl.AddTween(tl.Duration(), tw)
+}
+
+// AddCallback schedules fn to be called once at the given offset.
+func (tl *Timeline) AddCallback(at time.Duration, fn func()) error {
+	return tl.add(timelineEntry{start: at, callback: fn})
+}
+
+// Duration returns the time at which the last scheduled entry ends.
+func (tl *Timeline) Duration() time.Duration {
+	var end time.Duration
+	for _, entry := range tl.entries {
+		entryEnd := entry.start
+		if entry.tween != nil {
+			entryEnd += entry.tween.Duration
+		}
+		end = max(end, entryEnd)
+	}
+	return end
+}
+
+// Update advances the timeline by dt, starting, advancing and completing
+// entries as their offsets are reached.
+// Returns true while the timeline is still running (always true when looping,
+// unless the timeline has no duration to loop over).
+func (tl *Timeline) Update(dt time.Duration) bool {
+	if tl.advance(dt) {
+		return true
+	}
+
+	duration := tl.Duration()
+	if !tl.Loop || duration <= 0 {
+		return false
+	}
+
+	// Wrap in one step, however many periods dt spans
+	overshoot := (tl.elapsed - duration) % duration
+	tl.Reset()
+	if overshoot > 0 {
+		tl.advance(overshoot)
+	}
+	return true
+}
+
+// Elapsed returns the time since the timeline started.
+func (tl *Timeline) Elapsed() time.Duration {
+	return tl.elapsed
+}
+
+// Reset rewinds the timeline to its start.
+func (tl *Timeline) Reset() {
+	tl.elapsed = 0
+	for i := range tl.entries {
+		tl.entries[i].started = false
+	}
+}
+
+// add appends an entry if there is room left.
+func (tl *Timeline) add(entry timelineEntry) error {
+	if len(tl.entries) == cap(tl.entries) {
+		return ErrTimelineFull
+	}
+	tl.entries = append(tl.entries, entry)
+	return nil
+}
+
+// advance moves the timeline forward by dt and updates every entry.
+// Returns true if any entry has not finished yet.
+func (tl *Timeline) advance(dt time.Duration) bool {
+	tl.elapsed += dt
+
+	running := false
+	for i := range tl.entries {
+		if tl.updateEntry(&tl.entries[i]) {
+			running = true
+		}
+	}
+	return running
+}
+
+// updateEntry advances a single entry to the current time.
+// Returns true if the entry has not finished yet.
+func (tl *Timeline) updateEntry(entry *timelineEntry) bool {
+	if tl.elapsed < entry.start {
+		return true
+	}
+
+	if entry.tween == nil {
+		if !entry.started {
+			entry.started = true
+			if entry.callback != nil {
+				entry.callback()
+			}
+		}
+		return false
+	}
+
+	tw := entry.tween
+	if !entry.started {
+		entry.started = true
+		tw.Reset()
+	}
+	if tw.done {
+		return false
+	}
+	return tw.Update(tl.elapsed - entry.start - tw.elapsed)
+}
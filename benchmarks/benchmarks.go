@@ -0,0 +1,161 @@
+// Package benchmarks measures the drawing primitives and driver flushes of
+// t8go and enforces an allocation budget for each of them.
+//
+// The cases run against any t8go.IDisplay; the memory driver acts as a null
+// driver so only the cost of the library itself is measured. Run is built on
+// testing.Benchmark and testing.AllocsPerRun, so it can be called both from
+// regular programs (see cmd/t8gobench) and from test code.
+package benchmarks
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/fixed"
+	"github.com/redghc/t8go/framebuf"
+)
+
+const defaultRuns = 100 // Default iterations for the allocation check
+
+// Cases returns the standard benchmark set covering every drawing primitive
+// and the driver flush. None of them is allowed to allocate.
+func Cases() []Case {
+	return []Case{
+		{Name: "ClearBuffer", Run: func(gfx t8go.IDisplayDrawer) { gfx.ClearBuffer() }},
+		{Name: "ClearRegion", Run: func(gfx t8go.IDisplayDrawer) { gfx.ClearRegion(10, 10, 100, 40) }},
+		{Name: "Display", Run: func(gfx t8go.IDisplayDrawer) { _ = gfx.Display() }},
+		{Name: "DrawPixel", Run: func(gfx t8go.IDisplayDrawer) { gfx.DrawPixel(10, 10) }},
+		{Name: "DrawLine", Run: func(gfx t8go.IDisplayDrawer) { gfx.DrawLine(0, 0, 127, 63) }},
+		{Name: "DrawHLine", Run: func(gfx t8go.IDisplayDrawer) { gfx.DrawHLine(0, 20, 128) }},
+		{Name: "DrawVLine", Run: func(gfx t8go.IDisplayDrawer) { gfx.DrawVLine(20, 0, 64) }},
+		{Name: "DrawLineAngle", Run: func(gfx t8go.IDisplayDrawer) { gfx.DrawLineAngle(64, 32, 30, 40) }},
+		{Name: "DrawBox", Run: func(gfx t8go.IDisplayDrawer) { gfx.DrawBox(10, 10, 100, 40) }},
+		{Name: "DrawBoxFill", Run: func(gfx t8go.IDisplayDrawer) { gfx.DrawBoxFill(10, 10, 100, 40) }},
+		{Name: "DrawRoundBox", Run: func(gfx t8go.IDisplayDrawer) { gfx.DrawRoundBox(10, 10, 100, 40, 8) }},
+		{Name: "DrawRoundBoxFill", Run: func(gfx t8go.IDisplayDrawer) { gfx.DrawRoundBoxFill(10, 10, 100, 40, 8) }},
+		{Name: "DrawTriangle", Run: func(gfx t8go.IDisplayDrawer) { gfx.DrawTriangle(5, 60, 64, 2, 122, 50) }},
+		{Name: "DrawTriangleFill", Run: func(gfx t8go.IDisplayDrawer) { gfx.DrawTriangleFill(5, 60, 64, 2, 122, 50) }},
+		{Name: "DrawCircle", Run: func(gfx t8go.IDisplayDrawer) { gfx.DrawCircle(64, 32, 28, t8go.DrawAll) }},
+		{Name: "DrawCircleFill", Run: func(gfx t8go.IDisplayDrawer) { gfx.DrawCircleFill(64, 32, 28, t8go.DrawAll) }},
+		{Name: "DrawEllipse", Run: func(gfx t8go.IDisplayDrawer) { gfx.DrawEllipse(64, 32, 50, 25, t8go.DrawAll) }},
+		{Name: "DrawEllipseFill", Run: func(gfx t8go.IDisplayDrawer) { gfx.DrawEllipseFill(64, 32, 50, 25, t8go.DrawAll) }},
+		{Name: "DrawArc", Run: func(gfx t8go.IDisplayDrawer) { gfx.DrawArc(64, 32, 28, 16, 176) }},
+		{Name: "DrawArcFill", Run: func(gfx t8go.IDisplayDrawer) { gfx.DrawArcFill(64, 32, 28, 16, 176) }},
+		{Name: "DrawText", Run: func(gfx t8go.IDisplayDrawer) { gfx.DrawText(0, 40, "Hello, t8go! 0123456789") }},
+		{Name: "DrawLineThick", Run: func(gfx t8go.IDisplayDrawer) { gfx.DrawLineThick(4, 60, 122, 6, 5) }},
+		{Name: "DrawBoxThick", Run: func(gfx t8go.IDisplayDrawer) { gfx.DrawBoxThick(10, 10, 100, 40, 3) }},
+		{Name: "DrawCircleThick", Run: func(gfx t8go.IDisplayDrawer) { gfx.DrawCircleThick(64, 32, 28, 4, t8go.DrawAll) }},
+		{Name: "DrawArcThick", Run: func(gfx t8go.IDisplayDrawer) { gfx.DrawArcThick(64, 32, 28, 4, 16, 176) }},
+		{Name: "DrawBitmap", Run: func(gfx t8go.IDisplayDrawer) { gfx.DrawBitmap(30, 10, 32, 32, sprite.Data) }},
+		{Name: "DrawBuffer", Run: func(gfx t8go.IDisplayDrawer) { gfx.DrawBuffer(30, 10, sprite) }},
+		{Name: "DrawNinePatch", Run: func(gfx t8go.IDisplayDrawer) { gfx.DrawNinePatch(10, 10, 100, 40, panel) }},
+		{Name: "TransformedBoxFill", Run: func(gfx t8go.IDisplayDrawer) {
+			gfx.PushTransform(turned)
+			gfx.DrawBoxFill(-20, -12, 40, 24)
+			gfx.PopTransform()
+		}},
+		{Name: "TransformedText", Run: func(gfx t8go.IDisplayDrawer) {
+			gfx.PushTransform(turned)
+			gfx.DrawText(-30, 4, "Hello, t8go!")
+			gfx.PopTransform()
+		}},
+		{Name: "TransformedBuffer", Run: func(gfx t8go.IDisplayDrawer) {
+			gfx.PushTransform(turned)
+			gfx.DrawBuffer(-16, -16, sprite)
+			gfx.PopTransform()
+		}},
+	}
+}
+
+// Images drawn by the bitmap cases and the transform of the transformed
+// cases, built once so the cases themselves do not allocate.
+var (
+	sprite = pattern(32, 32)
+	panel  = t8go.NinePatch{Bitmap: pattern(12, 12), Left: 4, Top: 4, Right: 4, Bottom: 4}
+	turned = t8go.IdentityTransform().Translate(64, 32).Rotate(20).Scale(fixed.Q16One*3/2, fixed.Q16One*3/2)
+)
+
+// pattern returns a width x height buffer with a fixed mix of lit and unlit
+// pixels.
+func pattern(width, height int) framebuf.Buffer {
+	buffer := framebuf.Buffer{Width: width, Height: height, Data: make([]byte, framebuf.Size(width, height))}
+	for i := range buffer.Data {
+		buffer.Data[i] = byte(i*37 + 11)
+	}
+	return buffer
+}
+
+// Run measures every case against display and returns one result per case.
+// The display is wrapped with t8go.New once and shared by all cases.
+func Run(display t8go.IDisplay, cases []Case, config Config) ([]Result, error) {
+	if display == nil {
+		return nil, ErrNilDisplay
+	}
+
+	runs := config.Runs
+	if runs <= 0 {
+		runs = defaultRuns
+	}
+
+	gfx := t8go.New(display)
+	results := make([]Result, 0, len(cases))
+
+	for _, c := range cases {
+		result := Result{
+			Name:   c.Name,
+			Budget: c.Budget,
+		}
+
+		result.AllocsPerOp = testing.AllocsPerRun(runs, func() {
+			c.Run(gfx)
+		})
+
+		if !config.SkipTimed {
+			timed := testing.Benchmark(func(b *testing.B) {
+				b.ReportAllocs()
+				for b.Loop() {
+					c.Run(gfx)
+				}
+			})
+			result.NsPerOp = timed.NsPerOp()
+			result.BytesPerOp = timed.AllocedBytesPerOp()
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// Check returns an error wrapping ErrBudgetExceeded for every result that
+// allocated more than its budget, or nil if all results are within budget.
+func Check(results []Result) error {
+	var errs []error
+	for _, r := range results {
+		if r.AllocsPerOp > r.Budget {
+			errs = append(errs, fmt.Errorf("%w: %s %.1f allocs/op (budget %.1f)", ErrBudgetExceeded, r.Name, r.AllocsPerOp, r.Budget))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Write prints the results as an aligned text table.
+func Write(w io.Writer, results []Result) error {
+	if _, err := fmt.Fprintf(w, "%-20s %12s %10s %12s %8s\n", "case", "ns/op", "B/op", "allocs/op", "budget"); err != nil {
+		return err
+	}
+
+	for _, r := range results {
+		status := ""
+		if r.AllocsPerOp > r.Budget {
+			status = "  OVER"
+		}
+		if _, err := fmt.Fprintf(w, "%-20s %12d %10d %12.1f %8.1f%s\n", r.Name, r.NsPerOp, r.BytesPerOp, r.AllocsPerOp, r.Budget, status); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package benchmarks
+
+import (
+	"testing"
+
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/drivers/memory"
+)
+
+// newDisplay returns a 128 x 64 memory display, the null driver of the cases.
+func newDisplay(tb testing.TB) t8go.IDisplay {
+	tb.Helper()
+	display, err := memory.New(memory.Config{Width: 128, Height: 64})
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return display
+}
+
+func BenchmarkCases(b *testing.B) {
+	gfx := t8go.New(newDisplay(b))
+	for _, c := range Cases() {
+		b.Run(c.Name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				c.Run(gfx)
+			}
+		})
+	}
+}
+
+func TestCasesWithinBudget(t *testing.T) {
+	results, err := Run(newDisplay(t), Cases(), Config{SkipTimed: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Check(results); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,235 @@
+package benchmarks
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/helpers"
+)
+
+// chartArea is the part of the standard 128x64 screen holding the charts.
+var chartArea = t8go.Rect{X: 0, Y: 32, Width: 128, Height: 32}
+
+// digits backs the frame counter, sliced per digit so drawing it never allocates.
+const digits = "0123456789"
+
+// Workloads returns the standard frame workloads for a 128x64 display:
+// "full" redraws a whole dashboard (clear, fills, text and charts) and
+// flushes the buffer, "partial" redraws only the charts and flushes their
+// area, as a partial update would.
+func Workloads() []Workload {
+	return []Workload{
+		{Name: "full", Draw: drawDashboard},
+		{Name: "partial", Draw: drawCharts, Region: chartArea},
+	}
+}
+
+// Links returns bus models for the common SSD1306 wirings.
+func Links() []Link {
+	return []Link{
+		{Name: "I2C-100k", BitsPerSecond: 100_000, BitsPerByte: 9, Overhead: 10},
+		{Name: "I2C-400k", BitsPerSecond: 400_000, BitsPerByte: 9, Overhead: 10},
+		{Name: "I2C-1M", BitsPerSecond: 1_000_000, BitsPerByte: 9, Overhead: 10},
+		{Name: "SPI-8M", BitsPerSecond: 8_000_000, BitsPerByte: 8, Overhead: 6},
+	}
+}
+
+// FPS returns the frame rate the link allows when every frame transfers
+// bytesPerFrame bytes, ignoring drawing time.
+func (l Link) FPS(bytesPerFrame int64) float64 {
+	bits := (bytesPerFrame + l.Overhead) * l.BitsPerByte
+	if bits <= 0 {
+		return 0
+	}
+	return float64(l.BitsPerSecond) / float64(bits)
+}
+
+// FPS returns the frames per second reached on this host, or 0 when timing
+// was skipped.
+func (r FrameResult) FPS() float64 {
+	if r.NsPerFrame <= 0 {
+		return 0
+	}
+	return 1e9 / float64(r.NsPerFrame)
+}
+
+// Compare runs every workload on every target and returns one result per
+// pair. Bytes and allocations are averaged over config.Runs frames; the first
+// flush error stops the comparison.
+func Compare(targets []Target, workloads []Workload, config Config) ([]FrameResult, error) {
+	runs := config.Runs
+	if runs <= 0 {
+		runs = defaultRuns
+	}
+
+	results := make([]FrameResult, 0, len(targets)*len(workloads))
+	for _, target := range targets {
+		if target.Display == nil {
+			return results, fmt.Errorf("%w: target %s", ErrNilDisplay, target.Name)
+		}
+		gfx := t8go.New(target.Display)
+
+		for _, workload := range workloads {
+			result, err := measure(gfx, target, workload, runs, config.SkipTimed)
+			if err != nil {
+				return results, fmt.Errorf("%s/%s: %w", target.Name, workload.Name, err)
+			}
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
+
+// measure runs workload on gfx and collects its FrameResult.
+func measure(gfx t8go.IDisplayDrawer, target Target, workload Workload, runs int, skipTimed bool) (FrameResult, error) {
+	var frame int
+	var flushErr error
+	run := func() {
+		workload.Draw(gfx, frame)
+		frame++
+
+		var err error
+		if workload.Region.Empty() {
+			err = gfx.Display()
+		} else {
+			err = gfx.FlushRegion(workload.Region)
+		}
+		if err != nil && flushErr == nil {
+			flushErr = err
+		}
+	}
+
+	result := FrameResult{
+		Target:   target.Name,
+		Workload: workload.Name,
+	}
+
+	// Start from a fully drawn screen, as the partial workload expects
+	drawDashboard(gfx, 0)
+	if err := gfx.Display(); err != nil {
+		return result, err
+	}
+
+	var sent int64
+	if target.Transferred != nil {
+		sent = target.Transferred()
+	}
+	result.AllocsPerFrame = testing.AllocsPerRun(runs, run)
+
+	// AllocsPerRun runs one extra warm-up frame
+	if target.Transferred != nil {
+		result.BytesPerFrame = (target.Transferred() - sent) / int64(runs+1)
+	} else {
+		result.BytesPerFrame = flushBytes(gfx, target.Display, workload.Region)
+	}
+
+	if !skipTimed {
+		timed := testing.Benchmark(func(b *testing.B) {
+			for b.Loop() {
+				run()
+			}
+		})
+		result.NsPerFrame = timed.NsPerOp()
+	}
+
+	return result, flushErr
+}
+
+// flushBytes returns the buffer bytes a flush of region hands to display:
+// the page rows covering region with a region flusher, the whole buffer
+// otherwise.
+func flushBytes(gfx t8go.IDisplayDrawer, display t8go.IDisplay, region t8go.Rect) int64 {
+	if _, ok := display.(t8go.IRegionFlusher); !ok || region.Empty() {
+		return int64(display.BufferSize())
+	}
+
+	region = region.Intersect(gfx.Bounds())
+	if region.Empty() {
+		return 0
+	}
+	corner := region.Max()
+	pages := int64(corner.Y/8 - region.Y/8 + 1)
+	return pages * int64(region.Width)
+}
+
+// drawDashboard draws the full-screen workload: a header with a frame
+// counter, filled shapes and the charts.
+func drawDashboard(gfx t8go.IDisplayDrawer, frame int) {
+	gfx.ClearBuffer()
+
+	gfx.DrawRoundBoxFill(0, 0, 128, 12, 3)
+	gfx.ClearRegion(2, 2, 70, 8)
+	gfx.DrawText(3, 9, "t8go bench")
+	for i, value := 0, frame; i < 5; i, value = i+1, value/10 {
+		gfx.DrawText(int16(120-6*i), 9, digits[value%10:value%10+1])
+	}
+
+	gfx.DrawBoxFill(0, 14, 40, 16)
+	gfx.DrawCircleFill(56, 22, 7, t8go.DrawAll)
+	gfx.DrawTriangleFill(70, 29, 80, 15, 90, 29)
+	gfx.DrawBox(96, 14, 32, 16)
+	gfx.DrawBoxFill(98, 16, int16(frame%29), 12)
+
+	drawCharts(gfx, frame)
+}
+
+// drawCharts redraws the chart area: a bar chart and a moving line chart.
+func drawCharts(gfx t8go.IDisplayDrawer, frame int) {
+	area := chartArea
+	gfx.ClearRegion(area.X, area.Y, area.Width, area.Height)
+	bottom := area.Y + area.Height - 1
+
+	// Bar chart
+	for bar := range int16(8) {
+		height := wave(frame*4+int(bar)*32, area.Height-2)
+		gfx.DrawBoxFill(bar*8, bottom-height, 6, height+1)
+	}
+
+	// Line chart
+	gfx.DrawHLine(66, bottom, 62)
+	previous := bottom - wave(frame*4, area.Height-2)
+	for x := int16(1); x < 62; x++ {
+		y := bottom - wave(frame*4+int(x)*8, area.Height-2)
+		gfx.DrawLine(66+x-1, previous, 66+x, y)
+		previous = y
+	}
+}
+
+// wave returns a value in 0..amplitude following a sine of phase (256 units per period).
+func wave(phase int, amplitude int16) int16 {
+	sine := int32(helpers.Sin256(uint8(phase))) + 1<<helpers.TrigShift
+	return int16(sine * int32(amplitude) >> (helpers.TrigShift + 1))
+}
+
+// WriteComparison prints the results as an aligned text table with the
+// frame rate each link would allow for the measured bytes per frame.
+func WriteComparison(w io.Writer, results []FrameResult, links []Link) error {
+	if _, err := fmt.Fprintf(w, "%-14s %-8s %10s %9s %8s %8s", "target", "workload", "ns/frame", "fps", "B/frame", "allocs"); err != nil {
+		return err
+	}
+	for _, link := range links {
+		if _, err := fmt.Fprintf(w, " %9s", link.Name); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintln(w); err != nil {
+		return err
+	}
+
+	for _, r := range results {
+		if _, err := fmt.Fprintf(w, "%-14s %-8s %10d %9.0f %8d %8.1f", r.Target, r.Workload, r.NsPerFrame, r.FPS(), r.BytesPerFrame, r.AllocsPerFrame); err != nil {
+			return err
+		}
+		for _, link := range links {
+			if _, err := fmt.Fprintf(w, " %9.1f", link.FPS(r.BytesPerFrame)); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintln(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
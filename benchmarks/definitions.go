@@ -0,0 +1,75 @@
+package benchmarks
+
+import (
+	"errors"
+
+	"github.com/redghc/t8go"
+)
+
+// Case is a single benchmarked operation.
+type Case struct {
+	Name   string                        // Benchmark name
+	Budget float64                       // Maximum allowed allocations per run
+	Run    func(gfx t8go.IDisplayDrawer) // Operation under measurement
+}
+
+// Config holds the parameters used by Run.
+type Config struct {
+	Runs      int  // Iterations averaged by the allocation check (default: 100)
+	SkipTimed bool // Only measure allocations, skip the timed benchmark
+}
+
+// Result holds the measurements taken for a Case.
+type Result struct {
+	Name        string  // Benchmark name
+	NsPerOp     int64   // Nanoseconds per operation (0 when timing was skipped)
+	BytesPerOp  int64   // Bytes allocated per operation (0 when timing was skipped)
+	AllocsPerOp float64 // Average allocations per operation
+	Budget      float64 // Maximum allowed allocations per operation
+}
+
+// Workload is a frame drawn and flushed repeatedly by Compare.
+type Workload struct {
+	Name string                                   // Workload name
+	Draw func(gfx t8go.IDisplayDrawer, frame int) // Draws frame number frame into the buffer
+
+	// Region is the area flushed with FlushRegion after drawing. When empty,
+	// the whole buffer is flushed with Display.
+	Region t8go.Rect
+}
+
+// Target is a display driver measured by Compare.
+type Target struct {
+	Name    string        // Target name
+	Display t8go.IDisplay // Driver under measurement
+
+	// Transferred returns the total number of bytes the driver has sent so
+	// far, for drivers whose transport can be counted (such as a remote
+	// display writing to a counting writer). When nil, the bytes per frame
+	// are the buffer bytes handed to the driver by each flush.
+	Transferred func() int64
+}
+
+// Link models the bus between the controller and the panel, to estimate the
+// frame rate the transfer alone allows on real hardware.
+type Link struct {
+	Name          string // Link name
+	BitsPerSecond int64  // Bus clock in Hz
+	BitsPerByte   int64  // Clock cycles per byte on the wire (9 for I²C including the ACK, 8 for SPI)
+	Overhead      int64  // Protocol bytes added to every flush (addresses, control bytes, addressing commands)
+}
+
+// FrameResult holds the measurements taken for a Workload on a Target.
+type FrameResult struct {
+	Target         string  // Target name
+	Workload       string  // Workload name
+	NsPerFrame     int64   // Nanoseconds to draw and flush a frame on this host (0 when timing was skipped)
+	BytesPerFrame  int64   // Bytes transferred per frame
+	AllocsPerFrame float64 // Average allocations per frame
+}
+
+// Common errors returned by the benchmarks package.
+var (
+	ErrNilDisplay     = errors.New("display cannot be nil")      // Nil display passed to Run
+	ErrBudgetExceeded = errors.New("allocation budget exceeded") // A case allocated more than its budget
+)
@@ -0,0 +1,199 @@
+package t8go
+
+import "github.com/redghc/t8go/framebuf"
+
+// DrawBitmap draws the width x height monochrome bitmap packed in data with
+// its top-left corner at (x, y), for icons and logos. Rows are stored
+// MSB-first (the leftmost pixel in the top bit), each padded to a whole byte,
+// as in framebuf.RowMajor buffers and particles.Sprite. Set bits are drawn
+// in the draw mode and color like the other primitives, in horizontal runs
+// that keep the span fast paths. Clear bits are skipped in BitmapTransparent
+// mode, the default, and drawn in the opposite color in BitmapOpaque mode,
+// except in ModeXOR, where they leave the pixels unchanged. Nothing is drawn
+// if width or height is not positive or data is shorter than the bitmap.
+func (t *T8Go) DrawBitmap(x, y, width, height int16, data []byte) {
+	if t.traced() {
+		t.tracer("DrawBitmap", x, y, width, height)
+	}
+
+	stride := (int32(width) + 7) / 8
+	if width <= 0 || height <= 0 || int32(len(data)) < stride*int32(height) {
+		return
+	}
+	ink, uniform := t.ink()
+	opaque := t.state.bitmapMode == BitmapOpaque && uniform
+	if t.transformed {
+		if !t.transform.translation() {
+			t.drawImage(int32(x), int32(y), int32(width), int32(height), opaque, func(col, row int32) bool {
+				return data[row*stride+col>>3]&(0x80>>(col&7)) != 0
+			})
+			return
+		}
+		x, y = t.transform.Apply(x, y)
+	}
+
+	_, minY, _, maxY := t.bounds()
+	originX := int32(x)
+	for row := max(int32(minY)-int32(y), 0); row < min(int32(maxY)-int32(y)+1, int32(height)); row++ {
+		bits := data[row*stride : (row+1)*stride]
+		py := int32(y) + row
+
+		// Draw runs of equal bits
+		for col := int32(0); col < int32(width); {
+			on := bits[col>>3]&(0x80>>(col&7)) != 0
+			end := col + 1
+			for end < int32(width) && (bits[end>>3]&(0x80>>(end&7)) != 0) == on {
+				end++
+			}
+			switch {
+			case on:
+				t.hspan(originX+col, originX+end-1, py)
+			case opaque:
+				t.writeRect(originX+col, py, originX+end-1, py, !ink)
+			}
+			col = end
+		}
+	}
+}
+
+// SetBitmapMode selects how DrawBitmap draws the clear bits of bitmaps:
+// BitmapTransparent (the default) leaves the pixels under them unchanged, so
+// icons can be drawn over a pattern, and BitmapOpaque draws them in the
+// opposite of the draw color, replacing the whole rectangle. The mode is
+// part of the graphics state saved by PushState.
+func (t *T8Go) SetBitmapMode(mode BitmapMode) {
+	t.state.bitmapMode = mode
+}
+
+// GetBitmapMode returns the current bitmap mode.
+func (t *T8Go) GetBitmapMode() BitmapMode {
+	return t.state.bitmapMode
+}
+
+// DrawBuffer copies src to the display with its top-left corner at (x, y),
+// replacing the covered pixels like an opaque bitmap: lit pixels of src are
+// drawn in the draw mode and color and the others in the opposite color, so
+// in ModeSet they turn on and off, while in ModeXOR lit pixels flip and the
+// others stay unchanged. In ModeSet with ColorOn, pages are copied directly
+// when the driver exposes its buffer. Parts outside the display are clipped,
+// and a src shorter than its dimensions require is ignored.
+func (t *T8Go) DrawBuffer(x, y int16, src framebuf.Buffer) {
+	if t.traced() {
+		t.tracer("DrawBuffer", x, y, int16(src.Width), int16(src.Height))
+	}
+
+	if src.Width <= 0 || src.Height <= 0 || len(src.Data) < framebuf.Size(src.Width, src.Height) {
+		return
+	}
+	if t.transformed {
+		if !t.transform.translation() {
+			t.drawImage(int32(x), int32(y), int32(src.Width), int32(src.Height), true, func(col, row int32) bool {
+				return src.GetPixel(int(col), int(row))
+			})
+			return
+		}
+		x, y = t.transform.Apply(x, y)
+	}
+	endX, endY := int32(x)+int32(src.Width)-1, int32(y)+int32(src.Height)-1
+	on, uniform := t.ink()
+	if on && uniform && !t.tinted() && t.direct.Data != nil && !t.filtered() && !t.clipped() && t.state.rect.contains(int32(x), int32(y), endX, endY) {
+		t.countWrites(int32(x), int32(y), int32(src.Width), int32(src.Height))
+		t.direct.Blit(int(x), int(y), src)
+		return
+	}
+
+	minX, minY, maxX, maxY := t.bounds()
+	startX := max(int32(x), int32(minX))
+	startY := max(int32(y), int32(minY))
+	endX, endY = min(endX, int32(maxX)), min(endY, int32(maxY))
+	for py := startY; py <= endY; py++ {
+		for px := startX; px <= endX; px++ {
+			t.stamp(int16(px), int16(py), src.GetPixel(int(px-int32(x)), int(py-int32(y))))
+		}
+	}
+}
+
+// DrawNinePatch draws patch stretched over the width x height rectangle with
+// its top-left corner at (x, y), replacing the covered pixels in the draw
+// mode like DrawBuffer. Rectangles smaller than the fixed borders shrink them
+// proportionally. Nothing is drawn if the borders are negative, leave no
+// stretchable center in the bitmap, or the bitmap is shorter than its
+// dimensions require.
+func (t *T8Go) DrawNinePatch(x, y, width, height int16, patch NinePatch) {
+	if t.traced() {
+		t.tracer("DrawNinePatch", x, y, width, height)
+	}
+
+	src := patch.Bitmap
+	if width <= 0 || height <= 0 || !patch.valid() {
+		return
+	}
+	if t.transformed {
+		if !t.transform.translation() {
+			t.drawImage(int32(x), int32(y), int32(width), int32(height), true, func(col, row int32) bool {
+				srcX := ninePatchSource(col, int32(width), int32(patch.Left), int32(patch.Right), int32(src.Width))
+				srcY := ninePatchSource(row, int32(height), int32(patch.Top), int32(patch.Bottom), int32(src.Height))
+				return src.GetPixel(int(srcX), int(srcY))
+			})
+			return
+		}
+		x, y = t.transform.Apply(x, y)
+	}
+
+	minX, minY, maxX, maxY := t.bounds()
+	startX := max(int32(x), int32(minX))
+	startY := max(int32(y), int32(minY))
+	endX := min(int32(x)+int32(width)-1, int32(maxX))
+	endY := min(int32(y)+int32(height)-1, int32(maxY))
+	for py := startY; py <= endY; py++ {
+		srcY := ninePatchSource(py-int32(y), int32(height), int32(patch.Top), int32(patch.Bottom), int32(src.Height))
+		for px := startX; px <= endX; px++ {
+			srcX := ninePatchSource(px-int32(x), int32(width), int32(patch.Left), int32(patch.Right), int32(src.Width))
+			t.stamp(int16(px), int16(py), src.GetPixel(int(srcX), int(srcY)))
+		}
+	}
+}
+
+// stamp draws the pixel at (x, y) of a copied image like an opaque bitmap:
+// lit pixels in the draw mode and color, the others in the opposite color,
+// or unchanged in ModeXOR.
+func (t *T8Go) stamp(x, y int16, lit bool) {
+	if lit {
+		t.paint(x, y)
+		return
+	}
+	if on, uniform := t.ink(); uniform {
+		t.countWrites(int32(x), int32(y), 1, 1)
+		t.writePixel(x, y, !on)
+	}
+}
+
+// valid reports whether the borders of the patch leave a stretchable center
+// and the bitmap holds all of its pixels.
+func (p NinePatch) valid() bool {
+	src := p.Bitmap
+	return p.Left >= 0 && p.Top >= 0 && p.Right >= 0 && p.Bottom >= 0 &&
+		int(p.Left)+int(p.Right) < src.Width && int(p.Top)+int(p.Bottom) < src.Height &&
+		src.Layout.Valid() && len(src.Data) >= src.Layout.Size(src.Width, src.Height)
+}
+
+// ninePatchSource maps the coordinate pos of a destination span of length
+// size to the source span of length source with fixed borders low and high.
+// The middle repeats the center of the source; spans shorter than the
+// borders split them proportionally.
+func ninePatchSource(pos, size, low, high, source int32) int32 {
+	if size < low+high {
+		if low = size * low / (low + high); pos < low {
+			return pos
+		}
+		return source - (size - pos)
+	}
+
+	switch {
+	case pos < low:
+		return pos
+	case pos >= size-high:
+		return source - (size - pos)
+	}
+	return low + (pos-low)%(source-low-high)
+}
@@ -0,0 +1,84 @@
+// Package bmp encodes monochrome images as 1-bit BMP files.
+// It is shared by the bitmap driver and the capture and debugging tools.
+package bmp
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// ErrInvalidDimensions is returned when the image width or height is not positive.
+var ErrInvalidDimensions = errors.New("invalid image dimensions")
+
+// PixelFunc reports whether the pixel at (x, y) is on.
+type PixelFunc func(x, y int) bool
+
+// Encode writes a width x height monochrome image as a 1-bit BMP file.
+// Pixels reported as on are stored as white, the rest as black.
+func Encode(w io.Writer, width, height int, pixel PixelFunc) error {
+	if width <= 0 || height <= 0 {
+		return ErrInvalidDimensions
+	}
+
+	// Calculate padding for BMP format (rows must be multiple of 4 bytes)
+	rowSize := (width + 31) / 32 * 4
+	imageSize := rowSize * height
+	dataOffset := 14 + 40 + 8          // File header + info header + palette
+	fileSize := dataOffset + imageSize // Headers + image data
+
+	// BMP File Header (14 bytes)
+	header := make([]byte, dataOffset)
+	header[0], header[1] = 'B', 'M'                                  // Signature
+	binary.LittleEndian.PutUint32(header[2:6], uint32(fileSize))     // File size
+	binary.LittleEndian.PutUint32(header[10:14], uint32(dataOffset)) // Offset to pixel data
+
+	// BMP Info Header (40 bytes)
+	info := header[14:54]
+	binary.LittleEndian.PutUint32(info[0:4], 40)                  // Header size
+	binary.LittleEndian.PutUint32(info[4:8], uint32(width))       // Width
+	binary.LittleEndian.PutUint32(info[8:12], uint32(height))     // Height
+	binary.LittleEndian.PutUint16(info[12:14], 1)                 // Planes
+	binary.LittleEndian.PutUint16(info[14:16], 1)                 // Bits per pixel (monochrome)
+	binary.LittleEndian.PutUint32(info[16:20], 0)                 // Compression
+	binary.LittleEndian.PutUint32(info[20:24], uint32(imageSize)) // Image size
+	binary.LittleEndian.PutUint32(info[24:28], 2835)              // X pixels per meter
+	binary.LittleEndian.PutUint32(info[28:32], 2835)              // Y pixels per meter
+	binary.LittleEndian.PutUint32(info[32:36], 2)                 // Colors used (black and white)
+	binary.LittleEndian.PutUint32(info[36:40], 0)                 // Important colors
+
+	// Color palette for monochrome BMP (8 bytes)
+	copy(header[54:], []byte{
+		0x00, 0x00, 0x00, 0x00, // Black (BGRA)
+		0xFF, 0xFF, 0xFF, 0x00, // White (BGRA)
+	})
+
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+
+	// BMP rows are stored bottom-to-top, so we need to flip the image
+	row := make([]byte, rowSize)
+	for y := height - 1; y >= 0; y-- {
+		clear(row)
+		for x := range width {
+			if pixel(x, y) {
+				row[x/8] |= 0x80 >> (x & 7) // MSB first for BMP
+			}
+		}
+		if _, err := w.Write(row); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PageBuffer returns a PixelFunc reading from an SSD1306-style page buffer,
+// where each byte holds 8 vertical pixels and pages are width bytes long.
+func PageBuffer(buffer []byte, width int) PixelFunc {
+	return func(x, y int) bool {
+		index := x + (y>>3)*width
+		return index < len(buffer) && buffer[index]&(1<<(y&7)) != 0
+	}
+}
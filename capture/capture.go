@@ -0,0 +1,224 @@
+// Package capture provides debugging helpers that record what was sent to a
+// display. The Recorder keeps the last N flushed frames in a ring buffer and
+// can dump them as a BMP sequence or as hex text over a serial console, which
+// helps diagnosing glitches that only appear on hardware. The ScreenService
+// dumps the current buffer on request over the serial console of a deployed
+// device, and ReadScreen decodes the dump on the host (see cmd/t8gocapture).
+package capture
+
+import (
+	"encoding/hex"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/bmp"
+	"github.com/redghc/t8go/framebuf"
+)
+
+var (
+	_ t8go.IDisplay      = &Recorder{}
+	_ t8go.IAsyncDisplay = &Recorder{}
+)
+
+// NewRecorder wraps display and records the last frames flushed buffers.
+// Each slot holds a full copy of the display buffer, so memory usage is
+// frames * display.BufferSize() bytes.
+func NewRecorder(display t8go.IDisplay, frames int) (*Recorder, error) {
+	if display == nil {
+		return nil, ErrNilDisplay
+	}
+	if frames <= 0 {
+		return nil, ErrInvalidFrames
+	}
+
+	ring := make([][]byte, frames)
+	for i := range ring {
+		ring[i] = make([]byte, display.BufferSize())
+	}
+
+	return &Recorder{
+		display: display,
+		frames:  ring,
+	}, nil
+}
+
+// * ----- Display methods -----
+
+// Size returns the dimensions of the wrapped display
+func (r *Recorder) Size() (width, height uint16) {
+	return r.display.Size()
+}
+
+// BufferSize returns the buffer size of the wrapped display
+func (r *Recorder) BufferSize() int {
+	return r.display.BufferSize()
+}
+
+// Buffer returns the buffer of the wrapped display
+func (r *Recorder) Buffer() []byte {
+	return r.display.Buffer()
+}
+
+// ClearBuffer clears the buffer of the wrapped display
+func (r *Recorder) ClearBuffer() {
+	r.display.ClearBuffer()
+}
+
+// ClearDisplay clears the wrapped display and records the empty frame
+func (r *Recorder) ClearDisplay() {
+	r.display.ClearBuffer()
+	_ = r.Display()
+}
+
+// Command forwards a command byte to the wrapped display
+func (r *Recorder) Command(cmd byte) error {
+	return r.display.Command(cmd)
+}
+
+// Display records the current buffer and flushes the wrapped display
+func (r *Recorder) Display() error {
+	r.record()
+	return r.display.Display()
+}
+
+// DisplayAsync records the current buffer and flushes the wrapped display in
+// the background when the driver supports it
+func (r *Recorder) DisplayAsync(done func(error)) {
+	r.record()
+	if async, ok := r.display.(t8go.IAsyncDisplay); ok {
+		async.DisplayAsync(done)
+		return
+	}
+
+	err := r.display.Display()
+	if done != nil {
+		done(err)
+	}
+}
+
+// SetPixel sets a pixel on the wrapped display
+func (r *Recorder) SetPixel(x, y int16, on bool) {
+	r.display.SetPixel(x, y, on)
+}
+
+// GetPixel returns a pixel from the wrapped display
+func (r *Recorder) GetPixel(x, y int16) bool {
+	return r.display.GetPixel(x, y)
+}
+
+// * ----- Recording -----
+
+// Len returns the number of frames currently held in the ring.
+func (r *Recorder) Len() int {
+	return r.count
+}
+
+// Total returns the number of frames recorded since the recorder was created.
+func (r *Recorder) Total() uint32 {
+	return r.total
+}
+
+// Frame returns the recorded buffer at index, where 0 is the oldest frame
+// still held in the ring. The slice is overwritten as new frames are recorded.
+func (r *Recorder) Frame(index int) ([]byte, error) {
+	if index < 0 || index >= r.count {
+		return nil, ErrFrameIndex
+	}
+
+	slot := (r.next - r.count + index + len(r.frames)) % len(r.frames)
+	return r.frames[slot], nil
+}
+
+// Reset discards all recorded frames.
+func (r *Recorder) Reset() {
+	r.next = 0
+	r.count = 0
+}
+
+// WriteBMP encodes the recorded frame at index as a BMP image.
+func (r *Recorder) WriteBMP(w io.Writer, index int) error {
+	frame, err := r.Frame(index)
+	if err != nil {
+		return err
+	}
+
+	width, height := r.display.Size()
+	pixels := framebuf.Buffer{Data: frame, Width: int(width), Height: int(height), Layout: t8go.DirectBuffer(r.display).Layout}
+	return bmp.Encode(w, int(width), int(height), pixels.GetPixel)
+}
+
+// DumpBMP writes every recorded frame, oldest first, to numbered BMP files
+// named "<prefix>-000.bmp", "<prefix>-001.bmp" and so on.
+func (r *Recorder) DumpBMP(prefix string) error {
+	for index := range r.count {
+		name := prefix + "-" + padIndex(index) + ".bmp"
+
+		file, err := os.Create(name)
+		if err != nil {
+			return err
+		}
+		err = r.WriteBMP(file, index)
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DumpHex writes every recorded frame, oldest first, as hex text suitable for
+// a serial console. Each frame starts with a "FRAME <index> <width>x<height>"
+// line followed by one line per buffer page, and the dump ends with "END".
+func (r *Recorder) DumpHex(w io.Writer) error {
+	width, height := r.display.Size()
+	stride := int(width)
+
+	var line []byte
+	for index := range r.count {
+		frame, _ := r.Frame(index)
+
+		line = append(line[:0], "FRAME "...)
+		line = strconv.AppendInt(line, int64(index), 10)
+		line = append(line, ' ')
+		line = strconv.AppendUint(line, uint64(width), 10)
+		line = append(line, 'x')
+		line = strconv.AppendUint(line, uint64(height), 10)
+		line = append(line, '\n')
+		if _, err := w.Write(line); err != nil {
+			return err
+		}
+
+		for start := 0; start < len(frame); start += stride {
+			end := min(start+stride, len(frame))
+			line = hex.AppendEncode(line[:0], frame[start:end])
+			line = append(line, '\n')
+			if _, err := w.Write(line); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err := io.WriteString(w, "END\n")
+	return err
+}
+
+// record copies the current display buffer into the next ring slot.
+func (r *Recorder) record() {
+	copy(r.frames[r.next], r.display.Buffer())
+	r.next = (r.next + 1) % len(r.frames)
+	r.count = min(r.count+1, len(r.frames))
+	r.total++
+}
+
+// padIndex formats a frame index with at least three digits.
+func padIndex(index int) string {
+	digits := strconv.Itoa(index)
+	for len(digits) < 3 {
+		digits = "0" + digits
+	}
+	return digits
+}
@@ -0,0 +1,40 @@
+package capture
+
+import (
+	"errors"
+	"io"
+
+	"github.com/redghc/t8go"
+)
+
+// Recorder wraps a display and keeps copies of the last flushed buffers in a ring.
+// It implements t8go.IDisplay, so it can be passed to t8go.New in place of the
+// wrapped driver.
+type Recorder struct {
+	display t8go.IDisplay // Wrapped display driver
+	frames  [][]byte      // Ring of recorded buffers
+	next    int           // Slot that receives the next frame
+	count   int           // Number of recorded frames (up to len(frames))
+	total   uint32        // Total number of frames recorded since creation
+}
+
+// ScreenService answers screen capture requests received on a serial
+// console with a dump of the current display buffer (see WriteScreen), so
+// support can ask a device in the field what its screen shows. Feed it the
+// console input with Write; ReadScreen decodes the dump on the host.
+type ScreenService struct {
+	display t8go.IDisplay // Display whose buffer is dumped
+	out     io.Writer     // Console output receiving the dumps
+	line    []byte        // Partial request line
+	enabled bool          // Whether requests are answered
+}
+
+// Common errors returned by the capture package.
+var (
+	ErrNilDisplay     = errors.New("display cannot be nil")         // Nil display passed to NewRecorder
+	ErrInvalidFrames  = errors.New("frame count must be positive")  // Ring size is zero or negative
+	ErrFrameIndex     = errors.New("frame index out of range")      // No recorded frame at the given index
+	ErrNoScreen       = errors.New("no screen dump found")          // ReadScreen found no SCREEN header
+	ErrScreenFormat   = errors.New("malformed screen dump")         // Bad header, base64 or compressed data
+	ErrScreenChecksum = errors.New("screen dump checksum mismatch") // Decoded buffer does not match the CRC
+)
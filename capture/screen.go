@@ -0,0 +1,282 @@
+package capture
+
+import (
+	"bufio"
+	"encoding/base64"
+	"hash/crc32"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/framebuf"
+)
+
+// ScreenRequest is the console line that asks a ScreenService for a dump.
+const ScreenRequest = "t8go screen"
+
+// Screen dumps are text, so they pass through serial consoles and log files
+// unchanged:
+//
+//	SCREEN <width>x<height> <layout> <size> <crc32>
+//	<base64 lines of the PackBits-compressed buffer>
+//	END
+//
+// The layout is the framebuf.Layout number, size the length of the raw
+// buffer and crc32 its IEEE checksum in hexadecimal.
+const (
+	screenHeader = "SCREEN "
+	screenEnd    = "END"
+	screenChunk  = 57 // Compressed bytes per line (76 base64 characters)
+)
+
+// NewScreenService returns an enabled service that dumps the buffer of
+// display to out whenever ScreenRequest is written to it.
+func NewScreenService(display t8go.IDisplay, out io.Writer) (*ScreenService, error) {
+	if display == nil {
+		return nil, ErrNilDisplay
+	}
+	return &ScreenService{display: display, out: out, enabled: true}, nil
+}
+
+// SetEnabled turns the service on or off. A disabled service ignores
+// requests, so field builds can keep it behind a support setting.
+func (s *ScreenService) SetEnabled(enabled bool) {
+	s.enabled = enabled
+	s.line = s.line[:0]
+}
+
+// Enabled reports whether the service answers requests.
+func (s *ScreenService) Enabled() bool {
+	return s.enabled
+}
+
+// Write feeds console input to the service. Input is split into lines and
+// every line equal to ScreenRequest (surrounding spaces ignored) is answered
+// with a dump of the current buffer; other lines are discarded, so the
+// service can share the console with other commands. It never buffers more
+// than one request line.
+func (s *ScreenService) Write(p []byte) (int, error) {
+	if !s.enabled {
+		return len(p), nil
+	}
+	for _, c := range p {
+		if c != '\n' && c != '\r' {
+			if len(s.line) <= len(ScreenRequest)+8 {
+				s.line = append(s.line, c)
+			}
+			continue
+		}
+		request := strings.TrimSpace(string(s.line)) == ScreenRequest
+		s.line = s.line[:0]
+		if request {
+			if err := s.Dump(); err != nil {
+				return len(p), err
+			}
+		}
+	}
+	return len(p), nil
+}
+
+// Dump writes the current buffer to the output of the service, whether or
+// not it is enabled.
+func (s *ScreenService) Dump() error {
+	return WriteScreen(s.out, s.display)
+}
+
+// WriteScreen writes the current buffer of display to w in the screen dump
+// format, compressed with PackBits and wrapped in base64 lines. It streams
+// the buffer, so it needs no more memory than one line.
+func WriteScreen(w io.Writer, display t8go.IDisplay) error {
+	width, height := display.Size()
+	data := display.Buffer()
+
+	line := make([]byte, 0, 80)
+	line = append(line, screenHeader...)
+	line = strconv.AppendUint(line, uint64(width), 10)
+	line = append(line, 'x')
+	line = strconv.AppendUint(line, uint64(height), 10)
+	line = append(line, ' ')
+	line = strconv.AppendUint(line, uint64(t8go.DirectBuffer(display).Layout), 10)
+	line = append(line, ' ')
+	line = strconv.AppendInt(line, int64(len(data)), 10)
+	line = append(line, ' ')
+	line = strconv.AppendUint(line, uint64(crc32.ChecksumIEEE(data)), 16)
+	line = append(line, '\n')
+	if _, err := w.Write(line); err != nil {
+		return err
+	}
+
+	encoder := &screenEncoder{w: w, line: line}
+	packBits(data, encoder.writeByte)
+	encoder.flush()
+	if encoder.err != nil {
+		return encoder.err
+	}
+
+	_, err := io.WriteString(w, screenEnd+"\n")
+	return err
+}
+
+// ReadScreen decodes the first screen dump found in r, skipping any console
+// output before its header, and verifies its size and checksum.
+func ReadScreen(r io.Reader) (framebuf.Buffer, error) {
+	scanner := bufio.NewScanner(r)
+
+	var header []string
+	for scanner.Scan() {
+		if text := strings.TrimSpace(scanner.Text()); strings.HasPrefix(text, screenHeader) {
+			header = strings.Fields(text)
+			break
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return framebuf.Buffer{}, err
+	}
+	if header == nil {
+		return framebuf.Buffer{}, ErrNoScreen
+	}
+
+	screen, size, sum, ok := parseScreenHeader(header)
+	if !ok {
+		return framebuf.Buffer{}, ErrScreenFormat
+	}
+
+	var packed []byte
+	for {
+		if !scanner.Scan() {
+			if err := scanner.Err(); err != nil {
+				return framebuf.Buffer{}, err
+			}
+			return framebuf.Buffer{}, ErrScreenFormat
+		}
+		text := strings.TrimSpace(scanner.Text())
+		if text == screenEnd {
+			break
+		}
+		var err error
+		packed, err = base64.StdEncoding.AppendDecode(packed, []byte(text))
+		if err != nil {
+			return framebuf.Buffer{}, ErrScreenFormat
+		}
+	}
+
+	screen.Data, ok = unpackBits(packed, size)
+	if !ok {
+		return framebuf.Buffer{}, ErrScreenFormat
+	}
+	if crc32.ChecksumIEEE(screen.Data) != sum {
+		return framebuf.Buffer{}, ErrScreenChecksum
+	}
+	return screen, nil
+}
+
+// parseScreenHeader parses the fields of a SCREEN line into an empty buffer
+// description, the raw buffer size and its checksum.
+func parseScreenHeader(fields []string) (screen framebuf.Buffer, size int, sum uint32, ok bool) {
+	if len(fields) != 5 {
+		return screen, 0, 0, false
+	}
+	width, height, found := strings.Cut(fields[1], "x")
+	w, errW := strconv.ParseUint(width, 10, 16)
+	h, errH := strconv.ParseUint(height, 10, 16)
+	layout, errL := strconv.ParseUint(fields[2], 10, 8)
+	n, errN := strconv.Atoi(fields[3])
+	crc, errC := strconv.ParseUint(fields[4], 16, 32)
+	if !found || errW != nil || errH != nil || errL != nil || errN != nil || errC != nil {
+		return screen, 0, 0, false
+	}
+
+	screen = framebuf.Buffer{Width: int(w), Height: int(h), Layout: framebuf.Layout(layout)}
+	if w == 0 || h == 0 || !screen.Layout.Valid() || n < screen.Layout.Size(screen.Width, screen.Height) {
+		return screen, 0, 0, false
+	}
+	return screen, n, uint32(crc), true
+}
+
+// * ----- PackBits -----
+
+// packBits compresses data with the PackBits run-length encoding and passes
+// the result to emit one byte at a time. A header n below 128 is followed by
+// n+1 literal bytes; a header above 128 by one byte repeated 257-n times.
+func packBits(data []byte, emit func(byte)) {
+	for i := 0; i < len(data); {
+		run := 1
+		for i+run < len(data) && run < 128 && data[i+run] == data[i] {
+			run++
+		}
+		if run > 1 {
+			emit(byte(257 - run))
+			emit(data[i])
+			i += run
+			continue
+		}
+
+		// Literals continue until the next run of at least two bytes
+		end := i + 1
+		for end < len(data) && end-i < 128 && (end+1 >= len(data) || data[end] != data[end+1]) {
+			end++
+		}
+		emit(byte(end - i - 1))
+		for _, value := range data[i:end] {
+			emit(value)
+		}
+		i = end
+	}
+}
+
+// unpackBits expands PackBits data, which must decode to exactly size bytes.
+func unpackBits(packed []byte, size int) ([]byte, bool) {
+	data := make([]byte, 0, min(size, len(packed)*128))
+	for i := 0; i < len(packed); {
+		n := int(packed[i])
+		i++
+		switch {
+		case n < 128:
+			if i+n+1 > len(packed) || len(data)+n+1 > size {
+				return nil, false
+			}
+			data = append(data, packed[i:i+n+1]...)
+			i += n + 1
+		case n > 128:
+			if i >= len(packed) || len(data)+257-n > size {
+				return nil, false
+			}
+			for range 257 - n {
+				data = append(data, packed[i])
+			}
+			i++
+		}
+	}
+	return data, len(data) == size
+}
+
+// screenEncoder wraps compressed bytes in base64 lines of screenChunk bytes.
+type screenEncoder struct {
+	w     io.Writer
+	line  []byte            // Reused output line
+	chunk [screenChunk]byte // Bytes of the current line
+	n     int               // Bytes used in chunk
+	err   error             // First write error
+}
+
+// writeByte adds value to the current line and writes the line when full.
+func (e *screenEncoder) writeByte(value byte) {
+	e.chunk[e.n] = value
+	e.n++
+	if e.n == screenChunk {
+		e.flush()
+	}
+}
+
+// flush writes the pending bytes as one base64 line.
+func (e *screenEncoder) flush() {
+	if e.n == 0 || e.err != nil {
+		e.n = 0
+		return
+	}
+	e.line = base64.StdEncoding.AppendEncode(e.line[:0], e.chunk[:e.n])
+	e.line = append(e.line, '\n')
+	_, e.err = e.w.Write(e.line)
+	e.n = 0
+}
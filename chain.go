@@ -0,0 +1,97 @@
+package t8go
+
+// At starts a drawing chain with the cursor at (x, y).
+func (t *T8Go) At(x, y int16) Chain {
+	return Chain{ctx: t, x: x, y: y}
+}
+
+// At moves the cursor to (x, y).
+func (c Chain) At(x, y int16) Chain {
+	c.x, c.y = x, y
+	c.shape = chainNone
+	return c
+}
+
+// Move moves the cursor by (dx, dy).
+func (c Chain) Move(dx, dy int16) Chain {
+	return c.At(c.x+dx, c.y+dy)
+}
+
+// Position returns the cursor position.
+func (c Chain) Position() (x, y int16) {
+	return c.x, c.y
+}
+
+// Pixel draws a pixel at the cursor.
+func (c Chain) Pixel() Chain {
+	c.ctx.DrawPixel(c.x, c.y)
+	return c
+}
+
+// LineTo draws a line from the cursor to (x, y) and moves the cursor there.
+func (c Chain) LineTo(x, y int16) Chain {
+	c.ctx.DrawLine(c.x, c.y, x, y)
+	return c.At(x, y)
+}
+
+// Box draws a rectangle outline with its top-left corner at the cursor.
+func (c Chain) Box(width, height int16) Chain {
+	c.ctx.DrawBox(c.x, c.y, width, height)
+	c.shape, c.width, c.height = chainBox, width, height
+	return c
+}
+
+// RoundBox draws a rounded rectangle outline with its top-left corner at the cursor.
+func (c Chain) RoundBox(width, height, cornerRadius int16) Chain {
+	c.ctx.DrawRoundBox(c.x, c.y, width, height, cornerRadius)
+	c.shape, c.width, c.height, c.radius = chainRoundBox, width, height, cornerRadius
+	return c
+}
+
+// Circle draws a circle outline centered at the cursor.
+func (c Chain) Circle(radius int16) Chain {
+	c.ctx.DrawCircle(c.x, c.y, radius, DrawAll)
+	c.shape, c.width = chainCircle, radius
+	return c
+}
+
+// Ellipse draws an ellipse outline centered at the cursor.
+func (c Chain) Ellipse(radiusX, radiusY int16) Chain {
+	c.ctx.DrawEllipse(c.x, c.y, radiusX, radiusY, DrawAll)
+	c.shape, c.width, c.height = chainEllipse, radiusX, radiusY
+	return c
+}
+
+// Fill fills the shape drawn by the previous Box, RoundBox, Circle or Ellipse call.
+// It does nothing when no shape was drawn since the cursor last moved.
+func (c Chain) Fill() Chain {
+	switch c.shape {
+	case chainBox:
+		c.ctx.DrawBoxFill(c.x, c.y, c.width, c.height)
+	case chainRoundBox:
+		c.ctx.DrawRoundBoxFill(c.x, c.y, c.width, c.height, c.radius)
+	case chainCircle:
+		c.ctx.DrawCircleFill(c.x, c.y, c.width, DrawAll)
+	case chainEllipse:
+		c.ctx.DrawEllipseFill(c.x, c.y, c.width, c.height, DrawAll)
+	}
+	return c
+}
+
+// Text draws text with the pen at the cursor, like DrawText, and moves the
+// cursor to the end of the text in the current text rotation, so further
+// text continues the line.
+func (c Chain) Text(text string) Chain {
+	c.ctx.DrawText(c.x, c.y, text)
+	width, _ := c.ctx.MeasureText(text)
+	switch c.ctx.GetTextRotation() {
+	case Rotate90:
+		return c.Move(0, -width)
+	case Rotate180:
+		return c.Move(-width, 0)
+	case Rotate270:
+		return c.Move(0, width)
+	default:
+		return c.Move(width, 0)
+	}
+}
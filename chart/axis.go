@@ -0,0 +1,239 @@
+// Package chart provides the building blocks of embedded charts. Axis holds
+// the axis math shared by line, bar and scope plots: mapping values to
+// pixels, placing ticks at round values and drawing their labels, with
+// optional rotated labels on vertical axes. Viewport maps a world rectangle
+// onto the screen and plots lines and points in world units, clipped to its
+// screen area. Drawing never allocates.
+package chart
+
+import (
+	"strconv"
+
+	"github.com/redghc/t8go"
+)
+
+const (
+	defaultMaxTicks   = 5 // Default maximum number of ticks
+	defaultTickLength = 3 // Default tick length in pixels
+)
+
+// defaultLabel formats labels of axes without a Label function.
+var defaultLabel = Decimal(0)
+
+// Decimal returns a LabelFunc for fixed-point values with the given number of
+// decimal places, so 125 is labelled "12.5" with one place.
+func Decimal(places uint8) LabelFunc {
+	scale := int64(1)
+	for range places {
+		scale *= 10
+	}
+
+	return func(dst []byte, value int32) []byte {
+		magnitude := int64(value)
+		if magnitude < 0 {
+			dst = append(dst, '-')
+			magnitude = -magnitude
+		}
+		dst = strconv.AppendInt(dst, magnitude/scale, 10)
+		if places == 0 {
+			return dst
+		}
+
+		dst = append(dst, '.')
+		fraction := magnitude % scale
+		for digit := scale / 10; digit > 0; digit /= 10 {
+			dst = append(dst, byte('0'+fraction/digit%10))
+		}
+		return dst
+	}
+}
+
+// NiceStep returns the distance between ticks covering span with at most
+// maxTicks ticks: the smallest 1, 2 or 5 times a power of ten that fits.
+func NiceStep(span int64, maxTicks int) int64 {
+	if span <= 0 {
+		return 1
+	}
+	intervals := int64(max(maxTicks-1, 1))
+	raw := (span + intervals - 1) / intervals
+
+	magnitude := int64(1)
+	for magnitude*10 <= raw {
+		magnitude *= 10
+	}
+	for _, factor := range [...]int64{1, 2, 5} {
+		if factor*magnitude >= raw {
+			return factor * magnitude
+		}
+	}
+	return 10 * magnitude
+}
+
+// * ----- Mapping -----
+
+// Position returns the pixel coordinate of value along the axis: the X
+// coordinate for a horizontal axis, the Y coordinate for a vertical one.
+// Values outside Min..Max map outside the axis.
+func (a *Axis) Position(value int32) int16 {
+	offset := scale(value, a.Min, a.Max, a.Length)
+	if a.Orientation == Vertical {
+		return int16(int64(a.Origin.Y) - offset)
+	}
+	return int16(int64(a.Origin.X) + offset)
+}
+
+// scale returns the pixel offset of value from low along length pixels
+// covering low..high, rounded to the nearest pixel.
+func scale(value, low, high int32, length int16) int64 {
+	span := int64(high) - int64(low)
+	if span == 0 || length <= 1 {
+		return 0
+	}
+	return roundDiv((int64(value)-int64(low))*int64(length-1), span)
+}
+
+// Ticks appends the tick values of the axis to dst and returns the result.
+// Ticks are the multiples of NiceStep between Min and Max.
+func (a *Axis) Ticks(dst []int32) []int32 {
+	first, last, step := a.tickRange()
+	for value := first; value <= last; value += step {
+		dst = append(dst, int32(value))
+	}
+	return dst
+}
+
+// tickRange returns the first and last tick values and the step between them.
+func (a *Axis) tickRange() (first, last, step int64) {
+	low, high := int64(a.Min), int64(a.Max)
+	if low > high {
+		low, high = high, low
+	}
+
+	maxTicks := int(a.MaxTicks)
+	if maxTicks == 0 {
+		maxTicks = defaultMaxTicks
+	}
+	step = NiceStep(high-low, maxTicks)
+
+	first = floorDiv(low+step-1, step) * step
+	last = floorDiv(high, step) * step
+	return first, last, step
+}
+
+// * ----- Drawing -----
+
+// Draw draws the axis line, a tick at every tick value, the optional grid
+// and the tick labels.
+func (a *Axis) Draw(ctx t8go.IDisplayDrawer) {
+	if a.Length <= 0 {
+		return
+	}
+
+	font := a.Font
+	if font == nil {
+		font = ctx.GetFont()
+	}
+	label := a.Label
+	if label == nil {
+		label = defaultLabel
+	}
+	tick := int16(a.TickLength)
+	if tick == 0 {
+		tick = defaultTickLength
+	}
+
+	origin := a.Origin
+	if a.Orientation == Vertical {
+		ctx.DrawVLine(origin.X, origin.Y-a.Length+1, a.Length)
+	} else {
+		ctx.DrawHLine(origin.X, origin.Y, a.Length)
+	}
+
+	var text [24]byte
+	first, last, step := a.tickRange()
+	for value := first; value <= last; value += step {
+		at := a.Position(int32(value))
+		name := label(text[:0], int32(value))
+		width := textWidth(font, name)
+
+		if a.Orientation == Horizontal {
+			ctx.DrawVLine(at, origin.Y+1, tick)
+			for y := origin.Y - 2; y > origin.Y-a.Grid; y -= 2 {
+				ctx.DrawPixel(at, y)
+			}
+			drawLabel(ctx, font, name, at-width/2, origin.Y+tick+1+font.Ascent, false)
+			continue
+		}
+
+		ctx.DrawHLine(origin.X-tick, at, tick)
+		for x := origin.X + 2; x < origin.X+a.Grid; x += 2 {
+			ctx.DrawPixel(x, at)
+		}
+		if a.RotateLabels {
+			drawLabel(ctx, font, name, origin.X-tick-2-font.Descent, at+width/2, true)
+		} else {
+			drawLabel(ctx, font, name, origin.X-tick-1-width, at+font.Ascent/2, false)
+		}
+	}
+}
+
+// textWidth returns the advance of text in font.
+func textWidth(font *t8go.Font, text []byte) int16 {
+	width := int16(0)
+	for _, char := range text {
+		if glyph, ok := font.Glyph(rune(char)); ok {
+			width += int16(glyph.Advance)
+		}
+	}
+	return width
+}
+
+// drawLabel draws text with the pen at (x, y) on the baseline. Rotated text
+// runs upwards from (x, y) with the baseline on column x and the glyph tops
+// to its left.
+func drawLabel(ctx t8go.IDisplayDrawer, font *t8go.Font, text []byte, x, y int16, rotated bool) {
+	pen := int16(0)
+	for _, char := range text {
+		glyph, ok := font.Glyph(rune(char))
+		if !ok {
+			continue
+		}
+
+		for gy := range int(glyph.Height) {
+			for gx := range int(glyph.Width) {
+				if !font.GlyphPixel(glyph, gx, gy) {
+					continue
+				}
+				along := pen + int16(glyph.XOffset) + int16(gx)
+				across := int16(glyph.YOffset) + int16(gy)
+				if rotated {
+					ctx.DrawPixel(x+across, y-along)
+				} else {
+					ctx.DrawPixel(x+along, y+across)
+				}
+			}
+		}
+		pen += int16(glyph.Advance)
+	}
+}
+
+// roundDiv divides numerator by a positive or negative denominator, rounding
+// to the nearest integer.
+func roundDiv(numerator, denominator int64) int64 {
+	if denominator < 0 {
+		numerator, denominator = -numerator, -denominator
+	}
+	if numerator < 0 {
+		return -((-numerator + denominator/2) / denominator)
+	}
+	return (numerator + denominator/2) / denominator
+}
+
+// floorDiv divides numerator by a positive denominator, rounding down.
+func floorDiv(numerator, denominator int64) int64 {
+	quotient := numerator / denominator
+	if numerator%denominator != 0 && numerator < 0 {
+		quotient--
+	}
+	return quotient
+}
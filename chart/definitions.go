@@ -0,0 +1,61 @@
+package chart
+
+import "github.com/redghc/t8go"
+
+// Orientation selects the direction of an Axis.
+type Orientation uint8
+
+const (
+	Horizontal Orientation = iota // X axis: runs right from its origin, ticks and labels below
+	Vertical                      // Y axis: runs up from its origin, ticks and labels on the left
+)
+
+// LabelFunc appends the label of a tick value to dst and returns the result,
+// so labels are formatted without allocating.
+type LabelFunc func(dst []byte, value int32) []byte
+
+// Axis maps a range of values to a line of pixels and draws it with ticks at
+// round values and their labels. Plots share the mapping through Position.
+type Axis struct {
+	Orientation Orientation // Direction of the axis
+	Min, Max    int32       // Value range covered by the axis
+	Origin      t8go.Point  // Pixel of Min: left end of a horizontal axis, bottom end of a vertical one
+	Length      int16       // Length of the axis in pixels
+
+	MaxTicks   uint8      // Maximum number of ticks (default: 5)
+	TickLength uint8      // Tick length in pixels (default: 3)
+	Label      LabelFunc  // Tick label format (default: Decimal(0)); labels are skipped if it returns nothing
+	Font       *t8go.Font // Label font (default: the font of the drawing context)
+
+	// RotateLabels draws the labels of a vertical axis rotated a quarter turn
+	// counter-clockwise, reading bottom to top, to save horizontal space.
+	RotateLabels bool
+
+	// Grid draws a dotted grid line of this length from every tick across the
+	// plot: upwards for a horizontal axis, to the right for a vertical one.
+	Grid int16
+}
+
+// Viewport maps a world rectangle onto a screen rectangle, so plots are
+// specified in their own units (minutes, tenths of a degree) instead of
+// pixels. World coordinates are int32 in any fixed-point unit, including
+// fixed.Q16 values; world Y grows upwards. A range with Min greater than Max
+// flips the axis.
+type Viewport struct {
+	MinX, MaxX int32     // World X range, shown from the left to the right edge of Screen
+	MinY, MaxY int32     // World Y range, shown from the bottom to the top edge of Screen
+	Screen     t8go.Rect // Pixel area the world range is drawn into
+}
+
+// Radar draws radar (spider) charts and polar plots: values along spokes
+// spread evenly around a center, such as sensor readings per direction or an
+// antenna pattern. Spokes run clockwise from Start.
+type Radar struct {
+	Center t8go.Point // Center of the chart
+	Radius int16      // Length of the spokes in pixels, where values reach Max
+	Max    int32      // Value drawn at the end of a spoke; smaller values are closer to the center
+	Start  uint8      // Angle of the first spoke in 0..255 units (0 = right, 64 = up)
+
+	Rings    uint8 // Number of evenly spaced grid rings drawn by DrawGrid
+	Circular bool  // Draw the grid rings as circles instead of polygons joining the spokes
+}
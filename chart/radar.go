@@ -0,0 +1,81 @@
+package chart
+
+import "github.com/redghc/t8go"
+
+// * ----- Mapping -----
+
+// Angle returns the angle of spoke index out of count spokes.
+func (r *Radar) Angle(index, count int) uint8 {
+	if count <= 0 {
+		return r.Start
+	}
+	return r.Start - uint8(index*256/count)
+}
+
+// Point returns the pixel showing value on spoke index out of count spokes.
+// Values are clamped to 0..Max, so outliers stay on the chart.
+func (r *Radar) Point(index, count int, value int32) t8go.Point {
+	return t8go.PolarToScreen(r.Center.X, r.Center.Y, r.length(value), r.Angle(index, count))
+}
+
+// length returns the distance from the center of value in pixels.
+func (r *Radar) length(value int32) int16 {
+	if r.Max <= 0 || value <= 0 {
+		return 0
+	}
+	return int16(roundDiv(int64(min(value, r.Max))*int64(r.Radius), int64(r.Max)))
+}
+
+// * ----- Drawing -----
+
+// DrawGrid draws count spokes from the center and Rings rings at even
+// fractions of Radius, the outer one at Radius.
+func (r *Radar) DrawGrid(ctx t8go.IDisplayDrawer, count int) {
+	for i := range count {
+		end := r.Point(i, count, r.Max)
+		ctx.DrawLine(r.Center.X, r.Center.Y, end.X, end.Y)
+	}
+
+	for ring := 1; ring <= int(r.Rings); ring++ {
+		value := int32(roundDiv(int64(r.Max)*int64(ring), int64(r.Rings)))
+		if r.Circular || count < 3 {
+			ctx.DrawCircle(r.Center.X, r.Center.Y, r.length(value), t8go.DrawAll)
+			continue
+		}
+		previous := r.Point(count-1, count, value)
+		for i := range count {
+			point := r.Point(i, count, value)
+			ctx.DrawLine(previous.X, previous.Y, point.X, point.Y)
+			previous = point
+		}
+	}
+}
+
+// Plot draws the closed outline joining values[i] on spoke i, one spoke per
+// value.
+func (r *Radar) Plot(ctx t8go.IDisplayDrawer, values []int32) {
+	count := len(values)
+	if count == 0 {
+		return
+	}
+	previous := r.Point(count-1, count, values[count-1])
+	for i, value := range values {
+		point := r.Point(i, count, value)
+		ctx.DrawLine(previous.X, previous.Y, point.X, point.Y)
+		previous = point
+	}
+}
+
+// PlotFill draws the area enclosed by Plot, as triangles from the center.
+func (r *Radar) PlotFill(ctx t8go.IDisplayDrawer, values []int32) {
+	count := len(values)
+	if count == 0 {
+		return
+	}
+	previous := r.Point(count-1, count, values[count-1])
+	for i, value := range values {
+		point := r.Point(i, count, value)
+		ctx.DrawTriangleFill(r.Center.X, r.Center.Y, previous.X, previous.Y, point.X, point.Y)
+		previous = point
+	}
+}
@@ -0,0 +1,221 @@
+package chart
+
+import "github.com/redghc/t8go"
+
+// clipLimit bounds the screen coordinates of points far outside the viewport
+// before clipping, so the intersection math cannot overflow.
+const clipLimit = 1 << 28
+
+// Outcode bits of a point outside the screen area.
+const (
+	outLeft   = 1 << iota // Left of the area
+	outRight              // Right of the area
+	outTop                // Above the area
+	outBottom             // Below the area
+)
+
+// * ----- Transforms -----
+
+// WorldToScreen returns the pixel showing the world point (x, y). Points
+// outside the world range map outside Screen, saturating at the int16 limits.
+func (v *Viewport) WorldToScreen(x, y int32) t8go.Point {
+	screenX, screenY := v.project(x, y)
+	return t8go.Point{X: saturate(screenX), Y: saturate(screenY)}
+}
+
+// ScreenToWorld returns the world point shown at the pixel p, for cursors and
+// touch input.
+func (v *Viewport) ScreenToWorld(p t8go.Point) (x, y int32) {
+	corner := v.Screen.Max()
+	x = unscale(int64(p.X)-int64(v.Screen.X), v.MinX, v.MaxX, v.Screen.Width)
+	y = unscale(int64(corner.Y)-int64(p.Y), v.MinY, v.MaxY, v.Screen.Height)
+	return x, y
+}
+
+// Contains reports whether the world point (x, y) is inside the world range.
+func (v *Viewport) Contains(x, y int32) bool {
+	return within(x, v.MinX, v.MaxX) && within(y, v.MinY, v.MaxY)
+}
+
+// Pan moves the world range by (dx, dy) world units, for scrolling plots.
+func (v *Viewport) Pan(dx, dy int32) {
+	v.MinX += dx
+	v.MaxX += dx
+	v.MinY += dy
+	v.MaxY += dy
+}
+
+// FitY sets the world Y range to the smallest range covering values, so a
+// series fills the height of the plot. Empty values leave the range as is.
+func (v *Viewport) FitY(values []int32) {
+	if len(values) == 0 {
+		return
+	}
+	low, high := values[0], values[0]
+	for _, value := range values[1:] {
+		low, high = min(low, value), max(high, value)
+	}
+	v.MinY, v.MaxY = low, high
+}
+
+// AxisX returns a horizontal axis along the bottom edge of Screen covering the
+// world X range, ready to be customized and drawn.
+func (v *Viewport) AxisX() Axis {
+	return Axis{
+		Orientation: Horizontal,
+		Min:         v.MinX,
+		Max:         v.MaxX,
+		Origin:      t8go.Point{X: v.Screen.X, Y: v.Screen.Max().Y},
+		Length:      v.Screen.Width,
+	}
+}
+
+// AxisY returns a vertical axis along the left edge of Screen covering the
+// world Y range, ready to be customized and drawn.
+func (v *Viewport) AxisY() Axis {
+	return Axis{
+		Orientation: Vertical,
+		Min:         v.MinY,
+		Max:         v.MaxY,
+		Origin:      t8go.Point{X: v.Screen.X, Y: v.Screen.Max().Y},
+		Length:      v.Screen.Height,
+	}
+}
+
+// project returns the unsaturated screen coordinates of the world point (x, y).
+func (v *Viewport) project(x, y int32) (screenX, screenY int64) {
+	screenX = int64(v.Screen.X) + scale(x, v.MinX, v.MaxX, v.Screen.Width)
+	screenY = int64(v.Screen.Max().Y) - scale(y, v.MinY, v.MaxY, v.Screen.Height)
+	return screenX, screenY
+}
+
+// * ----- Plotting -----
+
+// Point draws the world point (x, y) if it is inside Screen.
+func (v *Viewport) Point(ctx t8go.IDisplayDrawer, x, y int32) {
+	screenX, screenY := v.project(x, y)
+	if outcode(screenX, screenY, v.Screen) == 0 {
+		ctx.DrawPixel(int16(screenX), int16(screenY))
+	}
+}
+
+// Line draws the world line from (x0, y0) to (x1, y1), clipped to Screen.
+func (v *Viewport) Line(ctx t8go.IDisplayDrawer, x0, y0, x1, y1 int32) {
+	startX, startY := v.project(x0, y0)
+	endX, endY := v.project(x1, y1)
+	if start, end, ok := clipLine(startX, startY, endX, endY, v.Screen); ok {
+		ctx.DrawLine(start.X, start.Y, end.X, end.Y)
+	}
+}
+
+// Plot draws the series of world points (xs[i], ys[i]) joined by lines,
+// clipped to Screen. Extra values of the longer slice are ignored.
+func (v *Viewport) Plot(ctx t8go.IDisplayDrawer, xs, ys []int32) {
+	count := min(len(xs), len(ys))
+	if count == 1 {
+		v.Point(ctx, xs[0], ys[0])
+	}
+	for i := 1; i < count; i++ {
+		v.Line(ctx, xs[i-1], ys[i-1], xs[i], ys[i])
+	}
+}
+
+// Scatter draws every world point (xs[i], ys[i]) inside Screen as a pixel.
+// Extra values of the longer slice are ignored.
+func (v *Viewport) Scatter(ctx t8go.IDisplayDrawer, xs, ys []int32) {
+	for i := range min(len(xs), len(ys)) {
+		v.Point(ctx, xs[i], ys[i])
+	}
+}
+
+// clipLine clips the segment from (x0, y0) to (x1, y1) to area with the
+// Cohen-Sutherland algorithm and reports whether any part of it is visible.
+func clipLine(x0, y0, x1, y1 int64, area t8go.Rect) (start, end t8go.Point, ok bool) {
+	x0, y0 = limit(x0), limit(y0)
+	x1, y1 = limit(x1), limit(y1)
+	corner := area.Max()
+	left, top := int64(area.X), int64(area.Y)
+	right, bottom := int64(corner.X), int64(corner.Y)
+
+	code0, code1 := outcode(x0, y0, area), outcode(x1, y1, area)
+	// Every pass moves one end onto an edge; rounding may need a few more
+	for range 8 {
+		if code0|code1 == 0 {
+			start = t8go.Point{X: int16(x0), Y: int16(y0)}
+			end = t8go.Point{X: int16(x1), Y: int16(y1)}
+			return start, end, true
+		}
+		if code0&code1 != 0 {
+			return start, end, false
+		}
+
+		code := code0
+		if code == 0 {
+			code = code1
+		}
+
+		var x, y int64
+		switch {
+		case code&outTop != 0:
+			x, y = x0+roundDiv((x1-x0)*(top-y0), y1-y0), top
+		case code&outBottom != 0:
+			x, y = x0+roundDiv((x1-x0)*(bottom-y0), y1-y0), bottom
+		case code&outLeft != 0:
+			x, y = left, y0+roundDiv((y1-y0)*(left-x0), x1-x0)
+		default:
+			x, y = right, y0+roundDiv((y1-y0)*(right-x0), x1-x0)
+		}
+
+		if code == code0 {
+			x0, y0, code0 = x, y, outcode(x, y, area)
+		} else {
+			x1, y1, code1 = x, y, outcode(x, y, area)
+		}
+	}
+	return start, end, false
+}
+
+// outcode returns the outcode bits of (x, y) relative to area, 0 inside.
+func outcode(x, y int64, area t8go.Rect) uint8 {
+	if area.Empty() {
+		return outLeft | outRight
+	}
+	corner := area.Max()
+	var code uint8
+	if x < int64(area.X) {
+		code |= outLeft
+	} else if x > int64(corner.X) {
+		code |= outRight
+	}
+	if y < int64(area.Y) {
+		code |= outTop
+	} else if y > int64(corner.Y) {
+		code |= outBottom
+	}
+	return code
+}
+
+// unscale returns the value at offset pixels along length pixels covering
+// low..high, the inverse of scale.
+func unscale(offset int64, low, high int32, length int16) int32 {
+	if length <= 1 {
+		return low
+	}
+	value := int64(low) + roundDiv(offset*(int64(high)-int64(low)), int64(length-1))
+	return int32(max(min(value, 1<<31-1), -1<<31))
+}
+
+// within reports whether value lies between the bounds, in either order.
+func within(value, a, b int32) bool {
+	return min(a, b) <= value && value <= max(a, b)
+}
+
+// limit clamps a screen coordinate to ±clipLimit.
+func limit(value int64) int64 {
+	return max(min(value, clipLimit), -clipLimit)
+}
+
+// saturate converts a screen coordinate to int16, saturating at its limits.
+func saturate(value int64) int16 {
+	return int16(max(min(value, 1<<15-1), -1<<15))
+}
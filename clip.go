@@ -0,0 +1,127 @@
+package t8go
+
+import "math"
+
+// clipCircle is the circular clip area of the graphics state.
+type clipCircle struct {
+	centerX, centerY int32 // Center of the circle
+	radius           int32 // Radius in pixels
+	set              bool  // Whether drawing is clipped to the circle
+}
+
+// clipRect is the rectangular clip area of the graphics state.
+type clipRect struct {
+	minX, minY int16 // Top-left corner, inclusive
+	maxX, maxY int16 // Bottom-right corner, inclusive
+	set        bool  // Whether drawing is clipped to the rectangle
+}
+
+// SetClipRect restricts drawing to the width x height rectangle with its
+// top-left corner at (x, y), so a widget redrawn on its own cannot spill
+// into its neighbors. Every drawing call is clipped, including SetPixel,
+// ClearRegion and DrawBuffer, but not ClearBuffer; the primitives clip their
+// spans before rasterizing, so they keep the fast paths of the driver. It
+// combines with SetClipCircle, drawing only where both allow. The clip is
+// part of the graphics state saved by PushState; a width or height that is
+// not positive clips everything.
+func (t *T8Go) SetClipRect(x, y, width, height int16) {
+	if width <= 0 || height <= 0 {
+		t.state.rect = clipRect{minX: 0, minY: 0, maxX: -1, maxY: -1, set: true}
+		return
+	}
+	t.state.rect = clipRect{
+		minX: x,
+		minY: y,
+		maxX: int16(min(int32(x)+int32(width)-1, math.MaxInt16)),
+		maxY: int16(min(int32(y)+int32(height)-1, math.MaxInt16)),
+		set:  true,
+	}
+}
+
+// SetClipCircle restricts drawing to the pixels within radius of
+// (centerX, centerY), rounded like the discs of DrawCircleFill, for round panels
+// whose corners are outside the glass or hidden behind a bezel. Every
+// drawing call is clipped, including SetPixel, ClearRegion and DrawBuffer,
+// but not ClearBuffer. Spans stay on the fast paths of the driver, cut to the
+// circle row by row. The clip is part of the graphics state saved by
+// PushState; a negative radius clips everything.
+func (t *T8Go) SetClipCircle(centerX, centerY, radius int16) {
+	t.state.circle = clipCircle{centerX: int32(centerX), centerY: int32(centerY), radius: int32(radius), set: true}
+}
+
+// ClearClip removes the clip rect and circle, so drawing covers the whole
+// display again.
+func (t *T8Go) ClearClip() {
+	t.state.rect = clipRect{}
+	t.state.circle = clipCircle{}
+}
+
+// contains reports whether the inclusive box (minX, minY)-(maxX, maxY) lies
+// inside the clip rect; without a clip rect, every box does.
+func (r *clipRect) contains(minX, minY, maxX, maxY int32) bool {
+	return !r.set || minX >= int32(r.minX) && minY >= int32(r.minY) && maxX <= int32(r.maxX) && maxY <= int32(r.maxY)
+}
+
+// clipped reports whether drawing is clipped to a circle.
+func (t *T8Go) clipped() bool {
+	return t.state.circle.set
+}
+
+// inClip reports whether the pixel at (x, y) is inside the clip circle.
+func (t *T8Go) inClip(x, y int32) bool {
+	circle := &t.state.circle
+	half, ok := circle.halfChord(y - circle.centerY)
+	return ok && x >= circle.centerX-half && x <= circle.centerX+half
+}
+
+// clipRow cuts the run from startX to endX on row y to the clip circle and
+// reports whether any of it is left.
+func (t *T8Go) clipRow(y, startX, endX int32) (int32, int32, bool) {
+	circle := &t.state.circle
+	half, ok := circle.halfChord(y - circle.centerY)
+	startX, endX = max(startX, circle.centerX-half), min(endX, circle.centerX+half)
+	return startX, endX, ok && startX <= endX
+}
+
+// clipColumn cuts the run from startY to endY on column x to the clip circle
+// and reports whether any of it is left.
+func (t *T8Go) clipColumn(x, startY, endY int32) (int32, int32, bool) {
+	circle := &t.state.circle
+	half, ok := circle.halfChord(x - circle.centerX)
+	startY, endY = max(startY, circle.centerY-half), min(endY, circle.centerY+half)
+	return startY, endY, ok && startY <= endY
+}
+
+// halfChord returns the half width of the chord of the circle at offset
+// pixels from its center, or false when the line misses the circle.
+func (c *clipCircle) halfChord(offset int32) (int32, bool) {
+	return discHalfWidth(c.radius, offset)
+}
+
+// discHalfWidth returns the half width of the row of a disc of the given
+// radius at offset pixels from its center, or false when the row misses the
+// disc. The r*r + r bound rounds like the midpoint discs of DrawCircleFill.
+func discHalfWidth(radius, offset int32) (int32, bool) {
+	if radius < 0 || offset < -radius || offset > radius {
+		return 0, false
+	}
+	return isqrt32(uint32(radius*radius + radius - offset*offset)), true
+}
+
+// isqrt32 returns the integer square root of value, rounded down.
+func isqrt32(value uint32) int32 {
+	root, bit := uint32(0), uint32(1)<<30
+	for bit > value {
+		bit >>= 2
+	}
+	for bit != 0 {
+		if value >= root+bit {
+			value -= root + bit
+			root = root>>1 + bit
+		} else {
+			root >>= 1
+		}
+		bit >>= 2
+	}
+	return int32(root)
+}
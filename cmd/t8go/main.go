@@ -0,0 +1,147 @@
+// Command t8go renders a drawing script without hardware, so screens can be
+// designed and iterated on without writing a main() or flashing a device.
+//
+// A script has one drawing call per line: the IDisplayDrawer method name,
+// with or without the "Draw" prefix, followed by its arguments. Lines
+// starting with '#' are comments (see record.Recording.UnmarshalText):
+//
+//	# status bar
+//	BoxFill 0 0 128 10
+//	ClearRegion 2 2 20 6
+//	CircleFill 64 40 12 0
+//
+// The result is shown in the terminal and can be saved as a BMP file.
+//
+// Usage:
+//
+//	t8go [-width 128] [-height 64] [-o screen.bmp [-open]] [-ascii] [-watch] script.t8
+//
+// With -watch the script is rendered again whenever it changes. Use "-" to
+// read the script from standard input.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"time"
+
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/drivers/bitmap"
+	"github.com/redghc/t8go/record"
+)
+
+func main() {
+	width := flag.Uint("width", 128, "display width in pixels")
+	height := flag.Uint("height", 64, "display height in pixels")
+	output := flag.String("o", "", "write the result to this BMP file")
+	open := flag.Bool("open", false, "open the BMP file with the system viewer")
+	ascii := flag.Bool("ascii", false, "preview with ASCII characters instead of braille")
+	watch := flag.Bool("watch", false, "render again whenever the script changes")
+	flag.Parse()
+
+	if flag.NArg() != 1 || *width == 0 || *height == 0 || *width > 4096 || *height > 4096 {
+		fmt.Fprintln(os.Stderr, "usage: t8go [flags] script.t8")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+	path := flag.Arg(0)
+
+	run := func() error {
+		script, err := readScript(path)
+		if err != nil {
+			return err
+		}
+		return render(script, uint16(*width), uint16(*height), *output, *ascii)
+	}
+
+	if !*watch {
+		if err := run(); err != nil {
+			fatal(err)
+		}
+		if *open && *output != "" {
+			if err := openFile(*output); err != nil {
+				fatal(err)
+			}
+		}
+		return
+	}
+
+	if path == "-" {
+		fatal(fmt.Errorf("-watch needs a script file"))
+	}
+	var modified time.Time
+	for {
+		info, err := os.Stat(path)
+		if err == nil && !info.ModTime().Equal(modified) {
+			modified = info.ModTime()
+			fmt.Print("\x1b[H\x1b[2J") // Redraw from a clean screen
+			if err := run(); err != nil {
+				fmt.Fprintln(os.Stderr, "t8go:", err)
+			}
+		}
+		time.Sleep(300 * time.Millisecond)
+	}
+}
+
+// readScript returns the contents of the script at path, or of standard input for "-".
+func readScript(path string) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(path)
+}
+
+// render draws script on a new display, previews it on standard output and
+// saves it to output when set.
+func render(script []byte, width, height uint16, output string, ascii bool) error {
+	var recording record.Recording
+	if err := recording.UnmarshalText(script); err != nil {
+		return err
+	}
+
+	display, err := bitmap.New(bitmap.Config{Width: width, Height: height, Filename: output})
+	if err != nil {
+		return err
+	}
+	ctx := t8go.New(display)
+	if err := recording.Replay(ctx); err != nil {
+		return err
+	}
+
+	if ascii {
+		err = ctx.DumpASCII(os.Stdout)
+	} else {
+		err = ctx.DumpBraille(os.Stdout)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d commands, %dx%d\n", recording.Len(), width, height)
+
+	if output == "" {
+		return nil
+	}
+	return ctx.Display()
+}
+
+// openFile opens path with the default viewer of the operating system.
+func openFile(path string) error {
+	switch runtime.GOOS {
+	case "darwin":
+		return exec.Command("open", path).Start()
+	case "windows":
+		return exec.Command("cmd", "/c", "start", "", path).Start()
+	default:
+		return exec.Command("xdg-open", path).Start()
+	}
+}
+
+// fatal prints err and exits.
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "t8go:", err)
+	os.Exit(1)
+}
@@ -0,0 +1,132 @@
+// Command t8gobench runs the t8go benchmark suite on the in-memory display
+// and exits with a non-zero status when any case exceeds its allocation budget.
+//
+// With -compare it instead runs the standard frame workloads on the null,
+// bitmap and remote drivers and reports frames per second, bytes transferred
+// and allocations per frame, with the frame rate each I²C/SPI link would
+// allow. Hardware drivers only build for their targets; run
+// benchmarks.Compare on the device to measure them.
+//
+// Usage:
+//
+//	t8gobench [-width 128] [-height 64] [-runs 100] [-allocs] [-compare]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/redghc/t8go/benchmarks"
+	"github.com/redghc/t8go/drivers/bitmap"
+	"github.com/redghc/t8go/drivers/memory"
+	"github.com/redghc/t8go/drivers/remote"
+)
+
+func main() {
+	width := flag.Uint("width", 128, "display width in pixels")
+	height := flag.Uint("height", 64, "display height in pixels")
+	runs := flag.Int("runs", 100, "iterations averaged by the allocation check")
+	allocsOnly := flag.Bool("allocs", false, "only check allocations, skip timing")
+	compare := flag.Bool("compare", false, "compare frame workloads across drivers")
+	flag.Parse()
+
+	if *compare {
+		config := benchmarks.Config{Runs: *runs, SkipTimed: *allocsOnly}
+		if err := compareDrivers(uint16(*width), uint16(*height), config); err != nil {
+			fmt.Fprintln(os.Stderr, "t8gobench:", err)
+			os.Exit(2)
+		}
+		return
+	}
+
+	display, err := memory.New(memory.Config{
+		Width:  uint16(*width),
+		Height: uint16(*height),
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "t8gobench:", err)
+		os.Exit(2)
+	}
+
+	results, err := benchmarks.Run(display, benchmarks.Cases(), benchmarks.Config{
+		Runs:      *runs,
+		SkipTimed: *allocsOnly,
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "t8gobench:", err)
+		os.Exit(2)
+	}
+
+	if err := benchmarks.Write(os.Stdout, results); err != nil {
+		fmt.Fprintln(os.Stderr, "t8gobench:", err)
+		os.Exit(2)
+	}
+
+	if err := benchmarks.Check(results); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// counter is an io.Writer that discards its input and counts the bytes.
+type counter struct {
+	bytes int64 // Bytes written so far
+}
+
+// Write counts p.
+func (c *counter) Write(p []byte) (int, error) {
+	c.bytes += int64(len(p))
+	return len(p), nil
+}
+
+// compareDrivers runs the frame workloads on every driver available on the
+// host and prints the comparison.
+func compareDrivers(width, height uint16, config benchmarks.Config) error {
+	dir, err := os.MkdirTemp("", "t8gobench")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	null, err := memory.New(memory.Config{Width: width, Height: height})
+	if err != nil {
+		return err
+	}
+	file, err := bitmap.New(bitmap.Config{Width: width, Height: height, Filename: filepath.Join(dir, "frame.bmp")})
+	if err != nil {
+		return err
+	}
+	targets := []benchmarks.Target{
+		{Name: "null", Display: null},
+		{Name: "bitmap", Display: file},
+	}
+
+	encodings := []struct {
+		name     string
+		encoding remote.Encoding
+	}{
+		{"remote-raw", remote.EncodingRaw},
+		{"remote-rle", remote.EncodingRLE},
+		{"remote-delta", remote.EncodingDelta},
+	}
+	for _, e := range encodings {
+		sink := &counter{}
+		display, err := remote.New(sink, remote.Config{Width: width, Height: height, Encoding: e.encoding})
+		if err != nil {
+			return err
+		}
+		targets = append(targets, benchmarks.Target{
+			Name:        e.name,
+			Display:     display,
+			Transferred: func() int64 { return sink.bytes },
+		})
+	}
+
+	results, err := benchmarks.Compare(targets, benchmarks.Workloads(), config)
+	if err != nil {
+		return err
+	}
+	return benchmarks.WriteComparison(os.Stdout, results, benchmarks.Links())
+}
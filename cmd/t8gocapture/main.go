@@ -0,0 +1,108 @@
+// Command t8gocapture decodes a screen dump sent by capture.ScreenService
+// over a serial console, so a device in the field can show support what its
+// screen displays. The dump may be surrounded by other console output, as in
+// a log pasted by a user.
+//
+// Usage:
+//
+//	t8gocapture [-o screen.bmp] [-ascii] console.log    # decode a saved log
+//	t8gocapture -request [-o screen.bmp] /dev/ttyUSB0   # ask the device and decode its answer
+//
+// With -request the request line is written to the serial device before the
+// dump is read; configure the port (baud rate, raw mode) beforehand, for
+// example with stty. Use "-" to read from standard input.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/capture"
+	"github.com/redghc/t8go/drivers/bitmap"
+)
+
+func main() {
+	output := flag.String("o", "", "write the screen to this BMP file")
+	ascii := flag.Bool("ascii", false, "preview with ASCII characters instead of braille")
+	request := flag.Bool("request", false, "send the capture request before reading")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: t8gocapture [flags] console.log|device")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+
+	input, err := openInput(flag.Arg(0), *request)
+	if err != nil {
+		fatal(err)
+	}
+	defer input.Close()
+
+	screen, err := capture.ReadScreen(input)
+	if err != nil {
+		fatal(err)
+	}
+
+	display, err := bitmap.New(bitmap.Config{Width: uint16(screen.Width), Height: uint16(screen.Height), Filename: *output})
+	if err != nil {
+		fatal(err)
+	}
+	ctx := t8go.New(display)
+	for y := range screen.Height {
+		for x := range screen.Width {
+			if screen.GetPixel(x, y) {
+				ctx.SetPixel(int16(x), int16(y), true)
+			}
+		}
+	}
+
+	if *ascii {
+		err = ctx.DumpASCII(os.Stdout)
+	} else {
+		err = ctx.DumpBraille(os.Stdout)
+	}
+	if err != nil {
+		fatal(err)
+	}
+	fmt.Printf("%dx%d\n", screen.Width, screen.Height)
+
+	if *output != "" {
+		if err := ctx.Display(); err != nil {
+			fatal(err)
+		}
+	}
+}
+
+// openInput opens the log or serial device at path, or standard input for
+// "-", and writes the capture request to it when request is set.
+func openInput(path string, request bool) (io.ReadCloser, error) {
+	if path == "-" {
+		if request {
+			return nil, fmt.Errorf("-request needs a serial device")
+		}
+		return io.NopCloser(os.Stdin), nil
+	}
+	if !request {
+		return os.Open(path)
+	}
+
+	device, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.WriteString(device, capture.ScreenRequest+"\n"); err != nil {
+		device.Close()
+		return nil, err
+	}
+	return device, nil
+}
+
+// fatal prints err and exits.
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "t8gocapture:", err)
+	os.Exit(1)
+}
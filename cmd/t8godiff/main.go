@@ -0,0 +1,138 @@
+// Command t8godiff compares golden images (the text format of
+// T8Go.DumpASCII, see drawtest.ExpectGolden) and writes a composite BMP for
+// every pair that differs, with added pixels checkered and removed pixels as
+// hollow squares, so visual regressions can be reviewed at a glance.
+//
+// Usage:
+//
+//	t8godiff [-o diffs] old.txt new.txt    # compare two golden images
+//	t8godiff [-o diffs] old/ new/          # compare two golden sets by file name
+//
+// It exits with status 1 when any image differs or is missing from one set.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/redghc/t8go/drawtest"
+)
+
+func main() {
+	outDir := flag.String("o", ".", "directory for the diff images")
+	flag.Parse()
+	if flag.NArg() != 2 {
+		fmt.Fprintln(os.Stderr, "usage: t8godiff [-o dir] old new")
+		os.Exit(2)
+	}
+	oldPath, newPath := flag.Arg(0), flag.Arg(1)
+
+	info, err := os.Stat(oldPath)
+	if err != nil {
+		fatal(err)
+	}
+
+	pairs := [][2]string{{oldPath, newPath}}
+	if info.IsDir() {
+		pairs, err = matchFiles(oldPath, newPath)
+		if err != nil {
+			fatal(err)
+		}
+	}
+
+	if err := os.MkdirAll(*outDir, 0o755); err != nil {
+		fatal(err)
+	}
+
+	failed := false
+	for _, pair := range pairs {
+		changed, err := compare(pair[0], pair[1], *outDir)
+		switch {
+		case err != nil:
+			fmt.Fprintf(os.Stderr, "%s: %v\n", filepath.Base(pair[0]), err)
+			failed = true
+		case changed > 0:
+			fmt.Printf("%s: %d pixels changed\n", filepath.Base(pair[0]), changed)
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// matchFiles pairs the files of two golden directories by name. Files found
+// in only one directory are paired with a missing file.
+func matchFiles(oldDir, newDir string) ([][2]string, error) {
+	names := map[string]bool{}
+	for _, dir := range []string{oldDir, newDir} {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() && filepath.Ext(entry.Name()) != ".bmp" {
+				names[entry.Name()] = true
+			}
+		}
+	}
+
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	slices.Sort(sorted)
+
+	pairs := make([][2]string, len(sorted))
+	for i, name := range sorted {
+		pairs[i] = [2]string{filepath.Join(oldDir, name), filepath.Join(newDir, name)}
+	}
+	return pairs, nil
+}
+
+// compare diffs two golden files and writes the diff image to outDir when
+// they differ. It returns the number of changed pixels.
+func compare(oldPath, newPath, outDir string) (int, error) {
+	oldImage, err := readGolden(oldPath)
+	if err != nil {
+		return 0, err
+	}
+	newImage, err := readGolden(newPath)
+	if err != nil {
+		return 0, err
+	}
+
+	width, height := max(oldImage.Width, newImage.Width), max(oldImage.Height, newImage.Height)
+	_, changed := drawtest.DiffText(width, height, newImage.Pixel, oldImage.Pixel)
+	if changed == 0 && oldImage.Width == newImage.Width && oldImage.Height == newImage.Height {
+		return 0, nil
+	}
+
+	file, err := os.Create(filepath.Join(outDir, filepath.Base(oldPath)+".diff.bmp"))
+	if err != nil {
+		return 0, err
+	}
+	if err := drawtest.WriteDiff(file, width, height, newImage.Pixel, oldImage.Pixel); err != nil {
+		file.Close()
+		return 0, err
+	}
+	return changed, file.Close()
+}
+
+// readGolden reads and parses the golden image at path.
+func readGolden(path string) (*drawtest.Golden, error) {
+	text, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return drawtest.ParseGolden(text)
+}
+
+// fatal prints err and exits.
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "t8godiff:", err)
+	os.Exit(1)
+}
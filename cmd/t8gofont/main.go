@@ -0,0 +1,290 @@
+//go:build !t8go_minimal
+
+// Command t8gofont inspects and converts t8go fonts, so fonts from other
+// libraries can be validated before they are flashed.
+//
+// Usage:
+//
+//	t8gofont preview [-font 5x7|font.bdf|font.h] [-sample text] [-columns 16] [-o preview.png] [-metrics=false]
+//	t8gofont convert [-package fonts] [-name Font] [-chars 0-9:.%°C] -o font.go 5x7|font.bdf|font.h
+//
+// The preview command prints the font metrics, every glyph with its metrics
+// and a grid of all glyphs followed by a sample string, rendered in the
+// terminal. With -o the grid is also saved as a PNG or BMP image.
+//
+// The convert command reads a builtin font, a BDF font or an Adafruit GFX
+// font header and writes it as Go source declaring a *t8go.Font, ready for
+// go:generate. With -chars only the glyphs of the listed characters are
+// written (ranges as "a-z"), so small targets carry just what they draw.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/bmp"
+	"github.com/redghc/t8go/drivers/memory"
+	"github.com/redghc/t8go/fonts"
+)
+
+// builtinFonts lists the fonts that can be selected by name.
+var builtinFonts = map[string]*t8go.Font{
+	"5x7": t8go.Font5x7,
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	switch os.Args[1] {
+	case "preview":
+		preview(os.Args[2:])
+	case "convert":
+		convert(os.Args[2:])
+	default:
+		usage()
+	}
+}
+
+// usage prints the available commands and exits.
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: t8gofont preview [flags]")
+	fmt.Fprintln(os.Stderr, "       t8gofont convert [flags] -o font.go 5x7|font.bdf|font.h")
+	os.Exit(2)
+}
+
+// preview implements the preview command.
+func preview(args []string) {
+	flags := flag.NewFlagSet("preview", flag.ExitOnError)
+	fontName := flags.String("font", "5x7", "builtin font name, or a .bdf or GFX .h file to preview")
+	sample := flags.String("sample", "The quick brown fox jumps over the lazy dog 0123456789", "sample string")
+	columns := flags.Int("columns", 16, "glyphs per grid row")
+	output := flags.String("o", "", "save the preview as a .png or .bmp image")
+	metrics := flags.Bool("metrics", true, "print per-glyph metrics")
+	flags.Parse(args)
+
+	font, err := loadFont(*fontName)
+	if err != nil {
+		fatal(err)
+	}
+	if len(font.Glyphs) == 0 {
+		fatal(fmt.Errorf("font %s has no glyphs", font.Name))
+	}
+
+	printSummary(os.Stdout, font)
+	if *metrics {
+		printMetrics(os.Stdout, font)
+	}
+
+	ctx, err := renderPreview(font, *sample, max(*columns, 1))
+	if err != nil {
+		fatal(err)
+	}
+	renderTerminal(os.Stdout, ctx)
+
+	if *output != "" {
+		if err := saveImage(*output, ctx); err != nil {
+			fatal(err)
+		}
+	}
+}
+
+// convert implements the convert command.
+func convert(args []string) {
+	flags := flag.NewFlagSet("convert", flag.ExitOnError)
+	output := flags.String("o", "", "Go file to write")
+	pkg := flags.String("package", "fonts", "package name of the Go source")
+	name := flags.String("name", "Font", "variable name of the Go source")
+	chars := flags.String("chars", "", "keep only the glyphs of these characters (ranges as a-z)")
+	flags.Parse(args)
+	if flags.NArg() != 1 || *output == "" {
+		usage()
+	}
+
+	font, err := loadFont(flags.Arg(0))
+	if err != nil {
+		fatal(err)
+	}
+	if *chars != "" {
+		missing, err := fonts.Missing(font, *chars)
+		if err != nil {
+			fatal(err)
+		}
+		if len(missing) > 0 {
+			fmt.Fprintf(os.Stderr, "t8gofont: font %s has no glyph for %q\n", font.Name, string(missing))
+		}
+		if font, err = fonts.Subset(font, *chars); err != nil {
+			fatal(err)
+		}
+	}
+	source, err := fonts.GoSource(font, *pkg, *name)
+	if err != nil {
+		fatal(err)
+	}
+	if err := os.WriteFile(*output, source, 0o644); err != nil {
+		fatal(err)
+	}
+	printSummary(os.Stdout, font)
+}
+
+// loadFont returns the builtin font with the given name, or reads a BDF
+// (.bdf) or Adafruit GFX (.h) font file.
+func loadFont(name string) (*t8go.Font, error) {
+	if font, ok := builtinFonts[name]; ok {
+		return font, nil
+	}
+
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".bdf":
+		file, err := os.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		return fonts.ParseBDF(file)
+	case ".h":
+		src, err := os.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		return fonts.ParseGFX(src)
+	}
+	return nil, fmt.Errorf("unknown font %q (use a builtin name, .bdf or .h)", name)
+}
+
+// printSummary prints the font-wide metrics.
+func printSummary(w io.Writer, font *t8go.Font) {
+	first, last := font.Glyphs[0].Rune, font.Glyphs[len(font.Glyphs)-1].Rune
+	fmt.Fprintf(w, "font %s: %d glyphs (U+%04X..U+%04X), %d bitmap bytes\n",
+		font.Name, len(font.Glyphs), first, last, len(font.Bitmap))
+	fmt.Fprintf(w, "ascent %d, descent %d, line height %d\n\n", font.Ascent, font.Descent, font.LineHeight)
+}
+
+// printMetrics prints a table with the metrics of every glyph.
+func printMetrics(w io.Writer, font *t8go.Font) {
+	fmt.Fprintln(w, "rune    char  width height xoff yoff advance offset")
+	for _, glyph := range font.Glyphs {
+		fmt.Fprintf(w, "U+%04X  %-4s  %5d %6d %4d %4d %7d %6d\n",
+			glyph.Rune, printable(glyph.Rune), glyph.Width, glyph.Height,
+			glyph.XOffset, glyph.YOffset, glyph.Advance, glyph.Offset)
+	}
+	fmt.Fprintln(w)
+}
+
+// printable returns r quoted for the metrics table, or its escape when it is not visible.
+func printable(r rune) string {
+	if r <= ' ' || r == 0x7F {
+		return fmt.Sprintf(`\x%02X`, r)
+	}
+	return string(r)
+}
+
+// renderPreview draws every glyph of font in a grid of boxed cells, one cell
+// per glyph advance with the baseline at the same height, followed by the
+// sample string, and returns the drawing.
+func renderPreview(font *t8go.Font, sample string, columns int) (t8go.IDisplayDrawer, error) {
+	cellWidth, cellHeight := int16(1), font.Ascent+font.Descent+2
+	for _, glyph := range font.Glyphs {
+		cellWidth = max(cellWidth, int16(glyph.Advance), int16(glyph.XOffset)+int16(glyph.Width))
+	}
+	cellWidth += 2
+
+	rows := (len(font.Glyphs) + columns - 1) / columns
+	sampleWidth := int16(0)
+	for _, char := range []byte(sample) {
+		if glyph, ok := font.Glyph(rune(char)); ok {
+			sampleWidth += int16(glyph.Advance)
+		}
+	}
+
+	width := max(int(cellWidth)*columns+1, int(sampleWidth)+2)
+	height := int(cellHeight)*rows + 1 + int(font.LineHeight) + 2
+	display, err := memory.New(memory.Config{Width: uint16(width), Height: uint16(height)})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := t8go.New(display)
+	ctx.SetFont(font)
+	for i, glyph := range font.Glyphs {
+		cellX, cellY := int16(i%columns)*cellWidth, int16(i/columns)*cellHeight
+		ctx.DrawBox(cellX, cellY, cellWidth+1, cellHeight+1)
+		ctx.DrawText(cellX+1, cellY+1+font.Ascent, string(glyph.Rune))
+	}
+	ctx.DrawText(1, int16(rows)*cellHeight+2+font.Ascent, sample)
+	return ctx, nil
+}
+
+// renderTerminal draws the preview with half-block characters, two pixel
+// rows per text line.
+func renderTerminal(w io.Writer, ctx t8go.IDisplayDrawer) {
+	width, height := ctx.Size()
+
+	var sb strings.Builder
+	for y := int16(0); y < int16(height); y += 2 {
+		for x := range int16(width) {
+			top, bottom := ctx.GetPixel(x, y), ctx.GetPixel(x, y+1)
+			switch {
+			case top && bottom:
+				sb.WriteString("█")
+			case top:
+				sb.WriteString("▀")
+			case bottom:
+				sb.WriteString("▄")
+			default:
+				sb.WriteByte(' ')
+			}
+		}
+		sb.WriteByte('\n')
+	}
+	io.WriteString(w, sb.String())
+}
+
+// saveImage writes the preview to path as PNG or BMP, chosen by extension.
+func saveImage(path string, ctx t8go.IDisplayDrawer) error {
+	width, height := ctx.Size()
+	pixel := func(x, y int) bool { return ctx.GetPixel(int16(x), int16(y)) }
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".bmp":
+		err = bmp.Encode(file, int(width), int(height), pixel)
+	case ".png":
+		img := image.NewGray(image.Rect(0, 0, int(width), int(height)))
+		for y := range int(height) {
+			for x := range int(width) {
+				if pixel(x, y) {
+					img.SetGray(x, y, color.Gray{Y: 0xFF})
+				}
+			}
+		}
+		err = png.Encode(file, img)
+	default:
+		err = fmt.Errorf("unsupported image format %q (use .png or .bmp)", filepath.Ext(path))
+	}
+
+	if err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// fatal prints err and exits.
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "t8gofont:", err)
+	os.Exit(1)
+}
@@ -0,0 +1,231 @@
+// Command t8gogallery renders every example scene to a BMP image and writes a
+// gallery page (index.html and README.md) showing each image next to the code
+// that drew it. It doubles as a smoke test of the whole API: it fails when a
+// scene panics, records an error, draws nothing or writes outside the display.
+//
+// Usage:
+//
+//	t8gogallery [-o gallery]
+//
+// Scenes live in scenes.go; their function bodies become the code snippets.
+package main
+
+import (
+	_ "embed"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"html/template"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/drawtest"
+	"github.com/redghc/t8go/drivers/bitmap"
+)
+
+// Dimensions of the gallery images.
+const (
+	sceneWidth  = 128
+	sceneHeight = 64
+)
+
+//go:embed scenes.go
+var scenesSource string
+
+// scene is a gallery entry.
+type scene struct {
+	Title string                        // Caption in the gallery
+	Draw  func(ctx t8go.IDisplayDrawer) // Draws the scene
+}
+
+// scenes lists the gallery entries in display order.
+var scenes = []scene{
+	{"Lines", sceneLines},
+	{"Boxes", sceneBoxes},
+	{"Circles and ellipses", sceneCircles},
+	{"Arcs", sceneArcs},
+	{"Triangles", sceneTriangles},
+	{"Chained drawing", sceneChain},
+	{"Widgets from JSON", sceneWidgets},
+	{"Display lists", sceneRecording},
+	{"Geometry", sceneGeometry},
+	{"Particles", sceneParticles},
+	{"Text", sceneText},
+	{"Tile map", sceneTileMap},
+	{"Chart axes", sceneChartAxes},
+	{"Text scroller", sceneTextScroller},
+	{"Weather icons", sceneWeather},
+}
+
+// entry is a rendered scene, as shown by the page templates.
+type entry struct {
+	Title   string // Caption
+	Image   string // Image file name relative to the gallery
+	Snippet string // Source of the scene body
+}
+
+func main() {
+	outDir := flag.String("o", "gallery", "output directory")
+	flag.Parse()
+
+	snippets, err := parseSnippets(scenesSource)
+	if err != nil {
+		fatal(err)
+	}
+	if err := os.MkdirAll(*outDir, 0o755); err != nil {
+		fatal(err)
+	}
+
+	entries := make([]entry, 0, len(scenes))
+	failed := false
+	for _, s := range scenes {
+		name := funcName(s.Draw)
+		if err := check(s); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", name, err)
+			failed = true
+			continue
+		}
+
+		image := strings.TrimPrefix(name, "scene") + ".bmp"
+		if err := render(s, filepath.Join(*outDir, image)); err != nil {
+			fatal(err)
+		}
+		entries = append(entries, entry{Title: s.Title, Image: image, Snippet: snippets[name]})
+	}
+
+	if err := writePages(*outDir, entries); err != nil {
+		fatal(err)
+	}
+	fmt.Printf("%d scenes written to %s\n", len(entries), *outDir)
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// check draws s on a virtual display and reports panics, recorded errors,
+// out-of-bounds writes and empty output.
+func check(s scene) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("panic: %v", recovered)
+		}
+	}()
+
+	display := drawtest.NewDisplay(sceneWidth, sceneHeight)
+	ctx := t8go.New(display)
+	s.Draw(ctx)
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if count, first := display.OutOfBounds(); count > 0 {
+		return fmt.Errorf("%d pixels written outside the display, first at %v", count, first)
+	}
+	for _, b := range display.Buffer() {
+		if b != 0 {
+			return nil
+		}
+	}
+	return fmt.Errorf("scene draws nothing")
+}
+
+// render draws s with the bitmap driver, saving it to path.
+func render(s scene, path string) error {
+	display, err := bitmap.New(bitmap.Config{Width: sceneWidth, Height: sceneHeight, Filename: path})
+	if err != nil {
+		return err
+	}
+	ctx := t8go.New(display)
+	s.Draw(ctx)
+	return ctx.Display()
+}
+
+// funcName returns the unqualified name of function f.
+func funcName(f any) string {
+	name := runtime.FuncForPC(reflect.ValueOf(f).Pointer()).Name()
+	return name[strings.LastIndexByte(name, '.')+1:]
+}
+
+// parseSnippets returns the body of every function in source, without the
+// braces and one level of indentation, by function name.
+func parseSnippets(source string) (map[string]string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "scenes.go", source, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	snippets := map[string]string{}
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+		start := fset.Position(fn.Body.Lbrace).Offset + 1
+		end := fset.Position(fn.Body.Rbrace).Offset
+		lines := strings.Split(strings.Trim(source[start:end], "\n"), "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimPrefix(line, "\t")
+		}
+		snippets[fn.Name.Name] = strings.Join(lines, "\n")
+	}
+	return snippets, nil
+}
+
+// pageHTML is the template of index.html.
+var pageHTML = template.Must(template.New("index.html").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>t8go gallery</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+section { display: flex; gap: 2em; margin-bottom: 2em; align-items: flex-start; }
+img { width: 512px; image-rendering: pixelated; border: 1px solid #888; }
+pre { background: #f4f4f4; padding: 1em; margin: 0; }
+</style>
+</head>
+<body>
+<h1>t8go gallery</h1>
+{{range .}}<h2>{{.Title}}</h2>
+<section>
+<img src="{{.Image}}" alt="{{.Title}}">
+<pre><code>{{.Snippet}}</code></pre>
+</section>
+{{end}}</body>
+</html>
+`))
+
+// writePages writes index.html and README.md for entries to dir.
+func writePages(dir string, entries []entry) error {
+	file, err := os.Create(filepath.Join(dir, "index.html"))
+	if err != nil {
+		return err
+	}
+	if err := pageHTML.Execute(file, entries); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	var sb strings.Builder
+	sb.WriteString("# t8go gallery\n")
+	for _, e := range entries {
+		fmt.Fprintf(&sb, "\n## %s\n\n![%s](%s)\n\n```go\n%s\n```\n", e.Title, e.Title, e.Image, e.Snippet)
+	}
+	return os.WriteFile(filepath.Join(dir, "README.md"), []byte(sb.String()), 0o644)
+}
+
+// fatal prints err and exits.
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "t8gogallery:", err)
+	os.Exit(1)
+}
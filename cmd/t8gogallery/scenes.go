@@ -0,0 +1,222 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/chart"
+	"github.com/redghc/t8go/drivers/memory"
+	"github.com/redghc/t8go/fixed"
+	"github.com/redghc/t8go/icons/weather"
+	"github.com/redghc/t8go/particles"
+	"github.com/redghc/t8go/record"
+	"github.com/redghc/t8go/tilemap"
+	"github.com/redghc/t8go/widget"
+)
+
+// Every scene draws on a 128x64 display. The body of each scene function is
+// shown as its code snippet in the gallery, so keep them short and readable.
+
+func sceneLines(ctx t8go.IDisplayDrawer) {
+	for x := int16(0); x < 64; x += 8 {
+		ctx.DrawLine(0, 63, x, 0)
+	}
+	for angle := 0; angle < 256; angle += 16 {
+		ctx.DrawLineAngle(96, 32, 28, uint8(angle))
+	}
+	ctx.DrawHLine(64, 62, 64)
+	ctx.DrawVLine(127, 0, 64)
+}
+
+func sceneBoxes(ctx t8go.IDisplayDrawer) {
+	ctx.DrawBox(2, 2, 36, 26)
+	ctx.DrawBoxFill(44, 2, 36, 26)
+	ctx.DrawRoundBox(86, 2, 40, 26, 6)
+	ctx.DrawRoundBoxFill(2, 34, 60, 28, 10)
+	ctx.DrawBoxCoords(124, 61, 68, 34)
+	ctx.ClearRegion(50, 8, 24, 14)
+}
+
+func sceneCircles(ctx t8go.IDisplayDrawer) {
+	ctx.DrawCircle(16, 16, 14, t8go.DrawAll)
+	ctx.DrawCircleFill(48, 16, 14, t8go.DrawAll)
+	ctx.DrawCircleFill(80, 16, 14, t8go.DrawTopLeft|t8go.DrawBottomRight)
+	ctx.DrawCircle(112, 16, 14, t8go.DrawTopRight|t8go.DrawTopLeft)
+	ctx.DrawEllipse(32, 48, 30, 12, t8go.DrawAll)
+	ctx.DrawEllipseFill(96, 48, 28, 14, t8go.DrawAll)
+}
+
+func sceneArcs(ctx t8go.IDisplayDrawer) {
+	ctx.DrawArc(20, 32, 18, 0, 192)
+	ctx.DrawArcFill(64, 32, 20, 32, 224)
+	for radius := int16(6); radius <= 24; radius += 6 {
+		ctx.DrawArc(106, 40, radius, 16, 112)
+	}
+}
+
+func sceneTriangles(ctx t8go.IDisplayDrawer) {
+	ctx.DrawTriangle(4, 60, 30, 4, 58, 60)
+	ctx.DrawTriangleFill(66, 60, 92, 4, 124, 44)
+	ctx.DrawTriangle(40, 40, 50, 30, 60, 48)
+}
+
+func sceneChain(ctx t8go.IDisplayDrawer) {
+	ctx.At(4, 4).Box(40, 24).Move(48, 0).RoundBox(40, 24, 5).Fill()
+	ctx.At(24, 46).Circle(14).Move(40, 0).Ellipse(20, 10).Fill()
+	ctx.At(100, 34).LineTo(124, 60).LineTo(100, 60).LineTo(100, 34)
+}
+
+func sceneWidgets(ctx t8go.IDisplayDrawer) {
+	screen, err := widget.LoadJSON([]byte(`{
+		"type": "panel", "x": 0, "y": 0, "width": 128, "height": 64, "border": true, "radius": 4,
+		"children": [
+			{"type": "progress", "x": 8, "y": 10, "width": 96, "height": 10, "bind": "battery", "max": 100},
+			{"type": "progress", "x": 8, "y": 28, "width": 96, "height": 10, "bind": "volume", "max": 100},
+			{"type": "indicator", "x": 112, "y": 10, "width": 8, "height": 8, "bind": "charging"},
+			{"type": "indicator", "x": 112, "y": 28, "width": 8, "height": 8, "bind": "muted"}
+		]
+	}`), widget.Bindings{
+		Values: map[string]widget.ValueFunc{
+			"battery": func() int16 { return 72 },
+			"volume":  func() int16 { return 30 },
+		},
+		Flags: map[string]widget.FlagFunc{
+			"charging": func() bool { return true },
+			"muted":    func() bool { return false },
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+	screen.Draw(ctx)
+}
+
+func sceneRecording(ctx t8go.IDisplayDrawer) {
+	icon := record.New(4)
+	icon.DrawRoundBox(0, 0, 16, 16, 3)
+	icon.DrawCircleFill(8, 8, 4, t8go.DrawAll)
+	icon.DrawLine(2, 13, 13, 2)
+
+	if err := icon.Replay(ctx); err != nil {
+		panic(err)
+	}
+	if err := icon.ReplayAt(ctx, t8go.Pt(24, 0), fixed.Q8One*3/2); err != nil {
+		panic(err)
+	}
+	if err := icon.ReplayAt(ctx, t8go.Pt(60, 0), fixed.Q8One*5/2); err != nil {
+		panic(err)
+	}
+}
+
+func sceneGeometry(ctx t8go.IDisplayDrawer) {
+	a := t8go.Rect{X: 10, Y: 8, Width: 60, Height: 36}
+	b := t8go.Rect{X: 44, Y: 24, Width: 70, Height: 34}
+	ctx.DrawBox(a.X, a.Y, a.Width, a.Height)
+	ctx.DrawBox(b.X, b.Y, b.Width, b.Height)
+
+	overlap := a.Intersect(b)
+	ctx.DrawBoxFill(overlap.X, overlap.Y, overlap.Width, overlap.Height)
+
+	union := a.Union(b).Inset(-3)
+	ctx.DrawRoundBox(union.X, union.Y, union.Width, union.Height, 4)
+}
+
+func sceneParticles(ctx t8go.IDisplayDrawer) {
+	system := particles.New(64)
+	system.Seed(7)
+	system.GravityY = 60
+	system.Emit(64, 40, 64, particles.Emitter{
+		VY: -50, SpreadX: 40, SpreadY: 20,
+		Life: 2 * time.Second, PositionJitter: 2,
+	})
+	for range 20 {
+		system.Update(40 * time.Millisecond)
+	}
+	system.Draw(ctx)
+	ctx.DrawHLine(0, 63, 128)
+}
+
+func sceneText(ctx t8go.IDisplayDrawer) {
+	ctx.DrawText(4, 12, "Hello, t8go!")
+	ctx.DrawText(4, 26, "0123456789 +-*/=")
+	ctx.DrawText(4, 40, "jumpy glyphs {|}")
+	ctx.DrawRoundBox(0, 46, 128, 18, 4)
+	ctx.DrawText(6, 59, "Font: "+ctx.GetFont().Name)
+}
+
+func sceneTileMap(ctx t8go.IDisplayDrawer) {
+	// Draw three 8x8 tiles (sky, brick, coin) into an off-screen sheet
+	sheetDisplay, _ := memory.New(memory.Config{Width: 24, Height: 8})
+	sheet := t8go.New(sheetDisplay)
+	sheet.DrawBoxFill(8, 0, 8, 3)
+	sheet.DrawBoxFill(8, 4, 8, 4)
+	sheet.ClearRegion(11, 0, 1, 3)
+	sheet.ClearRegion(15, 4, 1, 4)
+	sheet.DrawCircle(20, 4, 3, t8go.DrawAll)
+
+	world, _ := tilemap.New(&tilemap.Sheet{
+		Data: sheetDisplay.Buffer(), Width: 24, Height: 8, TileWidth: 8, TileHeight: 8,
+	}, 64, 8, ctx.Bounds())
+	for column := range uint16(64) {
+		height := 1 + column%5
+		for row := 8 - height; row < 8; row++ {
+			world.SetTile(column, row, 1)
+		}
+		if column%3 == 0 {
+			world.SetTile(column, 7-height-1, 2)
+		}
+	}
+
+	world.CenterOn(200, 32)
+	world.Draw(ctx)
+}
+
+func sceneChartAxes(ctx t8go.IDisplayDrawer) {
+	// Temperature in tenths of a degree, sampled every two hours
+	hours := []int32{0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24}
+	temperatures := []int32{112, 104, 98, 95, 101, 126, 158, 189, 214, 231, 226, 198, 164}
+
+	plot := chart.Viewport{MinX: 0, MaxX: 24, MinY: 50, MaxY: 250,
+		Screen: t8go.Rect{X: 18, Y: 3, Width: 106, Height: 50}}
+
+	time := plot.AxisX()
+	time.MaxTicks = 7
+	degrees := plot.AxisY()
+	degrees.MaxTicks, degrees.Grid = 3, 106
+	degrees.Label, degrees.RotateLabels = chart.Decimal(1), true
+	time.Draw(ctx)
+	degrees.Draw(ctx)
+
+	plot.Plot(ctx, hours, temperatures)
+}
+
+func sceneTextScroller(ctx t8go.IDisplayDrawer) {
+	log := &widget.TextScroller{
+		Rect:  t8go.Rect{X: 2, Y: 2, Width: 118, Height: 60},
+		Store: widget.NewRingStore(32, 512), // Text only: 512 bytes for 32 lines
+	}
+	for boot := range 12 {
+		fmt.Fprintf(log, "%02d:%02d sensor %d ok\n", 8+boot/6, boot%6*10, boot%3)
+	}
+	log.ScrollUp(2)
+	log.Draw(ctx)
+
+	ctx.DrawVLine(124, 2, 60) // Scrollbar
+	ctx.DrawBoxFill(123, 38, 3, 14)
+}
+
+func sceneWeather(ctx t8go.IDisplayDrawer) {
+	forecast := []int{800, 802, 500, 211} // OpenWeatherMap condition ids
+	for i, id := range forecast {
+		icon := weather.FromOpenWeatherMap(id).Icon(false)
+		ctx.DrawBuffer(int16(i*32), 0, icon.Bitmap32())
+	}
+
+	tonight := time.Date(2026, time.October, 26, 22, 0, 0, 0, time.UTC)
+	ctx.DrawBuffer(8, 40, weather.FromWMO(0).Icon(true).Bitmap16())
+	ctx.DrawBuffer(32, 40, weather.MoonPhase(tonight).Bitmap16())
+	for phase := range 4 { // Waxing phases
+		ctx.DrawBuffer(int16(56+phase*18), 40, (weather.IconMoonNew + weather.Icon(phase)).Bitmap16())
+	}
+}
@@ -0,0 +1,203 @@
+// Command t8goimg converts photos and icons to t8go monochrome images, so
+// their brightness, contrast, gamma and dithering can be tuned for a panel
+// while previewing the result in the terminal.
+//
+// Usage:
+//
+//	t8goimg [-width 0] [-height 0] [-dither fs|bayer|threshold|stucki|bluenoise]
+//	        [-threshold 128] [-edge 96] [-equalize] [-brightness 0] [-contrast 0]
+//	        [-gamma 100] [-invert]
+//	        [-o preview.png] [-go image.go [-package images] [-name Image]] image.png
+//
+// PNG, JPEG and GIF images are supported. With only one of -width and
+// -height the aspect ratio is kept. For photos on e-paper, -equalize with
+// -dither stucki or bluenoise avoids the banding of Bayer patterns. The
+// result is printed with half-block characters; -o saves it as a PNG or BMP
+// image and -go writes it as Go source declaring a framebuf.Buffer.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"image"
+	"image/color"
+	_ "image/gif"
+	_ "image/jpeg"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/redghc/t8go/bmp"
+	"github.com/redghc/t8go/framebuf"
+	"github.com/redghc/t8go/imageconv"
+)
+
+// dithers maps the -dither names to dithering methods.
+var dithers = map[string]imageconv.Dither{
+	"fs":        imageconv.DitherFloydSteinberg,
+	"bayer":     imageconv.DitherBayer,
+	"threshold": imageconv.DitherThreshold,
+	"stucki":    imageconv.DitherStucki,
+	"bluenoise": imageconv.DitherBlueNoise,
+}
+
+func main() {
+	width := flag.Int("width", 0, "output width in pixels (0 = source width)")
+	height := flag.Int("height", 0, "output height in pixels (0 = source height)")
+	dither := flag.String("dither", "fs", "dithering: fs (Floyd-Steinberg), bayer, threshold, stucki or bluenoise")
+	threshold := flag.Uint("threshold", 128, "gray level from which pixels turn on (1-255)")
+	edge := flag.Uint("edge", 96, "gray difference across which stucki stops spreading error (1-255)")
+	equalize := flag.Bool("equalize", false, "spread the gray levels evenly (histogram equalization)")
+	brightness := flag.Int("brightness", 0, "brightness offset (-255..255)")
+	contrast := flag.Int("contrast", 0, "contrast change in percent (-100..)")
+	gamma := flag.Uint("gamma", 100, "gamma in hundredths (above 100 lifts mid tones)")
+	invert := flag.Bool("invert", false, "turn dark areas on, for dark-on-light artwork")
+	output := flag.String("o", "", "save the result as a .png or .bmp image")
+	goFile := flag.String("go", "", "write the result as Go source to this file")
+	pkg := flag.String("package", "images", "package name of the Go source")
+	name := flag.String("name", "Image", "variable name of the Go source")
+	flag.Parse()
+
+	method, ok := dithers[*dither]
+	if flag.NArg() != 1 || !ok || *threshold == 0 || *threshold > 255 || *edge == 0 || *edge > 255 ||
+		*brightness < -255 || *brightness > 255 || *contrast < -100 || *contrast > 10000 || *gamma > 10000 {
+		fmt.Fprintln(os.Stderr, "usage: t8goimg [flags] image")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+
+	img, err := loadImage(flag.Arg(0))
+	if err != nil {
+		fatal(err)
+	}
+
+	out, err := imageconv.Convert(img, imageconv.Options{
+		Width:         *width,
+		Height:        *height,
+		Dither:        method,
+		Threshold:     uint8(*threshold),
+		EdgeThreshold: uint8(*edge),
+		Equalize:      *equalize,
+		Brightness:    int16(*brightness),
+		Contrast:      int16(*contrast),
+		Gamma:         uint16(*gamma),
+		Invert:        *invert,
+	})
+	if err != nil {
+		fatal(err)
+	}
+
+	renderTerminal(os.Stdout, out)
+	fmt.Printf("%dx%d, %d bytes\n", out.Width, out.Height, len(out.Data))
+
+	if *output != "" {
+		if err := saveImage(*output, out); err != nil {
+			fatal(err)
+		}
+	}
+	if *goFile != "" {
+		source, err := goSource(out, *pkg, *name, filepath.Base(flag.Arg(0)))
+		if err != nil {
+			fatal(err)
+		}
+		if err := os.WriteFile(*goFile, source, 0o644); err != nil {
+			fatal(err)
+		}
+	}
+}
+
+// loadImage decodes the image file at path.
+func loadImage(path string) (image.Image, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	img, _, err := image.Decode(file)
+	return img, err
+}
+
+// renderTerminal draws img with half-block characters, two pixel rows per
+// text line.
+func renderTerminal(w io.Writer, img framebuf.Buffer) {
+	var sb strings.Builder
+	for y := 0; y < img.Height; y += 2 {
+		for x := range img.Width {
+			top, bottom := img.GetPixel(x, y), img.GetPixel(x, y+1)
+			switch {
+			case top && bottom:
+				sb.WriteString("█")
+			case top:
+				sb.WriteString("▀")
+			case bottom:
+				sb.WriteString("▄")
+			default:
+				sb.WriteByte(' ')
+			}
+		}
+		sb.WriteByte('\n')
+	}
+	io.WriteString(w, sb.String())
+}
+
+// saveImage writes img to path as PNG or BMP, chosen by extension.
+func saveImage(path string, img framebuf.Buffer) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".bmp":
+		err = bmp.Encode(file, img.Width, img.Height, img.GetPixel)
+	case ".png":
+		gray := image.NewGray(image.Rect(0, 0, img.Width, img.Height))
+		for y := range img.Height {
+			for x := range img.Width {
+				if img.GetPixel(x, y) {
+					gray.SetGray(x, y, color.Gray{Y: 0xFF})
+				}
+			}
+		}
+		err = png.Encode(file, gray)
+	default:
+		err = fmt.Errorf("unsupported image format %q (use .png or .bmp)", filepath.Ext(path))
+	}
+
+	if err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// goSource returns Go source declaring img as a framebuf.Buffer variable.
+func goSource(img framebuf.Buffer, pkg, name, source string) ([]byte, error) {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by t8goimg from %s; DO NOT EDIT.\n\n", source)
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	fmt.Fprintf(&b, "import \"github.com/redghc/t8go/framebuf\"\n\n")
+	fmt.Fprintf(&b, "// %s is a %dx%d monochrome image in the t8go page layout.\n", name, img.Width, img.Height)
+	fmt.Fprintf(&b, "var %s = framebuf.Buffer{\n\tWidth:  %d,\n\tHeight: %d,\n\tData: []byte{", name, img.Width, img.Height)
+	for i, value := range img.Data {
+		if i%16 == 0 {
+			b.WriteString("\n\t\t")
+		} else {
+			b.WriteByte(' ')
+		}
+		fmt.Fprintf(&b, "0x%02X,", value)
+	}
+	b.WriteString("\n\t},\n}\n")
+	return format.Source(b.Bytes())
+}
+
+// fatal prints err and exits.
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "t8goimg:", err)
+	os.Exit(1)
+}
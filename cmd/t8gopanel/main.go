@@ -0,0 +1,138 @@
+// Command t8gopanel compiles panel descriptions into Go tables for the
+// ssd1306 driver (Config.Panel), so panels with odd geometries, offsets or
+// wiring are supported without forking the driver.
+//
+// Usage:
+//
+//	t8gopanel [-package panels] [-o panels.go] panel.json...
+//
+// Each file holds one JSON panel description; see panel.Description for the
+// fields. For example, a 0.66" 64x48 module wired to the middle columns of
+// the controller:
+//
+//	{
+//		"name": "OLED64x48",
+//		"comment": "0.66 inch 64x48 module",
+//		"width": 64,
+//		"height": 48,
+//		"column_offset": 32
+//	}
+//
+// The generated source declares one panel.Table per description, with every
+// command of its initialization sequence commented, and is printed unless
+// -o names an output file.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/redghc/t8go/panel"
+)
+
+func main() {
+	pkg := flag.String("package", "panels", "package name of the generated source")
+	output := flag.String("o", "", "write the generated source to this file instead of stdout")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "usage: t8gopanel [flags] panel.json...")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+
+	descriptions := make([]panel.Description, 0, flag.NArg())
+	for _, path := range flag.Args() {
+		description, err := loadDescription(path)
+		if err != nil {
+			fatal(err)
+		}
+		descriptions = append(descriptions, description)
+	}
+
+	source, err := goSource(descriptions, *pkg, flag.Args())
+	if err != nil {
+		fatal(err)
+	}
+	if *output == "" {
+		os.Stdout.Write(source)
+		return
+	}
+	if err := os.WriteFile(*output, source, 0o644); err != nil {
+		fatal(err)
+	}
+}
+
+// loadDescription reads and checks the panel description in the file at path.
+func loadDescription(path string) (panel.Description, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return panel.Description{}, err
+	}
+
+	var description panel.Description
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&description); err != nil {
+		return panel.Description{}, fmt.Errorf("%s: %w", path, err)
+	}
+
+	if !token.IsIdentifier(description.Name) || !token.IsExported(description.Name) {
+		return panel.Description{}, fmt.Errorf("%s: name %q is not an exported Go identifier", path, description.Name)
+	}
+	if err := description.Validate(); err != nil {
+		return panel.Description{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return description, nil
+}
+
+// goSource returns Go source declaring a panel.Table for every description.
+func goSource(descriptions []panel.Description, pkg string, sources []string) ([]byte, error) {
+	names := make([]string, len(sources))
+	for i, source := range sources {
+		names[i] = filepath.Base(source)
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by t8gopanel from %s; DO NOT EDIT.\n\n", strings.Join(names, ", "))
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	fmt.Fprintf(&b, "import \"github.com/redghc/t8go/panel\"\n")
+
+	for _, description := range descriptions {
+		table, err := description.Table()
+		if err != nil {
+			return nil, err
+		}
+
+		comment := description.Comment
+		if comment == "" {
+			comment = fmt.Sprintf("%dx%d panel", table.Width, table.Height)
+		}
+		fmt.Fprintf(&b, "\n// %s describes a %s for ssd1306.Config.Panel.\n", description.Name, comment)
+		fmt.Fprintf(&b, "var %s = panel.Table{\n", description.Name)
+		fmt.Fprintf(&b, "\tWidth: %d,\n\tHeight: %d,\n\tColumnOffset: %d,\n", table.Width, table.Height, table.ColumnOffset)
+		fmt.Fprintf(&b, "\tChargePump: %t,\n\tContrast: 0x%02X,\n\tInit: []byte{\n", table.ChargePump, table.Contrast)
+		for _, command := range description.Commands() {
+			b.WriteString("\t\t")
+			for _, value := range command.Bytes {
+				fmt.Fprintf(&b, "0x%02X, ", value)
+			}
+			fmt.Fprintf(&b, "// %s\n", command.Comment)
+		}
+		b.WriteString("\t},\n}\n")
+	}
+	return format.Source(b.Bytes())
+}
+
+// fatal prints err and exits.
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "t8gopanel:", err)
+	os.Exit(1)
+}
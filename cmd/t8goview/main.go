@@ -0,0 +1,230 @@
+// Command t8goview shows the frames streamed by a remote display
+// (drivers/remote) or sent by a UART display (drivers/uart) in the terminal,
+// and optionally saves the latest frame as a BMP file.
+//
+// Usage:
+//
+//	t8goview -tcp :7700        # accept one device connection over TCP
+//	t8goview -udp :7700        # receive frames as UDP datagrams
+//	t8goview -serial /dev/ttyUSB0
+//	t8goview -uart /dev/ttyUSB0 [-size 128x64]   # drivers/uart packets
+//	t8goview ... [-bmp frame.bmp] [-once]
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/bmp"
+	"github.com/redghc/t8go/drivers/memory"
+	"github.com/redghc/t8go/drivers/remote"
+	"github.com/redghc/t8go/drivers/uart"
+)
+
+func main() {
+	tcpAddr := flag.String("tcp", "", "listen for a TCP connection on this address")
+	udpAddr := flag.String("udp", "", "receive UDP datagrams on this address")
+	serialPath := flag.String("serial", "", "read frames from this serial device or file")
+	uartPath := flag.String("uart", "", "read drivers/uart packets from this serial device or file")
+	size := flag.String("size", "128x64", "display size of the -uart sender")
+	bmpPath := flag.String("bmp", "", "write the latest frame to this BMP file")
+	once := flag.Bool("once", false, "exit after the first frame")
+	flag.Parse()
+
+	show := func(frame remote.Frame) error {
+		render(os.Stdout, frame)
+		if *bmpPath != "" {
+			if err := saveBMP(*bmpPath, frame); err != nil {
+				return err
+			}
+		}
+		if *once {
+			os.Exit(0)
+		}
+		return nil
+	}
+
+	fmt.Print("\x1b[2J") // Clear the terminal once; frames redraw in place
+
+	var err error
+	switch {
+	case *tcpAddr != "":
+		err = serveTCP(*tcpAddr, show)
+	case *udpAddr != "":
+		err = serveUDP(*udpAddr, show)
+	case *serialPath != "":
+		err = serveFile(*serialPath, show)
+	case *uartPath != "":
+		var width, height uint16
+		if _, scanErr := fmt.Sscanf(*size, "%dx%d", &width, &height); scanErr != nil {
+			flag.Usage()
+			os.Exit(2)
+		}
+		err = serveUART(*uartPath, width, height, show)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err != nil && !errors.Is(err, io.EOF) {
+		fmt.Fprintln(os.Stderr, "t8goview:", err)
+		os.Exit(1)
+	}
+}
+
+// serveTCP accepts device connections one at a time and shows their frames.
+func serveTCP(addr string, show func(remote.Frame) error) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	defer listener.Close()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+		err = readStream(conn, show)
+		conn.Close()
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return err
+		}
+	}
+}
+
+// serveUDP shows every frame received as a datagram. Lost delta frames are
+// skipped until the next keyframe.
+func serveUDP(addr string, show func(remote.Frame) error) error {
+	conn, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	decoder := remote.NewDecoder()
+	packet := make([]byte, 65536)
+	for {
+		n, _, err := conn.ReadFrom(packet)
+		if err != nil {
+			return err
+		}
+		frame, err := decoder.Decode(packet[:n])
+		if errors.Is(err, remote.ErrFrameLost) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := show(frame); err != nil {
+			return err
+		}
+	}
+}
+
+// serveFile shows the frames read from a serial device or a captured file.
+func serveFile(path string, show func(remote.Frame) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return readStream(file, show)
+}
+
+// serveUART shows the frames of a UART display, received by a uart.Receiver
+// drawing on a memory display of the sender's size.
+func serveUART(path string, width, height uint16, show func(remote.Frame) error) error {
+	display, err := memory.New(memory.Config{Width: width, Height: height})
+	if err != nil {
+		return err
+	}
+	receiver := uart.NewReceiver(t8go.New(display), 0)
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	chunk := make([]byte, 512)
+	for {
+		n, err := file.Read(chunk)
+		shown := receiver.Shown()
+		if _, writeErr := receiver.Write(chunk[:n]); writeErr != nil {
+			return writeErr
+		}
+		if receiver.Shown() != shown {
+			frame := remote.Frame{Width: width, Height: height, Sequence: uint16(receiver.Shown()), Data: display.Buffer()}
+			if err := show(frame); err != nil {
+				return err
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// readStream shows the frames of a stream transport until it fails.
+func readStream(r io.Reader, show func(remote.Frame) error) error {
+	decoder := remote.NewDecoder()
+	for {
+		frame, err := decoder.Read(r)
+		if err != nil {
+			return err
+		}
+		if err := show(frame); err != nil {
+			return err
+		}
+	}
+}
+
+// render draws the frame in the terminal with half-block characters, two
+// pixel rows per text line, redrawing in place.
+func render(w io.Writer, frame remote.Frame) {
+	pixel := bmp.PageBuffer(frame.Data, int(frame.Width))
+	width, height := int(frame.Width), int(frame.Height)
+
+	var sb strings.Builder
+	sb.WriteString("\x1b[H")
+	for y := 0; y < height; y += 2 {
+		for x := 0; x < width; x++ {
+			top := pixel(x, y)
+			bottom := y+1 < height && pixel(x, y+1)
+			switch {
+			case top && bottom:
+				sb.WriteString("█")
+			case top:
+				sb.WriteString("▀")
+			case bottom:
+				sb.WriteString("▄")
+			default:
+				sb.WriteByte(' ')
+			}
+		}
+		sb.WriteByte('\n')
+	}
+	fmt.Fprintf(&sb, "frame %d  %dx%d\n", frame.Sequence, width, height)
+	io.WriteString(w, sb.String())
+}
+
+// saveBMP writes the frame to path as a 1-bit BMP file.
+func saveBMP(path string, frame remote.Frame) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := bmp.Encode(file, int(frame.Width), int(frame.Height), bmp.PageBuffer(frame.Data, int(frame.Width))); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -0,0 +1,39 @@
+package t8go
+
+// RGB returns the color with the given red, green and blue components.
+func RGB(red, green, blue uint8) Color {
+	return Color(red)<<16 | Color(green)<<8 | Color(blue)
+}
+
+// RGB returns the red, green and blue components of the color.
+func (c Color) RGB() (red, green, blue uint8) {
+	return uint8(c >> 16), uint8(c >> 8), uint8(c)
+}
+
+// Luma returns the perceived brightness of the color in 0..255 (ITU-R BT.601 weights).
+func (c Color) Luma() uint8 {
+	red, green, blue := c.RGB()
+	return uint8((299*uint32(red) + 587*uint32(green) + 114*uint32(blue)) / 1000)
+}
+
+// IsOn reports whether the color lights a monochrome pixel,
+// that is, whether its brightness is at least half of the maximum.
+func (c Color) IsOn() bool {
+	return c.Luma() >= 128
+}
+
+// SetPixelColor draws the pixel at (x, y) in color, like DrawPixel with
+// color as the draw color: through the transform, the draw mode, the clip
+// areas and the pixel filter. Color drivers (IColorDisplay) receive the
+// color unchanged; monochrome drivers turn the pixel on or off per
+// Color.IsOn.
+func (t *T8Go) SetPixelColor(x, y int16, color Color) {
+	if t.transformed {
+		x, y = t.transform.Apply(x, y)
+	}
+
+	drawColor := t.state.color
+	t.state.color = color
+	t.plot(int32(x), int32(y))
+	t.state.color = drawColor
+}
@@ -1,6 +1,13 @@
 package t8go
 
-import "github.com/redghc/t8go/helpers"
+import (
+	"io"
+	"sync"
+	"time"
+
+	"github.com/redghc/t8go/fixed"
+	"github.com/redghc/t8go/framebuf"
+)
 
 // IDisplay represents a generic display interface that all display drivers must implement.
 // It provides low-level operations for drawing pixels and managing the display buffer.
@@ -14,7 +21,116 @@ type IDisplay interface {
 	Command(cmd byte) error       // Command sends a command byte to the display
 	Display() error               // Display sends the current buffer to the physical display
 	SetPixel(x, y int16, on bool) // SetPixel sets a pixel at (x, y) to on/off
-	GetPixel(x, y uint8) bool     // GetPixel returns the state of a pixel at (x, y)
+	GetPixel(x, y int16) bool     // GetPixel returns the state of a pixel at (x, y)
+}
+
+// IAsyncDisplay is an optional interface for drivers that can flush the buffer
+// in the background. The driver must snapshot the buffer before returning, so
+// the caller can start drawing the next frame immediately.
+type IAsyncDisplay interface {
+	DisplayAsync(done func(error)) // DisplayAsync starts a flush and calls done (if non-nil) when it completes
+}
+
+// ISpanDrawer is an optional interface for drivers that can draw runs of pixels
+// faster than individual SetPixel calls. T8Go detects it and uses it for lines
+// and all filled primitives, falling back to SetPixel when it is not implemented.
+type ISpanDrawer interface {
+	DrawHSpan(x, y, length int16, on bool)       // DrawHSpan sets a horizontal run of length pixels starting at (x, y)
+	FillRect(x, y, width, height int16, on bool) // FillRect sets a rectangle with its top-left corner at (x, y)
+}
+
+// IBufferInfo is an optional interface for drivers that let T8Go write into
+// their buffer directly. When the reported layout is supported, T8Go rasterizes
+// into the buffer itself instead of calling SetPixel for every pixel.
+type IBufferInfo interface {
+	BufferInfo() BufferInfo // BufferInfo describes the raw display buffer
+}
+
+// BufferLayout identifies how pixels are packed into a display buffer.
+type BufferLayout = framebuf.Layout
+
+const (
+	LayoutPageMajor   = framebuf.PageMajor   // 8 vertical pixels per byte, LSB on top, pages stacked top to bottom (SSD1306)
+	LayoutRowMajor    = framebuf.RowMajor    // 8 horizontal pixels per byte, MSB on the left, rows stacked top to bottom (ST7920, Sharp memory LCD)
+	LayoutColumnMajor = framebuf.ColumnMajor // 8 vertical pixels per byte, LSB on top, columns stacked left to right
+)
+
+// BufferInfo describes the raw buffer of a display. The Data slice must stay
+// valid for the lifetime of the driver, since T8Go keeps a reference to it.
+type BufferInfo struct {
+	Data   []byte       // Raw buffer bytes
+	Width  uint16       // Width in pixels
+	Height uint16       // Height in pixels
+	Stride int          // Bytes from the start of one page (or row, or column) to the next
+	Layout BufferLayout // Pixel packing of Data
+}
+
+// IColorDisplay is an optional interface for drivers that can show more than
+// two colors. T8Go draws the pixels of SetPixelColor and of the primitives in
+// the draw color (see T8Go.SetDrawColor) through it when available, and
+// falls back to on and off per Color.IsOn on monochrome drivers.
+type IColorDisplay interface {
+	SetPixelColor(x, y int16, color Color) // SetPixelColor sets the pixel at (x, y) to color
+}
+
+// ICapabilities is an optional interface for drivers that describe their
+// features, so T8Go and higher layers can pick the best strategy per driver.
+// Drivers that do not implement it are treated as plain monochrome displays
+// (see T8Go.Capabilities).
+type ICapabilities interface {
+	Capabilities() Capabilities // Capabilities describes the features of the driver
+}
+
+// Capabilities describes the features of a display driver.
+type Capabilities struct {
+	PartialUpdate  bool  // Driver can flush a sub-region of the buffer instead of the whole frame
+	GrayscaleBits  uint8 // Bits per pixel of the panel (1 for monochrome)
+	HardwareScroll bool  // Controller can scroll the panel contents without redrawing
+	MaxTransfer    int   // Largest single bus transfer in bytes (0 = unlimited)
+	BusyWait       bool  // Refreshes block on a busy signal (typically e-paper); batch updates
+
+	PixelPitchX uint16 // Horizontal distance between pixel centers in micrometers (0 = unknown)
+	PixelPitchY uint16 // Vertical distance between pixel centers in micrometers (0 = unknown)
+}
+
+// IRegionFlusher is an optional interface for drivers that can send part of
+// the buffer to the panel. T8Go.FlushRegion uses it and falls back to a full
+// Display when it is not implemented. Drivers may round the region outwards,
+// for example to whole pages.
+type IRegionFlusher interface {
+	DisplayRegion(x0, y0, x1, y1 int) error // DisplayRegion sends the inclusive box (x0, y0)-(x1, y1) to the panel
+}
+
+// IBatcher is an optional interface for drivers that can coalesce commands
+// and buffer updates into fewer bus transactions. Between Begin and End the
+// driver queues what it would send; End sends it. T8Go.Begin, End and
+// FlushRegions use it and send everything immediately without it.
+type IBatcher interface {
+	Begin()     // Begin starts queuing commands and updates; calls may nest
+	End() error // End sends the queued commands and updates when the outermost batch ends
+}
+
+// IReinitializer is an optional interface for drivers that can repeat their
+// initialization sequence, for example after the panel was power-cycled.
+// T8Go.Reinit uses it. The buffer must be kept.
+type IReinitializer interface {
+	Reinit() error // Reinit sends the initialization sequence to the panel again
+}
+
+// IPowerManager is an optional interface for drivers that can put the panel to
+// sleep and change its brightness. T8Go.Sleep, Wake and SetBrightness delegate
+// to it and return ErrUnsupported when it is not implemented.
+type IPowerManager interface {
+	Sleep() error                    // Sleep turns the panel off; the buffer is kept
+	Wake() error                     // Wake turns the panel back on, showing the last flushed frame
+	SetBrightness(level uint8) error // SetBrightness sets the panel brightness (0 = dimmest, 255 = brightest)
+}
+
+// IRefreshModeDisplay is an optional interface for displays (typically e-paper)
+// that distinguish between slow full refreshes and fast partial refreshes.
+type IRefreshModeDisplay interface {
+	DisplayFull() error    // DisplayFull performs a full refresh that clears ghosting
+	DisplayPartial() error // DisplayPartial performs a fast partial refresh
 }
 
 // ----------
@@ -38,23 +154,71 @@ type IDisplay interface {
 //   - Arcs: circular arcs with start/end angles (outlined and filled)
 //
 // Coordinate System:
-//   - Uses int16 for most coordinates to support negative values and larger displays
-//   - Pixel queries use uint8 for optimization on smaller displays
+//   - Uses int16 for all coordinates to support negative values and larger displays
 //   - Display dimensions returned as uint16
 //
 // The interface is designed to work with various display technologies and provides
 // a unified API for both simple and complex drawing operations.
 type IDisplayDrawer interface {
 	GetDisplay() IDisplay
+	SetDisplay(display IDisplay)
+	Reinit() error
 	Size() (width, height uint16)
 	BufferSize() int
 	Buffer() []byte
 	ClearBuffer()
 	ClearDisplay()
+	ClearRegion(x, y, width, height int16)
+	Bounds() Rect
+	Capabilities() Capabilities
+	Units() Units
+	Sleep() error
+	Wake() error
+	SetBrightness(level uint8) error
+	SetBrightnessSource(source func() uint8) error
+	UpdateBrightness() error
 	Command(cmd byte) error
 	Display() error
+	DisplayAsync(done func(error))
+	FlushRegion(region Rect) error
+	FlushRegions(regions ...Rect) error
+	Begin()
+	End() error
+	Err() error
+	PushState()
+	PopState()
+	PushTransform(m Transform)
+	PopTransform()
+	GetTransform() Transform
+	SetFont(font *Font)
+	GetFont() *Font
+	SetTextScale(scale uint8)
+	GetTextScale() uint8
+	SetTextRotation(rotation TextRotation)
+	GetTextRotation() TextRotation
+	SetTextInvert(invert bool)
+	GetTextInvert() bool
+	SetDrawMode(mode DrawMode)
+	GetDrawMode() DrawMode
+	SetDrawColor(color Color)
+	GetDrawColor() Color
+	SetBitmapMode(mode BitmapMode)
+	GetBitmapMode() BitmapMode
+	At(x, y int16) Chain
+	SetTracer(tracer Tracer)
+	SetPixelFilter(filter PixelFilter)
+	SetGlyphCache(entries uint8)
+	SetClipRect(x, y, width, height int16)
+	SetClipCircle(centerX, centerY, radius int16)
+	ClearClip()
+	EnableStats(on bool)
+	Stats() Stats
+	DumpASCII(w io.Writer) error
+	DumpBraille(w io.Writer) error
+	ExportRegion(region Rect, w io.Writer, format ExportFormat) error
 	SetPixel(x, y int16, on bool)
-	GetPixel(x, y uint8) bool
+	SetPixelColor(x, y int16, color Color)
+	GetPixel(x, y int16) bool
 
 	DrawPixel(x, y int16)
 
@@ -62,9 +226,11 @@ type IDisplayDrawer interface {
 	DrawVLine(originX, originY, length int16)
 	DrawHLine(originX, originY, length int16)
 	DrawLineAngle(originX, originY, length int16, angle uint8)
+	DrawLineThick(startX, startY, endX, endY, width int16)
 
 	DrawBox(originX, originY, width, height int16)
 	DrawBoxCoords(startX, startY, endX, endY int16)
+	DrawBoxThick(originX, originY, width, height, thickness int16)
 	DrawRoundBox(originX, originY, width, height, cornerRadius int16)
 	DrawBoxFill(originX, originY, width, height int16)
 	DrawBoxFillCoords(startX, startY, endX, endY int16)
@@ -74,27 +240,166 @@ type IDisplayDrawer interface {
 	DrawTriangleFill(x1, y1, x2, y2, x3, y3 int16)
 
 	DrawCircle(centerX, centerY, radius int16, mask DrawQuadrants)
+	DrawCircleThick(centerX, centerY, radius, thickness int16, mask DrawQuadrants)
 	DrawCircleFill(centerX, centerY, radius int16, mask DrawQuadrants)
 
 	DrawEllipse(centerX, centerY, radiusX, radiusY int16, mask DrawQuadrants)
 	DrawEllipseFill(centerX, centerY, radiusX, radiusY int16, mask DrawQuadrants)
 
 	DrawArc(centerX, centerY, radius int16, angleStart, angleEnd uint8)
+	DrawArcThick(centerX, centerY, radius, thickness int16, angleStart, angleEnd uint8)
 	DrawArcFill(centerX, centerY, radius int16, angleStart, angleEnd uint8)
+
+	DrawText(x, y int16, text string)
+	DrawTextClipped(x, y int16, text string, clip Rect)
+	DrawInt(x, y int16, value int32)
+	DrawFixed(x, y int16, value fixed.Q16, decimals uint8)
+	DrawSpans(x, y int16, spans ...TextSpan)
+	MeasureSpans(spans ...TextSpan) (width, ascent, descent int16)
+	MeasureText(text string) (width, height int16)
+	DrawTextBox(x, y, width, height int16, text string, align TextAlign)
+	GlyphAdvance(r rune) int16
+
+	DrawBitmap(x, y, width, height int16, data []byte)
+	DrawBuffer(x, y int16, src framebuf.Buffer)
+	DrawNinePatch(x, y, width, height int16, patch NinePatch)
 }
 
 // T8Go is the main graphics context that provides high-level drawing operations.
 // It wraps a Display interface and provides methods for drawing various shapes
 // such as lines, rectangles, circles, and other geometric primitives.
 type T8Go struct {
-	display IDisplay // The underlying display interface
-	buffer  []byte   // Internal buffer for graphics operations
+	display IDisplay        // The underlying display interface
+	colors  IColorDisplay   // Optional color output of the display (nil if monochrome)
+	spans   ISpanDrawer     // Optional span fast path of the display (nil if unsupported)
+	direct  framebuf.Buffer // Direct view of the display buffer (nil Data if unsupported)
+	buffer  []byte          // Internal buffer for graphics operations
+	rows    scanlines       // Reusable per-row spans for filled shapes (one per display row)
+	holes   scanlines       // Per-row holes of turned thick boxes (allocated on first use)
+	digits  [12]byte        // Text buffer of DrawInt and DrawFixed
+	err     error           // First display error since the last Display call
+	tracer  Tracer          // Receives every drawing call (nil if unset)
+	filter  PixelFilter     // Rewrites every drawn pixel (nil if unset)
+	glyphs  glyphCache      // Rendered scaled and rotated glyphs (empty if disabled)
+	stats   *frameStats     // Drawing statistics (nil while disabled)
+	state   drawState       // Current graphics state
+	states  []drawState     // States saved by PushState
+
+	transform   Transform   // Current transform (see PushTransform)
+	transforms  []Transform // Transforms saved by PushTransform
+	transformed bool        // Drawing goes through transform (false for the identity)
+
+	brightness brightnessControl // Automatic brightness from SetBrightnessSource
+}
+
+// Units converts physical lengths to pixels for a display with the given
+// pixel pitch (see T8Go.Units).
+type Units struct {
+	PitchX uint16 // Horizontal pixel pitch in micrometers
+	PitchY uint16 // Vertical pixel pitch in micrometers
+}
+
+// brightnessControl smooths the readings of a brightness source into
+// gradual brightness changes (see T8Go.SetBrightnessSource).
+type brightnessControl struct {
+	source  func() uint8 // Brightness reading, 0..255 (nil if unset)
+	average int32        // Running average of the readings in Q8.8
+	sampled time.Time    // Time of the last reading
+	primed  bool         // The average holds at least one reading
+	level   uint8        // Brightness last sent to the driver
+	known   bool         // Level holds the current panel brightness
+}
+
+// Stats holds the drawing and flush counters of one frame, for measuring
+// redraw strategies (see T8Go.EnableStats).
+type Stats struct {
+	Frames       uint32 // Frames completed since statistics were enabled
+	Writes       uint32 // Pixel writes during the frame, repeated ones included
+	Pixels       uint32 // Distinct pixels written during the frame
+	Overdraw     uint32 // Writes to pixels already written in the frame (Writes - Pixels)
+	Flushed      uint32 // Bytes of the buffer sent to the display to end the frame
+	TotalFlushed uint64 // Bytes sent since statistics were enabled
+}
+
+// frameStats tracks the writes of the frame being drawn.
+type frameStats struct {
+	written framebuf.Buffer // Pixels written during the current frame
+	current Stats           // Counters of the current frame
+	last    Stats           // Counters of the last completed frame
+}
+
+// drawState is the graphics state that PushState saves and PopState restores.
+type drawState struct {
+	font         *Font        // Font used by DrawText (nil selects the default font)
+	textScale    uint8        // Pixel scale of text (0 draws unscaled)
+	textRotation TextRotation // Direction of text
+	textInvert   bool         // Draw text cleared on a filled background
+	mode         DrawMode     // How primitives change the pixels they cover
+	color        Color        // Color of the drawing primitives (see SetDrawColor)
+	bitmapMode   BitmapMode   // How DrawBitmap draws clear bits
+	rect         clipRect     // Rectangular clip area (unset draws everywhere)
+	circle       clipCircle   // Circular clip area (unset draws everywhere)
 }
 
 var _ IDisplayDrawer = (*T8Go)(nil) // Ensure T8Go implements DisplayDrawer
 
+// synced wraps a T8Go context and serializes every call with a mutex.
+// It is created by NewSynced.
+type synced struct {
+	mu  sync.Mutex // Guards ctx
+	ctx *T8Go      // Wrapped graphics context
+}
+
+var _ IDisplayDrawer = (*synced)(nil) // Ensure synced implements DisplayDrawer
+
+// ----------
+
+// Point is a pixel position on the display.
+type Point struct {
+	X int16 // Horizontal position in pixels (grows to the right)
+	Y int16 // Vertical position in pixels (grows downward)
+}
+
+// Transform is a 2D affine transform in Q16.16 fixed point that maps the
+// point (x, y) to (A*x + B*y + X, C*x + D*y + Y), for drawing through
+// T8Go.PushTransform. Build one from IdentityTransform; the zero value maps
+// everything to the origin.
+type Transform struct {
+	A, B fixed.Q16 // First row of the linear part (rotation, scale and shear)
+	C, D fixed.Q16 // Second row of the linear part
+	X, Y fixed.Q16 // Translation in pixels
+}
+
+// Rect is a rectangular display area with its top-left corner at (X, Y).
+// A rect with a non-positive Width or Height is empty.
+type Rect struct {
+	X      int16 // Left edge in pixels
+	Y      int16 // Top edge in pixels
+	Width  int16 // Width in pixels
+	Height int16 // Height in pixels
+}
+
 // ----------
 
+// DrawMode selects how drawing primitives change the pixels they cover (see
+// T8Go.SetDrawMode).
+type DrawMode uint8
+
+const (
+	ModeSet   DrawMode = iota // Set pixels to the draw color (on by default)
+	ModeClear                 // Turn pixels off, erasing what the call covers
+	ModeXOR                   // Flip pixels, so drawing twice restores them
+)
+
+// BitmapMode selects how DrawBitmap draws the clear bits of a bitmap (see
+// T8Go.SetBitmapMode).
+type BitmapMode uint8
+
+const (
+	BitmapTransparent BitmapMode = iota // Leave the pixels under clear bits unchanged
+	BitmapOpaque                        // Draw clear bits in the opposite of the draw color
+)
+
 // DrawQuadrants represents which quadrants of a circle or ellipse should be drawn.
 // It uses bitwise flags to specify combinations of quadrants.
 type DrawQuadrants uint8
@@ -144,51 +449,178 @@ func (s *scanSpan) IsEmpty() bool {
 	return !s.initialized
 }
 
-// arcAccum tracks perimeter points closest to start/end angles for arc rendering.
-// This is used internally to find the optimal endpoints when drawing arcs.
-type arcAccum struct {
-	bestStartAngleDiff uint8 // Smallest angle difference found for start angle
-	bestEndAngleDiff   uint8 // Smallest angle difference found for end angle
-	startEndX          int16 // X coordinate of best start angle match
-	startEndY          int16 // Y coordinate of best start angle match
-	endEndX            int16 // X coordinate of best end angle match
-	endEndY            int16 // Y coordinate of best end angle match
-}
-
-// arcProcessPerimeter samples 8-way symmetric perimeter points, filters by angle range,
-// widens spans, and updates endpoints closest to angleStart/angleEnd.
-func (accum *arcAccum) arcProcessPerimeter(
-	spans map[int16]scanSpan,
-	centerX, centerY, offsetX, offsetY int16,
-	angleStart, angleEnd uint8,
-) {
-	baseOctantAngle := helpers.ApproxAtanUnit64(offsetX, offsetY) // 0..64
-
-	candidates := [8]struct {
-		ang uint8
-		x   int16
-		y   int16
-	}{
-		{baseOctantAngle, centerX + offsetY, centerY - offsetX},                      // a0
-		{64 - baseOctantAngle, centerX + offsetX, centerY - offsetY},                 // a1
-		{64 + baseOctantAngle, centerX - offsetX, centerY - offsetY},                 // a2
-		{128 - baseOctantAngle, centerX - offsetY, centerY - offsetX},                // a3
-		{128 + baseOctantAngle, centerX - offsetY, centerY + offsetX},                // a4
-		{192 - baseOctantAngle, centerX - offsetX, centerY + offsetY},                // a5
-		{192 + baseOctantAngle, centerX + offsetX, centerY + offsetY},                // a6
-		{uint8(256 - uint16(baseOctantAngle)), centerX + offsetY, centerY + offsetX}, // a7
-	}
+// scanlines holds one scanSpan per display row, indexed by Y.
+// It is allocated once per context and reused by filled shapes so they do not
+// allocate per call. Points on rows outside the display are ignored.
+type scanlines []scanSpan
 
-	for _, c := range candidates {
-		if helpers.InAngleRange(c.ang, angleStart, angleEnd) {
-			updateSpan(spans, c.x, c.y)
-
-			if d := helpers.ArcAngleDistance(c.ang, angleStart); d < accum.bestStartAngleDiff {
-				accum.bestStartAngleDiff, accum.startEndX, accum.startEndY = d, c.x, c.y
-			}
-			if d := helpers.ArcAngleDistance(c.ang, angleEnd); d < accum.bestEndAngleDiff {
-				accum.bestEndAngleDiff, accum.endEndX, accum.endEndY = d, c.x, c.y
-			}
-		}
+// reset clears the spans of rows minY..maxY (clamped to the display) and
+// returns the clamped range.
+func (s scanlines) reset(minY, maxY int16) (int16, int16) {
+	minY = max(minY, 0)
+	maxY = min(maxY, int16(len(s)-1))
+	for y := minY; y <= maxY; y++ {
+		s[y] = scanSpan{}
 	}
+	return minY, maxY
+}
+
+// lineSegment describes the pixels DrawLine draws between two points, in
+// the form its Bresenham loop walks them: steep lines swap X and Y, and the
+// walk goes left to right.
+type lineSegment struct {
+	startX, startY int32 // First pixel of the walk
+	deltaX, deltaY int32 // Length of the walk and its rise (deltaY >= 0)
+	stepY          int32 // Direction of the rise (-1, 0 or 1)
+	steep          bool  // X and Y are swapped
+}
+
+// outlinePath draws a closed outline corner by corner. Each edge leaves out
+// the pixels of the edge before it and of the first edge, so corners are
+// drawn once. Repeated corners are skipped.
+type outlinePath struct {
+	t              *T8Go
+	firstX, firstY int32       // First corner
+	lastX, lastY   int32       // Latest corner
+	corners        int         // Distinct corners added so far
+	first          lineSegment // Edge from the first corner
+	previous       lineSegment // Edge to the latest corner
+}
+
+// arcSector describes a filled arc by the unit vectors of its radial edges
+// (scaled by 1<<helpers.TrigShift, Y pointing up) and its angular sweep.
+type arcSector struct {
+	startX, startY int32 // Direction of the start edge
+	endX, endY     int32 // Direction of the end edge
+	sweep          uint8 // Angle from start to end, counterclockwise (0..255 units)
+}
+
+// ----------
+
+// NinePatch is a border or frame bitmap that DrawNinePatch stretches to any
+// size: the corners are copied as they are, the edges are repeated along
+// their length and the center is repeated in both directions, so one small
+// asset skins buttons and panels of every size.
+type NinePatch struct {
+	Bitmap framebuf.Buffer // Source asset
+	Left   int16           // Width of the fixed left column
+	Top    int16           // Height of the fixed top row
+	Right  int16           // Width of the fixed right column
+	Bottom int16           // Height of the fixed bottom row
+}
+
+// ----------
+
+// ExportFormat selects the encoding written by ExportRegion.
+type ExportFormat uint8
+
+const (
+	ExportASCII   ExportFormat = iota // '#' and '.' text, as DumpASCII
+	ExportBraille                     // Unicode braille cells, as DumpBraille
+	ExportBMP                         // 1-bit BMP image
+)
+
+// ----------
+
+// Color is a display-independent color stored as 0x00RRGGBB.
+// Monochrome drivers show a color as on or off (see IsOn); color drivers can
+// map it to their native format.
+type Color uint32
+
+// Predefined colors matching the two states of a monochrome display.
+const (
+	ColorOff Color = 0x000000 // Pixel off (black)
+	ColorOn  Color = 0xFFFFFF // Pixel on (white)
+)
+
+// ----------
+
+// Chain is a chainable facade over IDisplayDrawer, created with At.
+// Every method draws immediately and returns the updated chain, so screens can
+// be composed as ctx.At(10, 10).Box(50, 20).Fill(). Chains are small values
+// and never allocate.
+type Chain struct {
+	ctx    IDisplayDrawer // Context that receives the drawing calls
+	x, y   int16          // Cursor position
+	shape  chainShape     // Last outlined shape, filled by Fill
+	width  int16          // Width of the last box, or horizontal radius of the last ellipse/circle
+	height int16          // Height of the last box, or vertical radius of the last ellipse
+	radius int16          // Corner radius of the last round box
+}
+
+// chainShape identifies the last shape drawn by a Chain.
+type chainShape uint8
+
+const (
+	chainNone chainShape = iota
+	chainBox
+	chainRoundBox
+	chainCircle
+	chainEllipse
+)
+
+// ----------
+
+// Font is a bitmap font for DrawText. Every glyph bitmap starts at a byte
+// boundary in Bitmap and is packed row by row, most significant bit first,
+// without padding between rows (the Adafruit GFX layout), so fonts converted
+// from other formats keep their exact metrics.
+type Font struct {
+	Name       string     // Font name, for tools and debugging
+	Bitmap     []byte     // Packed glyph bitmaps
+	Glyphs     []Glyph    // Glyph metrics, sorted by Rune
+	Ascent     int16      // Pixels above the baseline used by the tallest glyphs
+	Descent    int16      // Pixels below the baseline used by descenders
+	LineHeight int16      // Distance between the baselines of consecutive lines
+	Fallback   rune       // Glyph drawn for runes missing from the font (0 = skip them)
+	Kerning    []KernPair // Spacing adjustments of rune pairs, sorted by Left then Right (optional)
+}
+
+// KernPair adjusts the space between two runes of a Font drawn one after the
+// other, so pairs such as "AV" or "To" sit closer in proportional fonts.
+type KernPair struct {
+	Left   rune // Rune drawn first
+	Right  rune // Rune drawn next
+	Adjust int8 // Pixels added to the advance of Left (negative moves Right closer)
+}
+
+// TextSpan is a segment of a rich text line drawn by DrawSpans, with its own
+// font, scale and inversion, such as a large number followed by a small unit.
+type TextSpan struct {
+	Text   string // UTF-8 text of the segment
+	Font   *Font  // Font of the segment (nil = current font)
+	Scale  uint8  // Size of each glyph pixel in display pixels (0 = the current text scale)
+	Invert bool   // Draw clear glyphs on a filled background
+}
+
+// TextRotation is the direction text is drawn in, turning glyphs around the
+// starting point of the baseline.
+type TextRotation uint8
+
+const (
+	Rotate0   TextRotation = iota // Left to right
+	Rotate90                      // Bottom to top, turned a quarter turn counter-clockwise
+	Rotate180                     // Right to left, upside down
+	Rotate270                     // Top to bottom, turned a quarter turn clockwise
+)
+
+// TextAlign is the horizontal alignment of the lines of DrawTextBox.
+type TextAlign uint8
+
+const (
+	AlignLeft    TextAlign = iota // Lines start at the left edge
+	AlignCenter                   // Lines are centered
+	AlignRight                    // Lines end at the right edge
+	AlignJustify                  // Wrapped lines fill the width by widening their spaces
+)
+
+// Glyph describes the bitmap and metrics of a single character in a Font.
+type Glyph struct {
+	Rune    rune   // Character drawn by the glyph
+	Offset  uint32 // Index of the first bitmap byte in Font.Bitmap
+	Width   uint8  // Bitmap width in pixels
+	Height  uint8  // Bitmap height in pixels
+	XOffset int8   // Left edge of the bitmap relative to the pen position
+	YOffset int8   // Top edge of the bitmap relative to the baseline (negative is above)
+	Advance uint8  // Distance the pen moves to the next glyph
 }
@@ -0,0 +1,177 @@
+package t8go
+
+import (
+	"errors"
+	"strconv"
+	"time"
+)
+
+// Pages of the built-in test pattern, in the order Demo shows them.
+const (
+	DemoOrientation = iota // Border, corner markers and an arrow pointing at the top-left corner
+	DemoPatterns           // Checkerboard, stripes and solid blocks to spot stuck rows and columns
+	DemoPrimitives         // Lines, boxes, circles, ellipses, arcs and triangles
+	DemoText               // Every glyph of the current font
+	DemoWidgets            // Progress bars and indicators as drawn by the widget package
+	DemoContrast           // Full-screen fill while Demo ramps the brightness
+
+	DemoPages // Number of pages
+)
+
+// DemoPageDuration is how long Demo shows each page.
+const DemoPageDuration = 2 * time.Second
+
+// Demo cycles through the built-in test pattern, showing every page for
+// DemoPageDuration, so hardware bring-up can verify wiring, orientation and
+// contrast with one call. On the contrast page the brightness is ramped from
+// dimmest to brightest and left at its maximum; drivers without brightness
+// control keep the page still. Returns the first flush error.
+func Demo(ctx IDisplayDrawer) error {
+	for page := range DemoPages {
+		DemoPage(ctx, page)
+		if err := ctx.Display(); err != nil {
+			return err
+		}
+
+		if page != DemoContrast {
+			time.Sleep(DemoPageDuration)
+			continue
+		}
+
+		const steps = 8
+		for step := range steps + 1 {
+			err := ctx.SetBrightness(uint8(step * 255 / steps))
+			if errors.Is(err, ErrUnsupported) {
+				time.Sleep(DemoPageDuration)
+				break
+			}
+			if err != nil {
+				return err
+			}
+			time.Sleep(DemoPageDuration / steps)
+		}
+	}
+	return nil
+}
+
+// DemoPage clears the buffer and draws one page of the test pattern (one of
+// the Demo* page constants) scaled to the display, without flushing it.
+// Unknown pages leave the buffer cleared.
+func DemoPage(ctx IDisplayDrawer, page int) {
+	ctx.ClearBuffer()
+	w, h := ctx.Size()
+	width, height := int16(w), int16(h)
+
+	switch page {
+	case DemoOrientation:
+		demoOrientation(ctx, width, height)
+	case DemoPatterns:
+		demoPatterns(ctx, width, height)
+	case DemoPrimitives:
+		demoPrimitives(ctx, width, height)
+	case DemoText:
+		demoText(ctx, width, height)
+	case DemoWidgets:
+		demoWidgets(ctx, width, height)
+	case DemoContrast:
+		ctx.DrawBoxFill(0, 0, width, height)
+		ctx.ClearRegion(width/2-27, height/2-7, 55, 13)
+		ctx.DrawText(width/2-24, height/2+3, "CONTRAST")
+	}
+}
+
+// demoOrientation draws the display border, a marker in every corner and an
+// arrow into the top-left corner labelled with the display size, so mirrored
+// or rotated panels and shifted columns are obvious.
+func demoOrientation(ctx IDisplayDrawer, width, height int16) {
+	ctx.DrawBox(0, 0, width, height)
+	ctx.DrawBoxFill(0, 0, 4, 4)
+	ctx.DrawBoxFill(width-3, 0, 3, 3)
+	ctx.DrawBoxFill(0, height-2, 2, 2)
+	ctx.DrawPixel(width-2, height-2)
+
+	ctx.DrawTriangleFill(3, 3, 15, 5, 5, 15)
+	ctx.DrawLine(5, 5, 20, 20)
+	ctx.DrawText(18, 12, "TOP LEFT")
+
+	var text [16]byte
+	size := strconv.AppendUint(text[:0], uint64(width), 10)
+	size = append(size, 'x')
+	size = strconv.AppendUint(size, uint64(height), 10)
+	ctx.DrawText(width/2-int16(len(size))*3, height/2+4, string(size))
+}
+
+// demoPatterns fills four columns with a checkerboard, vertical stripes,
+// horizontal stripes and a solid block.
+func demoPatterns(ctx IDisplayDrawer, width, height int16) {
+	quarter := width / 4
+	for y := range height {
+		for x := int16(y & 1); x < quarter; x += 2 {
+			ctx.DrawPixel(x, y)
+		}
+	}
+	for x := quarter; x < 2*quarter; x += 2 {
+		ctx.DrawVLine(x, 0, height)
+	}
+	for y := int16(0); y < height; y += 2 {
+		ctx.DrawHLine(2*quarter, y, quarter)
+	}
+	ctx.DrawBoxFill(3*quarter, 0, width-3*quarter, height)
+}
+
+// demoPrimitives draws every primitive in a row of cells.
+func demoPrimitives(ctx IDisplayDrawer, width, height int16) {
+	cell := width / 4
+	radius := min(cell, height/2)/2 - 2
+	top, middle := height/2-radius-2, height/2
+
+	for x := int16(0); x < cell; x += 4 {
+		ctx.DrawLine(0, height-1, x, 0)
+	}
+	ctx.DrawBox(cell+2, 2, cell-4, middle-4)
+	ctx.DrawRoundBoxFill(cell+2, middle+2, cell-4, middle-4, 3)
+	ctx.DrawCircle(2*cell+cell/2, top+radius, radius, DrawAll)
+	ctx.DrawEllipseFill(2*cell+cell/2, middle+radius+2, cell/2-2, radius, DrawAll)
+	ctx.DrawArcFill(3*cell+cell/2, top+radius, radius, 16, 208)
+	ctx.DrawTriangle(3*cell+2, height-2, 3*cell+cell/2, middle+2, width-3, height-2)
+}
+
+// demoText draws every glyph of the current font, wrapped to the display.
+func demoText(ctx IDisplayDrawer, width, height int16) {
+	font := ctx.GetFont()
+	x, y := int16(0), font.Ascent
+	for _, glyph := range font.Glyphs {
+		advance := int16(glyph.Advance)
+		if x+advance > width {
+			x, y = 0, y+font.LineHeight
+			if y-font.Ascent >= height {
+				return
+			}
+		}
+		ctx.DrawText(x, y, string(glyph.Rune))
+		x += advance
+	}
+}
+
+// demoWidgets draws progress bars at 25%, 50%, 75% and 100% next to
+// indicators that alternate between on and off.
+func demoWidgets(ctx IDisplayDrawer, width, height int16) {
+	rowHeight := height / 4
+	barWidth := width - rowHeight - 4
+	for row := range int16(4) {
+		y := row*rowHeight + 1
+		bar := Rect{X: 0, Y: y, Width: barWidth, Height: rowHeight - 2}
+		ctx.DrawBox(bar.X, bar.Y, bar.Width, bar.Height)
+		if inner := bar.Inset(2); !inner.Empty() {
+			ctx.DrawBoxFill(inner.X, inner.Y, inner.Width*(row+1)/4, inner.Height)
+		}
+
+		radius := (rowHeight - 3) / 2
+		centerX, centerY := width-rowHeight/2-1, y+(rowHeight-2)/2
+		if row%2 == 0 {
+			ctx.DrawCircleFill(centerX, centerY, radius, DrawAll)
+		} else {
+			ctx.DrawCircle(centerX, centerY, radius, DrawAll)
+		}
+	}
+}
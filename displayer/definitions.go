@@ -0,0 +1,47 @@
+package displayer
+
+import (
+	"errors"
+	"image/color"
+
+	"github.com/redghc/t8go"
+)
+
+// Displayer matches the Displayer interface of tinygo.org/x/drivers, so any
+// TinyGo display driver (and tinydraw/tinyfont code) works with this package
+// without importing the drivers module.
+type Displayer interface {
+	Size() (x, y int16)                // Size returns the display dimensions in pixels
+	SetPixel(x, y int16, c color.RGBA) // SetPixel sets the pixel at (x, y) to c
+	Display() error                    // Display sends the buffer to the screen
+}
+
+// Config holds the colors used when t8go draws on a Displayer.
+type Config struct {
+	On  color.RGBA // Color of pixels that are on (default: white)
+	Off color.RGBA // Color of pixels that are off (default: black)
+}
+
+// display adapts a Displayer to t8go.IDisplay. T8Go draws into a page buffer
+// that is pushed to the Displayer on Display.
+type display struct {
+	target Displayer  // Wrapped TinyGo driver
+	on     color.RGBA // Color of pixels that are on
+	off    color.RGBA // Color of pixels that are off
+	width  uint16     // Display width in pixels
+	height uint16     // Display height in pixels
+	buffer []byte     // Display buffer (SSD1306-style page layout)
+	shown  []byte     // Buffer contents last pushed to target
+	synced bool       // Whether shown matches the target
+}
+
+// adapter adapts a t8go.IDisplay to Displayer.
+type adapter struct {
+	display t8go.IDisplay // Wrapped t8go driver
+}
+
+// Common errors returned by the displayer package.
+var (
+	ErrNilDisplay        = errors.New("display cannot be nil")      // Nil display passed to an adapter constructor
+	ErrInvalidDimensions = errors.New("invalid display dimensions") // Displayer reports a non-positive size
+)
@@ -0,0 +1,185 @@
+// Package displayer adapts between t8go displays and TinyGo display drivers
+// (tinygo.org/x/drivers Displayer), in both directions:
+//
+//   - New lets t8go draw on any existing TinyGo display driver.
+//   - ToDisplayer lets tinydraw, tinyfont and other Displayer code draw on a
+//     t8go driver.
+package displayer
+
+import (
+	"image/color"
+
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/framebuf"
+)
+
+var (
+	_ t8go.IDisplay    = &display{}
+	_ t8go.ISpanDrawer = &display{}
+	_ t8go.IBufferInfo = &display{}
+	_ Displayer        = adapter{}
+)
+
+// * ----- t8go on a TinyGo driver -----
+
+// New wraps a TinyGo display driver as a t8go display. Drawing goes to an
+// internal monochrome buffer; Display pushes the pixels that changed since the
+// previous Display to target (all of them the first time) and calls its Display.
+func New(target Displayer, config Config) (t8go.IDisplay, error) {
+	if target == nil {
+		return nil, ErrNilDisplay
+	}
+	width, height := target.Size()
+	if width <= 0 || height <= 0 {
+		return nil, ErrInvalidDimensions
+	}
+
+	if config.On == (color.RGBA{}) && config.Off == (color.RGBA{}) {
+		config.On = color.RGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF}
+		config.Off = color.RGBA{A: 0xFF}
+	}
+
+	size := framebuf.Size(int(width), int(height))
+	return &display{
+		target: target,
+		on:     config.On,
+		off:    config.Off,
+		width:  uint16(width),
+		height: uint16(height),
+		buffer: make([]byte, size),
+		shown:  make([]byte, size),
+	}, nil
+}
+
+// Size returns the display dimensions
+func (d *display) Size() (width, height uint16) {
+	return d.width, d.height
+}
+
+// BufferSize returns the size of the display buffer
+func (d *display) BufferSize() int {
+	return len(d.buffer)
+}
+
+// Buffer returns the display buffer
+func (d *display) Buffer() []byte {
+	return d.buffer
+}
+
+// ClearBuffer clears the display buffer
+func (d *display) ClearBuffer() {
+	clear(d.buffer)
+}
+
+// ClearDisplay clears the buffer and the target display
+func (d *display) ClearDisplay() {
+	d.ClearBuffer()
+	_ = d.Display()
+}
+
+// Command is a no-op; TinyGo drivers expose their own configuration methods
+func (d *display) Command(cmd byte) error {
+	return nil
+}
+
+// Display pushes the changed pixels to the target and calls its Display.
+func (d *display) Display() error {
+	width := int(d.width)
+	for i, value := range d.buffer {
+		changed := value ^ d.shown[i]
+		if d.synced && changed == 0 {
+			continue
+		}
+
+		x, page := i%width, i/width
+		for bit := range 8 {
+			y := page*8 + bit
+			if y >= int(d.height) {
+				break
+			}
+			if d.synced && changed&(1<<bit) == 0 {
+				continue
+			}
+			if value&(1<<bit) != 0 {
+				d.target.SetPixel(int16(x), int16(y), d.on)
+			} else {
+				d.target.SetPixel(int16(x), int16(y), d.off)
+			}
+		}
+	}
+
+	copy(d.shown, d.buffer)
+	d.synced = true
+	return d.target.Display()
+}
+
+// SetPixel sets a pixel at the given coordinates
+// Out-of-bounds are safely ignored.
+func (d *display) SetPixel(x, y int16, on bool) {
+	d.frame().SetPixel(int(x), int(y), on)
+}
+
+// GetPixel returns the current pixel state from the buffer
+func (d *display) GetPixel(x, y int16) bool {
+	return d.frame().GetPixel(int(x), int(y))
+}
+
+// DrawHSpan sets or clears a horizontal run of pixels starting at (x, y)
+func (d *display) DrawHSpan(x, y, length int16, on bool) {
+	d.frame().HSpan(int(x), int(y), int(length), on)
+}
+
+// FillRect sets or clears a rectangle with its top-left corner at (x, y)
+func (d *display) FillRect(x, y, width, height int16, on bool) {
+	d.frame().FillRect(int(x), int(y), int(width), int(height), on)
+}
+
+// BufferInfo describes the display buffer so T8Go can draw into it directly
+func (d *display) BufferInfo() t8go.BufferInfo {
+	return t8go.BufferInfo{
+		Data:   d.buffer,
+		Width:  d.width,
+		Height: d.height,
+		Stride: int(d.width),
+		Layout: t8go.LayoutPageMajor,
+	}
+}
+
+// frame returns a framebuf view of the display buffer
+func (d *display) frame() framebuf.Buffer {
+	return framebuf.Buffer{Data: d.buffer, Width: int(d.width), Height: int(d.height)}
+}
+
+// * ----- TinyGo code on a t8go driver -----
+
+// ToDisplayer wraps a t8go display as a Displayer for tinydraw, tinyfont and
+// other code written against tinygo.org/x/drivers. Colors are passed to
+// drivers implementing t8go.IColorDisplay and otherwise shown as on or off
+// per t8go.Color.IsOn. Pass the driver (ctx.GetDisplay() for a context).
+func ToDisplayer(display t8go.IDisplay) (Displayer, error) {
+	if display == nil {
+		return nil, ErrNilDisplay
+	}
+	return adapter{display: display}, nil
+}
+
+// Size returns the display dimensions
+func (a adapter) Size() (x, y int16) {
+	width, height := a.display.Size()
+	return int16(width), int16(height)
+}
+
+// SetPixel sets the pixel at (x, y) to the given color
+func (a adapter) SetPixel(x, y int16, c color.RGBA) {
+	value := t8go.RGB(c.R, c.G, c.B)
+	if colored, ok := a.display.(t8go.IColorDisplay); ok {
+		colored.SetPixelColor(x, y, value)
+		return
+	}
+	a.display.SetPixel(x, y, value.IsOn())
+}
+
+// Display sends the buffer to the physical display
+func (a adapter) Display() error {
+	return a.display.Display()
+}
@@ -0,0 +1,359 @@
+package drawtest
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/drivers/memory"
+	"github.com/redghc/t8go/fixed"
+	"github.com/redghc/t8go/framebuf"
+)
+
+// Dimensions of the displays used by Check.
+const (
+	checkWidth  = 64
+	checkHeight = 32
+)
+
+// Primitives lists every drawing call exercised by Check, in input order.
+var Primitives = []Primitive{
+	{Name: "DrawPixel", Args: 2, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) { ctx.DrawPixel(a[0], a[1]) }},
+	{Name: "DrawLine", Args: 4, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) { ctx.DrawLine(a[0], a[1], a[2], a[3]) }},
+	{Name: "DrawVLine", Args: 3, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) { ctx.DrawVLine(a[0], a[1], a[2]) }},
+	{Name: "DrawHLine", Args: 3, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) { ctx.DrawHLine(a[0], a[1], a[2]) }},
+	{Name: "DrawLineThick", Args: 5, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) { ctx.DrawLineThick(a[0], a[1], a[2], a[3], a[4]) }},
+	{Name: "DrawLineAngle", Args: 4, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) { ctx.DrawLineAngle(a[0], a[1], a[2], uint8(a[3])) }},
+	{Name: "DrawBox", Args: 4, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) { ctx.DrawBox(a[0], a[1], a[2], a[3]) }},
+	{Name: "DrawBoxCoords", Args: 4, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) { ctx.DrawBoxCoords(a[0], a[1], a[2], a[3]) }},
+	{Name: "DrawBoxThick", Args: 5, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) { ctx.DrawBoxThick(a[0], a[1], a[2], a[3], a[4]) }},
+	{Name: "DrawRoundBox", Args: 5, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) { ctx.DrawRoundBox(a[0], a[1], a[2], a[3], a[4]) }},
+	{Name: "DrawBoxFill", Args: 4, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) { ctx.DrawBoxFill(a[0], a[1], a[2], a[3]) }, Outline: "DrawBox"},
+	{Name: "DrawBoxFillCoords", Args: 4, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) { ctx.DrawBoxFillCoords(a[0], a[1], a[2], a[3]) }, Outline: "DrawBoxCoords"},
+	{Name: "DrawRoundBoxFill", Args: 5, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) { ctx.DrawRoundBoxFill(a[0], a[1], a[2], a[3], a[4]) }, Outline: "DrawRoundBox"},
+	{Name: "DrawTriangle", Args: 6, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) { ctx.DrawTriangle(a[0], a[1], a[2], a[3], a[4], a[5]) }},
+	{Name: "DrawTriangleFill", Args: 6, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) { ctx.DrawTriangleFill(a[0], a[1], a[2], a[3], a[4], a[5]) }, Outline: "DrawTriangle"},
+	{Name: "DrawCircle", Args: 4, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) { ctx.DrawCircle(a[0], a[1], a[2], t8go.DrawQuadrants(a[3])) }, Symmetric: true},
+	{Name: "DrawCircleFill", Args: 4, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) {
+		ctx.DrawCircleFill(a[0], a[1], a[2], t8go.DrawQuadrants(a[3]))
+	}, Outline: "DrawCircle", Symmetric: true},
+	{Name: "DrawCircleThick", Args: 5, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) {
+		ctx.DrawCircleThick(a[0], a[1], a[2], a[3], t8go.DrawQuadrants(a[4]))
+	}},
+	{Name: "DrawEllipse", Args: 5, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) {
+		ctx.DrawEllipse(a[0], a[1], a[2], a[3], t8go.DrawQuadrants(a[4]))
+	}},
+	{Name: "DrawEllipseFill", Args: 5, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) {
+		ctx.DrawEllipseFill(a[0], a[1], a[2], a[3], t8go.DrawQuadrants(a[4]))
+	}, Outline: "DrawEllipse"},
+	{Name: "DrawArc", Args: 5, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) { ctx.DrawArc(a[0], a[1], a[2], uint8(a[3]), uint8(a[4])) }},
+	{Name: "DrawArcFill", Args: 5, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) { ctx.DrawArcFill(a[0], a[1], a[2], uint8(a[3]), uint8(a[4])) }},
+	{Name: "DrawArcThick", Args: 6, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) {
+		ctx.DrawArcThick(a[0], a[1], a[2], a[3], uint8(a[4]), uint8(a[5]))
+	}},
+	{Name: "ClearRegion", Args: 4, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) {
+		ctx.DrawBoxFill(0, 0, checkWidth, checkHeight)
+		ctx.ClearRegion(a[0], a[1], a[2], a[3])
+	}},
+	{Name: "DrawBuffer", Args: 4, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) {
+		ctx.DrawBoxFill(0, 0, checkWidth, checkHeight/2)
+		ctx.DrawBuffer(a[0], a[1], pattern(a[2], a[3]))
+	}},
+	{Name: "DrawNinePatch", Args: 4, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) {
+		ctx.DrawBoxFill(0, 0, checkWidth, checkHeight/2)
+		ctx.DrawNinePatch(a[0], a[1], a[2], a[3], t8go.NinePatch{Bitmap: pattern(9, 7), Left: 3, Top: 2, Right: 4, Bottom: 3})
+	}},
+	{Name: "DrawBitmap", Args: 5, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) {
+		ctx.DrawBoxFill(0, 0, checkWidth, checkHeight/2)
+		ctx.SetBitmapMode(t8go.BitmapMode(a[4] & 1))
+		buffer := pattern(a[2], a[3])
+		ctx.DrawBitmap(a[0], a[1], int16(buffer.Width), int16(buffer.Height), buffer.Data)
+	}},
+	{Name: "DrawText", Args: 4, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) {
+		textStyle(ctx, a[2], a[3])
+		ctx.DrawText(a[0], a[1], checkText)
+	}},
+	{Name: "DrawTextClipped", Args: 6, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) {
+		ctx.DrawTextClipped(a[0], a[1], checkText, t8go.Rect{X: a[2], Y: a[3], Width: a[4], Height: a[5]})
+	}},
+	{Name: "DrawTextBox", Args: 5, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) {
+		textStyle(ctx, a[4], 0)
+		ctx.DrawTextBox(a[0], a[1], a[2], a[3], checkText+" "+checkText, t8go.TextAlign(uint16(a[4])%3))
+	}},
+	{Name: "DrawLineThick/transformed", Args: 6, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) {
+		ctx.PushTransform(checkTransform(a))
+		ctx.DrawLineThick(-a[4], -a[5], a[4], a[5], 3)
+	}},
+	{Name: "DrawBoxThick/transformed", Args: 6, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) {
+		ctx.PushTransform(checkTransform(a))
+		ctx.DrawBoxThick(-a[4], -a[5], a[4], a[5], 2)
+	}},
+	{Name: "DrawRoundBoxFill/transformed", Args: 6, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) {
+		ctx.PushTransform(checkTransform(a))
+		ctx.DrawRoundBoxFill(-a[4], -a[5], a[4], a[5], 3)
+	}},
+	{Name: "DrawTriangleFill/transformed", Args: 6, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) {
+		ctx.PushTransform(checkTransform(a))
+		ctx.DrawTriangleFill(-a[4], 0, a[4], -a[5], 0, a[5])
+	}},
+	{Name: "DrawArc/transformed", Args: 6, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) {
+		ctx.PushTransform(checkTransform(a))
+		ctx.DrawArc(0, 0, a[4], uint8(a[5]), uint8(a[5])+100)
+	}},
+	{Name: "DrawText/transformed", Args: 6, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) {
+		ctx.PushTransform(checkTransform(a))
+		textStyle(ctx, a[4], a[5])
+		ctx.DrawText(-10, 4, checkText)
+	}},
+	{Name: "DrawBuffer/transformed", Args: 6, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) {
+		ctx.DrawBoxFill(0, 0, checkWidth, checkHeight/2)
+		ctx.PushTransform(checkTransform(a))
+		ctx.DrawBuffer(-8, -6, pattern(a[4], a[5]))
+	}},
+	{Name: "DrawNinePatch/transformed", Args: 6, Draw: func(ctx t8go.IDisplayDrawer, a [6]int16) {
+		ctx.PushTransform(checkTransform(a))
+		ctx.DrawNinePatch(-a[4], -a[5], a[4], a[5], t8go.NinePatch{Bitmap: pattern(9, 7), Left: 3, Top: 2, Right: 4, Bottom: 3})
+	}},
+}
+
+// checkText is the text drawn by the text primitives, with glyphs above and
+// below the baseline.
+const checkText = "Ag 9|"
+
+// checkScales are the scale factors picked by checkTransform, mirroring ones
+// included.
+var checkScales = [4]fixed.Q16{fixed.Q16One, fixed.Q16One * 2, fixed.Q16One / 2, -fixed.Q16One * 3 / 2}
+
+// checkTransform returns the transform of the transformed primitives: a move
+// to (args[0], args[1]), a turn by args[2] and scales picked by the low bits
+// of args[3].
+func checkTransform(args [6]int16) t8go.Transform {
+	return t8go.IdentityTransform().Translate(args[0], args[1]).Rotate(uint8(args[2])).
+		Scale(checkScales[args[3]&3], checkScales[args[3]>>2&3])
+}
+
+// textStyle sets the text scale, rotation and inversion picked by the low bits
+// of style and invert.
+func textStyle(ctx t8go.IDisplayDrawer, style, invert int16) {
+	ctx.SetTextScale(uint8(style&3) + 1)
+	ctx.SetTextRotation(t8go.TextRotation(style >> 2 & 3))
+	ctx.SetTextInvert(invert&1 != 0)
+}
+
+// pattern returns a buffer of up to 63 x 63 pixels filled with a fixed mix of
+// lit and unlit pixels, for checking DrawBuffer and DrawNinePatch.
+func pattern(width, height int16) framebuf.Buffer {
+	buffer := framebuf.Buffer{Width: int(width & 63), Height: int(height & 63)}
+	buffer.Data = make([]byte, framebuf.Size(buffer.Width, buffer.Height))
+	for i := range buffer.Data {
+		buffer.Data[i] = byte(i*37 + 11)
+	}
+	return buffer
+}
+
+// Check decodes a primitive and its arguments from data and verifies the
+// drawing invariants: no panics, no out-of-bounds writes, identical output on
+// the SetPixel and fast paths, mirror symmetry of whole circles, and fills
+// staying within their outlines. The first byte selects the primitive; the
+// following bytes are little-endian int16 arguments (missing ones are zero).
+// Each input is checked as is and folded into the display area, so random
+// data also exercises fully visible shapes.
+func Check(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	primitive := Primitives[int(data[0])%len(Primitives)]
+	args := decodeArgs(data, primitive.Args)
+	if err := CheckPrimitive(primitive, args); err != nil {
+		return err
+	}
+	return CheckPrimitive(primitive, fold(args))
+}
+
+// decodeArgs reads count little-endian int16 arguments following the
+// selector byte of data; missing ones are zero.
+func decodeArgs(data []byte, count int) [6]int16 {
+	var args [6]int16
+	for i := range count {
+		if offset := 1 + 2*i; offset+2 <= len(data) {
+			args[i] = int16(binary.LittleEndian.Uint16(data[offset:]))
+		}
+	}
+	return args
+}
+
+// fold maps every argument into a range slightly larger than the check
+// display, so random data also produces visible, partially clipped shapes.
+func fold(args [6]int16) [6]int16 {
+	var folded [6]int16
+	for i, value := range args {
+		folded[i] = int16(uint16(value)%(checkWidth+32)) - 16
+	}
+	return folded
+}
+
+// Fuzz is the go-fuzz entry point. It panics with the error of Check when an
+// invariant is violated and returns 1 for inputs that hold every argument.
+func Fuzz(data []byte) int {
+	if err := Check(data); err != nil {
+		panic(err)
+	}
+	if len(data) > 0 && len(data) >= 1+2*Primitives[int(data[0])%len(Primitives)].Args {
+		return 1
+	}
+	return 0
+}
+
+// CheckPrimitive draws primitive with args and verifies the invariants
+// described in Check.
+func CheckPrimitive(primitive Primitive, args [6]int16) error {
+	call := fmt.Sprintf("%s%v", primitive.Name, args[:primitive.Args])
+
+	slow := NewDisplay(checkWidth, checkHeight)
+	if err := draw(slow, primitive, args); err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrPanic, call, err)
+	}
+	if count, first := slow.OutOfBounds(); count > 0 {
+		return fmt.Errorf("%w: %s: %d pixels, first at %v", ErrOutOfBounds, call, count, first)
+	}
+
+	fast, err := memory.New(memory.Config{Width: checkWidth, Height: checkHeight})
+	if err != nil {
+		return err
+	}
+	if err := draw(fast, primitive, args); err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrPanic, call, err)
+	}
+	if !bytes.Equal(slow.Buffer(), fast.Buffer()) {
+		return fmt.Errorf("%w: %s", ErrPathMismatch, call)
+	}
+
+	if primitive.Symmetric && wholeCircle(args) && !symmetric(slow, args[0], args[1]) {
+		return fmt.Errorf("%w: %s", ErrAsymmetric, call)
+	}
+
+	if primitive.Outline != "" && fullMask(primitive, args) {
+		outline := NewDisplay(checkWidth, checkHeight)
+		if err := draw(outline, lookup(primitive.Outline), args); err != nil {
+			return fmt.Errorf("%w: %s: %v", ErrPanic, primitive.Outline, err)
+		}
+		if !within(slow, outline) {
+			return fmt.Errorf("%w: %s", ErrOutsideOutline, call)
+		}
+	}
+	return nil
+}
+
+// draw runs primitive on a new context for display, converting a panic into an error.
+func draw(display t8go.IDisplay, primitive Primitive, args [6]int16) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("%v", recovered)
+		}
+	}()
+
+	primitive.Draw(t8go.New(display), args)
+	return nil
+}
+
+// lookup returns the primitive with the given name.
+func lookup(name string) Primitive {
+	for _, primitive := range Primitives {
+		if primitive.Name == name {
+			return primitive
+		}
+	}
+	panic("drawtest: unknown primitive " + name)
+}
+
+// wholeCircle reports whether circle args (center, radius, mask) describe a
+// complete circle that fits on the check display.
+func wholeCircle(args [6]int16) bool {
+	centerX, centerY, radius := int32(args[0]), int32(args[1]), int32(args[2])
+	mask := t8go.DrawQuadrants(args[3])
+	return radius > 0 && (mask == t8go.DrawNone || mask&t8go.DrawAll == t8go.DrawAll) &&
+		centerX-radius >= 0 && centerX+radius < checkWidth &&
+		centerY-radius >= 0 && centerY+radius < checkHeight
+}
+
+// fullMask reports whether a filled primitive is drawn whole, so it can be
+// compared with its outline. Shapes without a quadrant mask are always whole.
+func fullMask(primitive Primitive, args [6]int16) bool {
+	var mask t8go.DrawQuadrants
+	switch primitive.Name {
+	case "DrawCircleFill":
+		mask = t8go.DrawQuadrants(args[3])
+	case "DrawEllipseFill":
+		mask = t8go.DrawQuadrants(args[4])
+	default:
+		return true
+	}
+	return mask == t8go.DrawNone || mask&t8go.DrawAll == t8go.DrawAll
+}
+
+// symmetric reports whether the display contents are mirror-symmetric around
+// the vertical and horizontal lines through (centerX, centerY).
+func symmetric(display *Display, centerX, centerY int16) bool {
+	for y := range int16(checkHeight) {
+		for x := range int16(checkWidth) {
+			if !display.GetPixel(x, y) {
+				continue
+			}
+			if !display.GetPixel(2*centerX-x, y) || !display.GetPixel(x, 2*centerY-y) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// within reports whether, on every row, the pixels of fill lie between the
+// leftmost and rightmost pixels of outline. Rasterized curves can skip rows,
+// so rows without outline pixels use the nearest outline rows above and below
+// (filled shapes are convex); fill rows with no outline on one side, which
+// may be clipped away, are not checked. Fill reaching the left or right
+// display border may extend past the outline, which can be clipped there too.
+func within(fill, outline *Display) bool {
+	for y := range int16(checkHeight) {
+		fillLeft, fillRight := extent(fill, y)
+		if fillRight < 0 {
+			continue
+		}
+
+		aboveLeft, aboveRight := nearestExtent(outline, y, -1)
+		belowLeft, belowRight := nearestExtent(outline, y, 1)
+		if aboveRight < 0 || belowRight < 0 {
+			continue
+		}
+		outlineLeft, outlineRight := min(aboveLeft, belowLeft), max(aboveRight, belowRight)
+		if (fillLeft < outlineLeft && fillLeft != 0) || (fillRight > outlineRight && fillRight != checkWidth-1) {
+			return false
+		}
+	}
+	return true
+}
+
+// nearestExtent returns the extent of the first non-empty row of display
+// starting at y and moving by step, or (checkWidth, -1) when there is none.
+func nearestExtent(display *Display, y, step int16) (left, right int16) {
+	for ; y >= 0 && y < checkHeight; y += step {
+		if left, right = extent(display, y); right >= 0 {
+			return left, right
+		}
+	}
+	return checkWidth, -1
+}
+
+// extent returns the leftmost and rightmost set pixels of row y, or
+// (checkWidth, -1) when the row is empty.
+func extent(display *Display, y int16) (left, right int16) {
+	left, right = checkWidth, -1
+	for x := range int16(checkWidth) {
+		if display.GetPixel(x, y) {
+			left, right = min(left, x), max(right, x)
+		}
+	}
+	return left, right
+}
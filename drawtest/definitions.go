@@ -0,0 +1,55 @@
+package drawtest
+
+import (
+	"errors"
+
+	"github.com/redghc/t8go"
+)
+
+// Display is a virtual display for tests. It stores pixels in a plain buffer,
+// implements no fast paths (so every pixel goes through SetPixel) and counts
+// writes outside its bounds instead of ignoring them silently.
+type Display struct {
+	width       uint16     // Display width in pixels
+	height      uint16     // Display height in pixels
+	buffer      []byte     // Display buffer (SSD1306-style page layout)
+	outOfBounds int        // Number of SetPixel calls outside the display
+	firstOut    t8go.Point // First out-of-bounds SetPixel position
+}
+
+// Primitive describes a drawing call exercised by Check and Fuzz.
+type Primitive struct {
+	Name      string                                       // Name of the IDisplayDrawer method ("/transformed" when drawn under PushTransform)
+	Args      int                                          // Number of int16 arguments consumed from the input
+	Draw      func(ctx t8go.IDisplayDrawer, args [6]int16) // Performs the call
+	Outline   string                                       // For filled shapes, the outline primitive the fill must stay within
+	Symmetric bool                                         // Output is mirror-symmetric around args[0], args[1] when drawn whole
+}
+
+// Property is a relationship between primitives checked by CheckProperties,
+// such as a line drawing the same pixels in both directions.
+type Property struct {
+	Name  string                   // Short description of the relationship
+	Args  int                      // Number of int16 arguments consumed from the input
+	Holds func(args [6]int16) bool // Reports whether the relationship holds for args
+}
+
+// Golden is a monochrome reference image stored as text, one line per pixel
+// row with '#' for pixels that are on and '.' for pixels that are off: the
+// format written by T8Go.DumpASCII.
+type Golden struct {
+	Width  int    // Image width in pixels
+	Height int    // Image height in pixels
+	pixels []bool // Pixel states, row by row
+}
+
+// Common errors reported by the drawtest package.
+var (
+	ErrPanic          = errors.New("primitive panicked")                            // The primitive panicked
+	ErrOutOfBounds    = errors.New("pixel written outside the display")             // SetPixel was called with off-screen coordinates
+	ErrPathMismatch   = errors.New("fast path output differs from SetPixel output") // Span/direct paths draw different pixels
+	ErrAsymmetric     = errors.New("symmetric shape is not symmetric")              // A whole circle is not mirror-symmetric
+	ErrOutsideOutline = errors.New("filled shape draws outside its outline")        // A fill covers pixels outside the matching outline
+	ErrProperty       = errors.New("geometry property violated")                    // Related primitives disagree (see Properties)
+	ErrGoldenFormat   = errors.New("malformed golden image")                        // Golden text has uneven rows or unknown characters
+)
@@ -0,0 +1,111 @@
+// Package drawtest provides test support for t8go: a virtual Display that
+// flags out-of-bounds writes, invariant checks and a fuzzing entry point that
+// feed random and extreme coordinates into every primitive, and property
+// checks that relate primitives to each other (see Properties).
+//
+// Use Check from a native Go fuzz test:
+//
+//	func FuzzPrimitives(f *testing.F) {
+//		f.Fuzz(func(t *testing.T, data []byte) {
+//			if err := drawtest.Check(data); err != nil {
+//				t.Fatal(err)
+//			}
+//		})
+//	}
+//
+// or Fuzz with go-fuzz. CheckProperties takes the same input.
+//
+// Screens can be compared with golden images (ExpectGolden), or unit-tested
+// without golden files by drawing onto a Display and asserting on regions of it:
+//
+//	display := drawtest.NewDisplay(128, 64)
+//	screen.Draw(t8go.New(display))
+//	display.ExpectRect(t, t8go.Rect{X: 0, Y: 0, Width: 128, Height: 10}, true)
+//	display.ExpectCount(t, t8go.Rect{X: 0, Y: 10, Width: 128, Height: 54}, 0)
+package drawtest
+
+import (
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/framebuf"
+)
+
+var _ t8go.IDisplay = &Display{}
+
+// NewDisplay creates a virtual display with the given dimensions.
+func NewDisplay(width, height uint16) *Display {
+	return &Display{
+		width:  width,
+		height: height,
+		buffer: make([]byte, framebuf.Size(int(width), int(height))),
+	}
+}
+
+// Size returns the display dimensions
+func (d *Display) Size() (width, height uint16) {
+	return d.width, d.height
+}
+
+// BufferSize returns the size of the display buffer
+func (d *Display) BufferSize() int {
+	return len(d.buffer)
+}
+
+// Buffer returns the display buffer
+func (d *Display) Buffer() []byte {
+	return d.buffer
+}
+
+// ClearBuffer clears the display buffer
+func (d *Display) ClearBuffer() {
+	clear(d.buffer)
+}
+
+// ClearDisplay clears the display buffer (there is no physical display to update)
+func (d *Display) ClearDisplay() {
+	d.ClearBuffer()
+}
+
+// Command is a no-op for the virtual display
+func (d *Display) Command(cmd byte) error {
+	return nil
+}
+
+// Display is a no-op for the virtual display; the buffer is the output
+func (d *Display) Display() error {
+	return nil
+}
+
+// SetPixel sets a pixel at the given coordinates.
+// Out-of-bounds writes are counted (see OutOfBounds) and otherwise ignored.
+func (d *Display) SetPixel(x, y int16, on bool) {
+	if x < 0 || y < 0 || x >= int16(d.width) || y >= int16(d.height) {
+		if d.outOfBounds == 0 {
+			d.firstOut = t8go.Point{X: x, Y: y}
+		}
+		d.outOfBounds++
+		return
+	}
+	d.frame().SetPixel(int(x), int(y), on)
+}
+
+// GetPixel returns the current pixel state from the buffer
+func (d *Display) GetPixel(x, y int16) bool {
+	return d.frame().GetPixel(int(x), int(y))
+}
+
+// OutOfBounds returns the number of SetPixel calls outside the display and
+// the position of the first one.
+func (d *Display) OutOfBounds() (count int, first t8go.Point) {
+	return d.outOfBounds, d.firstOut
+}
+
+// Reset clears the buffer and the out-of-bounds counter.
+func (d *Display) Reset() {
+	d.ClearBuffer()
+	d.outOfBounds, d.firstOut = 0, t8go.Point{}
+}
+
+// frame returns a framebuf view of the display buffer
+func (d *Display) frame() framebuf.Buffer {
+	return framebuf.Buffer{Data: d.buffer, Width: int(d.width), Height: int(d.height)}
+}
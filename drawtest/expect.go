@@ -0,0 +1,99 @@
+package drawtest
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/redghc/t8go"
+)
+
+// maxReported limits the number of mismatching pixels listed in a failure.
+const maxReported = 10
+
+// ExpectPixels reports an error on tb when any pixel in pixels differs from
+// its expected state (true for on), listing the first ones in row order.
+// Pixels outside the display read as off.
+func (d *Display) ExpectPixels(tb testing.TB, pixels map[t8go.Point]bool) {
+	tb.Helper()
+
+	var mismatches []t8go.Point
+	for point, on := range pixels {
+		if d.GetPixel(point.X, point.Y) != on {
+			mismatches = append(mismatches, point)
+		}
+	}
+	if len(mismatches) > 0 {
+		slices.SortFunc(mismatches, func(a, b t8go.Point) int {
+			return cmp.Or(cmp.Compare(a.Y, b.Y), cmp.Compare(a.X, b.X))
+		})
+		tb.Errorf("drawtest: %d of %d pixels differ: %s", len(mismatches), len(pixels), listPoints(mismatches, pixels))
+	}
+}
+
+// ExpectRect reports an error on tb unless every pixel of rect is on (filled
+// is true) or off (filled is false). Pixels outside the display read as off.
+func (d *Display) ExpectRect(tb testing.TB, rect t8go.Rect, filled bool) {
+	tb.Helper()
+
+	var mismatches []t8go.Point
+	count := 0
+	d.scan(rect, func(point t8go.Point, on bool) {
+		if on != filled {
+			count++
+			if len(mismatches) < maxReported {
+				mismatches = append(mismatches, point)
+			}
+		}
+	})
+	if count > 0 {
+		state := "off"
+		if filled {
+			state = "on"
+		}
+		tb.Errorf("drawtest: %d pixels of %v are not %s, first at %v", count, rect, state, mismatches)
+	}
+}
+
+// ExpectCount reports an error on tb unless exactly n pixels of rect are on.
+func (d *Display) ExpectCount(tb testing.TB, rect t8go.Rect, n int) {
+	tb.Helper()
+
+	count := 0
+	d.scan(rect, func(_ t8go.Point, on bool) {
+		if on {
+			count++
+		}
+	})
+	if count != n {
+		tb.Errorf("drawtest: %v has %d pixels on, want %d", rect, count, n)
+	}
+}
+
+// scan calls visit for every pixel of rect, row by row.
+func (d *Display) scan(rect t8go.Rect, visit func(point t8go.Point, on bool)) {
+	for y := int32(rect.Y); y < int32(rect.Y)+int32(rect.Height); y++ {
+		for x := int32(rect.X); x < int32(rect.X)+int32(rect.Width); x++ {
+			point := t8go.Point{X: int16(x), Y: int16(y)}
+			visit(point, d.GetPixel(point.X, point.Y))
+		}
+	}
+}
+
+// listPoints formats up to maxReported mismatching points with the expected state.
+func listPoints(points []t8go.Point, expected map[t8go.Point]bool) string {
+	var sb strings.Builder
+	for i, point := range points {
+		if i == maxReported {
+			fmt.Fprintf(&sb, " ... (%d more)", len(points)-maxReported)
+			break
+		}
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		fmt.Fprintf(&sb, "%v want %t", point, expected[point])
+	}
+	return sb.String()
+}
@@ -0,0 +1,41 @@
+package drawtest
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+)
+
+// seedArgs are argument sets added to the corpus for every primitive:
+// visible shapes, degenerate ones and the int16 extremes.
+var seedArgs = [][6]int16{
+	{10, 8, 30, 20, 4, 9},
+	{32, 16, 12, 5, 3, 200},
+	{-5, -7, 70, 40, 2, 64},
+	{0, 0, 0, 0, 0, 0},
+	{63, 31, 1, 1, 1, 1},
+	{math.MinInt16, math.MinInt16, math.MaxInt16, math.MaxInt16, math.MaxInt16, math.MinInt16},
+	{math.MaxInt16, math.MaxInt16, math.MinInt16, math.MinInt16, math.MinInt16, math.MaxInt16},
+}
+
+// encode returns the input of Check selecting primitive with args.
+func encode(primitive int, args [6]int16) []byte {
+	data := []byte{byte(primitive)}
+	for _, arg := range args {
+		data = binary.LittleEndian.AppendUint16(data, uint16(arg))
+	}
+	return data
+}
+
+func FuzzPrimitives(f *testing.F) {
+	for primitive := range Primitives {
+		for _, args := range seedArgs {
+			f.Add(encode(primitive, args))
+		}
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if err := Check(data); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
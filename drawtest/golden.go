@@ -0,0 +1,194 @@
+package drawtest
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/bmp"
+)
+
+// UpdateGoldenEnv is the environment variable that makes ExpectGolden write
+// the current output as the new golden image instead of comparing.
+const UpdateGoldenEnv = "T8GO_UPDATE_GOLDEN"
+
+// diffScale is the size in pixels of the pattern cell drawn for every pixel
+// of a diff image.
+const diffScale = 4
+
+// * ----- Golden images -----
+
+// ParseGolden reads a golden image from text in the T8Go.DumpASCII format.
+// Trailing blank lines are ignored.
+func ParseGolden(text []byte) (*Golden, error) {
+	lines := strings.Split(strings.TrimRight(strings.ReplaceAll(string(text), "\r\n", "\n"), "\n"), "\n")
+	golden := &Golden{Width: len(lines[0]), Height: len(lines)}
+	if golden.Width == 0 {
+		return nil, ErrGoldenFormat
+	}
+
+	golden.pixels = make([]bool, 0, golden.Width*golden.Height)
+	for _, line := range lines {
+		if len(line) != golden.Width {
+			return nil, ErrGoldenFormat
+		}
+		for i := range len(line) {
+			switch line[i] {
+			case '#':
+				golden.pixels = append(golden.pixels, true)
+			case '.':
+				golden.pixels = append(golden.pixels, false)
+			default:
+				return nil, ErrGoldenFormat
+			}
+		}
+	}
+	return golden, nil
+}
+
+// Pixel reports whether the pixel at (x, y) is on. Pixels outside the image are off.
+func (g *Golden) Pixel(x, y int) bool {
+	if x < 0 || y < 0 || x >= g.Width || y >= g.Height {
+		return false
+	}
+	return g.pixels[y*g.Width+x]
+}
+
+// ExpectGolden compares the display with the golden image stored at path and
+// reports an error on tb when they differ, including a text diff and the path
+// of a diff image written next to the golden file (see WriteDiff). When the
+// UpdateGoldenEnv environment variable is set, the golden file is rewritten
+// with the current contents instead.
+func (d *Display) ExpectGolden(tb testing.TB, path string) {
+	tb.Helper()
+	d.expectGolden(tb, path, t8go.Rect{Width: int16(d.width), Height: int16(d.height)})
+}
+
+// ExpectGoldenRegion works like ExpectGolden but only compares the pixels
+// inside region, clipped to the display, so the golden image of a widget
+// stays small and does not change with the rest of the screen.
+func (d *Display) ExpectGoldenRegion(tb testing.TB, path string, region t8go.Rect) {
+	tb.Helper()
+	clipped := region.Intersect(t8go.Rect{Width: int16(d.width), Height: int16(d.height)})
+	if clipped.Empty() {
+		tb.Fatalf("drawtest: golden region %+v is outside the display", region)
+	}
+	d.expectGolden(tb, path, clipped)
+}
+
+// expectGolden compares region of the display with the golden image at path.
+func (d *Display) expectGolden(tb testing.TB, path string, region t8go.Rect) {
+	tb.Helper()
+
+	var current bytes.Buffer
+	_ = t8go.New(d).ExportRegion(region, &current, t8go.ExportASCII) // Writing to a bytes.Buffer cannot fail
+
+	if os.Getenv(UpdateGoldenEnv) != "" {
+		if err := os.WriteFile(path, current.Bytes(), 0o644); err != nil {
+			tb.Fatalf("drawtest: updating golden image: %v", err)
+		}
+		tb.Logf("drawtest: updated golden image %s", path)
+		return
+	}
+
+	text, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		tb.Fatalf("drawtest: golden image %s does not exist; run with %s=1 to create it", path, UpdateGoldenEnv)
+	}
+	if err != nil {
+		tb.Fatalf("drawtest: reading golden image: %v", err)
+	}
+	want, err := ParseGolden(text)
+	if err != nil {
+		tb.Fatalf("drawtest: %s: %v", path, err)
+	}
+
+	width, height := int(region.Width), int(region.Height)
+	got := func(x, y int) bool {
+		if x >= width || y >= height {
+			return false
+		}
+		return d.GetPixel(region.X+int16(x), region.Y+int16(y))
+	}
+	diff, changed := DiffText(max(width, want.Width), max(height, want.Height), got, want.Pixel)
+	if changed == 0 && width == want.Width && height == want.Height {
+		return
+	}
+
+	diffPath := path + ".diff.bmp"
+	if err := writeDiffFile(diffPath, max(width, want.Width), max(height, want.Height), got, want.Pixel); err != nil {
+		diffPath = "not written: " + err.Error()
+	}
+	tb.Errorf("drawtest: output differs from %s: %dx%d, want %dx%d, %d pixels changed (diff image %s)\n%s",
+		path, width, height, want.Width, want.Height, changed, diffPath, diff)
+}
+
+// * ----- Diffs -----
+
+// DiffText compares two images of the given size and returns a text view of
+// the result, using '#' and '.' for unchanged pixels that are on and off, '+'
+// for pixels only on in got and '-' for pixels only on in want, together with
+// the number of changed pixels.
+func DiffText(width, height int, got, want bmp.PixelFunc) (string, int) {
+	var sb strings.Builder
+	sb.Grow((width + 1) * height)
+
+	changed := 0
+	for y := range height {
+		for x := range width {
+			gotOn, wantOn := got(x, y), want(x, y)
+			switch {
+			case gotOn && wantOn:
+				sb.WriteByte('#')
+			case gotOn:
+				sb.WriteByte('+')
+				changed++
+			case wantOn:
+				sb.WriteByte('-')
+				changed++
+			default:
+				sb.WriteByte('.')
+			}
+		}
+		sb.WriteByte('\n')
+	}
+	return sb.String(), changed
+}
+
+// WriteDiff writes a composite BMP image comparing two images of the given
+// size, every pixel enlarged to a 4x4 cell so changes stand out: unchanged
+// pixels are solid or empty, pixels only on in got are checkered and pixels
+// only on in want are hollow squares.
+func WriteDiff(w io.Writer, width, height int, got, want bmp.PixelFunc) error {
+	return bmp.Encode(w, width*diffScale, height*diffScale, func(x, y int) bool {
+		cellX, cellY := x%diffScale, y%diffScale
+		gotOn, wantOn := got(x/diffScale, y/diffScale), want(x/diffScale, y/diffScale)
+		switch {
+		case gotOn && wantOn:
+			return true
+		case gotOn:
+			return (cellX+cellY)%2 == 0
+		case wantOn:
+			return cellX == 0 || cellY == 0 || cellX == diffScale-1 || cellY == diffScale-1
+		default:
+			return false
+		}
+	})
+}
+
+// writeDiffFile writes the WriteDiff image to path.
+func writeDiffFile(path string, width, height int, got, want bmp.PixelFunc) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := WriteDiff(file, width, height, got, want); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
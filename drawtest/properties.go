@@ -0,0 +1,134 @@
+package drawtest
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/helpers"
+)
+
+// Properties lists the relationships between primitives verified by
+// CheckProperties, in input order. They guard rewrites of the rasterizers:
+// a faster algorithm must keep drawing the same shapes.
+var Properties = []Property{
+	{Name: "DrawCircle lies on the boundary of DrawCircleFill", Args: 4, Holds: circleOnFillBoundary},
+	{Name: "DrawBoxCoords equals DrawBox after normalization", Args: 4, Holds: boxCoordsEqualBox},
+	{Name: "DrawLine(a, b) equals DrawLine(b, a)", Args: 4, Holds: lineReversible},
+	{Name: "DrawVLine and DrawHLine equal DrawLine", Args: 3, Holds: spansEqualLine},
+}
+
+// CheckProperties decodes a property and its arguments from data, in the
+// input format of Check, and verifies it for the arguments as is and folded
+// into the display area.
+func CheckProperties(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	property := Properties[int(data[0])%len(Properties)]
+	args := decodeArgs(data, property.Args)
+	if err := CheckProperty(property, args); err != nil {
+		return err
+	}
+	return CheckProperty(property, fold(args))
+}
+
+// CheckProperty verifies property for args. A panic is reported as ErrPanic.
+func CheckProperty(property Property, args [6]int16) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("%w: %s%v: %v", ErrPanic, property.Name, args[:property.Args], recovered)
+		}
+	}()
+
+	if !property.Holds(args) {
+		return fmt.Errorf("%w: %s: %v", ErrProperty, property.Name, args[:property.Args])
+	}
+	return nil
+}
+
+// render draws onto a new check display and returns it.
+func render(draw func(ctx t8go.IDisplayDrawer)) *Display {
+	display := NewDisplay(checkWidth, checkHeight)
+	draw(t8go.New(display))
+	return display
+}
+
+// same reports whether both draw calls produce the same pixels.
+func same(a, b func(ctx t8go.IDisplayDrawer)) bool {
+	return bytes.Equal(render(a).Buffer(), render(b).Buffer())
+}
+
+// circleOnFillBoundary checks that every pixel of the outline is also drawn
+// by the fill and has a 4-neighbour the fill leaves off.
+func circleOnFillBoundary(args [6]int16) bool {
+	centerX, centerY, radius, mask := args[0], args[1], args[2], t8go.DrawQuadrants(args[3])
+	outline := render(func(ctx t8go.IDisplayDrawer) { ctx.DrawCircle(centerX, centerY, radius, mask) })
+	fill := render(func(ctx t8go.IDisplayDrawer) { ctx.DrawCircleFill(centerX, centerY, radius, mask) })
+
+	for y := range int16(checkHeight) {
+		for x := range int16(checkWidth) {
+			if !outline.GetPixel(x, y) {
+				continue
+			}
+			if !fill.GetPixel(x, y) {
+				return false
+			}
+			// Pixels on the display border may border clipped fill
+			onBorder := x == 0 || y == 0 || x == checkWidth-1 || y == checkHeight-1
+			if !onBorder && fill.GetPixel(x-1, y) && fill.GetPixel(x+1, y) && fill.GetPixel(x, y-1) && fill.GetPixel(x, y+1) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// boxCoordsEqualBox checks DrawBoxCoords and DrawBoxFillCoords against
+// DrawBox and DrawBoxFill with the top-left corner and size of the same box.
+func boxCoordsEqualBox(args [6]int16) bool {
+	x0, y0, x1, y1 := args[0], args[1], args[2], args[3]
+	width := helpers.AbsDiff(int32(x0), int32(x1)) + 1
+	height := helpers.AbsDiff(int32(y0), int32(y1)) + 1
+	if width > math.MaxInt16 || height > math.MaxInt16 {
+		return true // Not expressible as a size
+	}
+	left, top := min(x0, x1), min(y0, y1)
+
+	return same(
+		func(ctx t8go.IDisplayDrawer) { ctx.DrawBoxCoords(x0, y0, x1, y1) },
+		func(ctx t8go.IDisplayDrawer) { ctx.DrawBox(left, top, int16(width), int16(height)) },
+	) && same(
+		func(ctx t8go.IDisplayDrawer) { ctx.DrawBoxFillCoords(x0, y0, x1, y1) },
+		func(ctx t8go.IDisplayDrawer) { ctx.DrawBoxFill(left, top, int16(width), int16(height)) },
+	)
+}
+
+// lineReversible checks that a line draws the same pixels in both directions.
+func lineReversible(args [6]int16) bool {
+	x0, y0, x1, y1 := args[0], args[1], args[2], args[3]
+	return same(
+		func(ctx t8go.IDisplayDrawer) { ctx.DrawLine(x0, y0, x1, y1) },
+		func(ctx t8go.IDisplayDrawer) { ctx.DrawLine(x1, y1, x0, y0) },
+	)
+}
+
+// spansEqualLine checks DrawVLine and DrawHLine against the DrawLine between
+// their first and last pixels.
+func spansEqualLine(args [6]int16) bool {
+	x, y, length := args[0], args[1], args[2]
+	if length == 0 {
+		return true
+	}
+	end := int32(length) - int32(helpers.Direction(length))
+
+	return same(
+		func(ctx t8go.IDisplayDrawer) { ctx.DrawVLine(x, y, length) },
+		func(ctx t8go.IDisplayDrawer) { ctx.DrawLine(x, y, x, helpers.ClampInt16(int32(y)+end)) },
+	) && same(
+		func(ctx t8go.IDisplayDrawer) { ctx.DrawHLine(x, y, length) },
+		func(ctx t8go.IDisplayDrawer) { ctx.DrawLine(x, y, helpers.ClampInt16(int32(x)+end), y) },
+	)
+}
@@ -0,0 +1,16 @@
+package drawtest
+
+import "testing"
+
+func FuzzProperties(f *testing.F) {
+	for property := range Properties {
+		for _, args := range seedArgs {
+			f.Add(encode(property, args))
+		}
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if err := CheckProperties(data); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
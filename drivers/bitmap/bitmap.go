@@ -4,41 +4,51 @@
 package bitmap
 
 import (
-	"encoding/binary"
 	"os"
 
 	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/bmp"
+	"github.com/redghc/t8go/framebuf"
 )
 
 // display implements the t8go.Display interface for bitmap file output.
 // It provides a virtual display that saves graphics as bitmap files.
 type display struct {
-	width    uint16 // Display width in pixels
-	height   uint16 // Display height in pixels
-	filename string // Output bitmap filename
-	buffer   []byte // Display buffer
-	bufSize  int    // Buffer size in bytes
+	width    uint16            // Display width in pixels
+	height   uint16            // Display height in pixels
+	filename string            // Output bitmap filename
+	buffer   []byte            // Display buffer
+	bufSize  int               // Buffer size in bytes
+	pitch    uint16            // Pixel pitch in micrometers (0 = unknown)
+	layout   t8go.BufferLayout // Pixel packing of buffer
 }
 
-var _ t8go.IDisplay = &display{}
+var (
+	_ t8go.IDisplay    = &display{}
+	_ t8go.ISpanDrawer = &display{}
+	_ t8go.IBufferInfo = &display{}
+
+	_ t8go.ICapabilities = &display{}
+)
 
 // New creates a new bitmap display instance with the specified configuration.
 // The display will render graphics to a bitmap file with the given dimensions.
 // If no filename is specified, it defaults to "display.bmp".
-// Returns an error if the dimensions are invalid (zero width or height).
+// Returns an error if the dimensions are invalid (zero width or height) or
+// the layout is unknown.
 func New(config Config) (t8go.IDisplay, error) {
 	if config.Width == 0 || config.Height == 0 {
 		return nil, ErrInvalidDimensions
 	}
+	if !config.Layout.Valid() {
+		return nil, ErrInvalidLayout
+	}
 
 	if config.Filename == "" {
 		config.Filename = "display.bmp"
 	}
 
-	bufSize := int(config.Width) * int(config.Height) / 8
-	if int(config.Height)%8 != 0 {
-		bufSize += int(config.Width)
-	}
+	bufSize := config.Layout.Size(int(config.Width), int(config.Height))
 
 	d := &display{
 		width:    config.Width,
@@ -46,6 +56,8 @@ func New(config Config) (t8go.IDisplay, error) {
 		filename: config.Filename,
 		buffer:   make([]byte, bufSize),
 		bufSize:  bufSize,
+		pitch:    config.PixelPitch,
+		layout:   config.Layout,
 	}
 
 	return d, nil
@@ -91,40 +103,40 @@ func (d *display) Display() error {
 }
 
 // SetPixel sets a pixel at the given coordinates
+// Out-of-bounds are safely ignored.
 func (d *display) SetPixel(x, y int16, color bool) {
-	if x < 0 || y < 0 || x >= int16(d.width) || y >= int16(d.height) {
-		return
-	}
-
-	// Use same buffer organization as SSD1306 for compatibility
-	byteIndex := int(x) + (int(y)/8)*int(d.width)
-	bitMask := uint8(1 << (y & 7))
-
-	if byteIndex >= len(d.buffer) {
-		return
-	}
-
-	if color {
-		d.buffer[byteIndex] |= bitMask
-	} else {
-		d.buffer[byteIndex] &^= bitMask
-	}
+	d.frame().SetPixel(int(x), int(y), color)
 }
 
 // GetPixel gets the state of a pixel at the given coordinates
-func (d *display) GetPixel(x, y uint8) bool {
-	if x >= uint8(d.width) || y >= uint8(d.height) {
-		return false
-	}
+func (d *display) GetPixel(x, y int16) bool {
+	return d.frame().GetPixel(int(x), int(y))
+}
 
-	byteIndex := int(x) + (int(y)/8)*int(d.width)
-	bitMask := uint8(1 << (y & 7))
+// DrawHSpan sets or clears a horizontal run of pixels starting at (x, y)
+func (d *display) DrawHSpan(x, y, length int16, on bool) {
+	d.frame().HSpan(int(x), int(y), int(length), on)
+}
+
+// FillRect sets or clears a rectangle with its top-left corner at (x, y)
+func (d *display) FillRect(x, y, width, height int16, on bool) {
+	d.frame().FillRect(int(x), int(y), int(width), int(height), on)
+}
 
-	if byteIndex >= len(d.buffer) {
-		return false
+// BufferInfo describes the display buffer so T8Go can draw into it directly
+func (d *display) BufferInfo() t8go.BufferInfo {
+	return t8go.BufferInfo{
+		Data:   d.buffer,
+		Width:  d.width,
+		Height: d.height,
+		Stride: d.layout.Stride(int(d.width), int(d.height)),
+		Layout: d.layout,
 	}
+}
 
-	return d.buffer[byteIndex]&bitMask != 0
+// frame returns a framebuf view of the display buffer
+func (d *display) frame() framebuf.Buffer {
+	return framebuf.Buffer{Data: d.buffer, Width: int(d.width), Height: int(d.height), Layout: d.layout}
 }
 
 // saveBMP saves the display buffer as a BMP file
@@ -135,95 +147,19 @@ func (d *display) saveBMP() error {
 	}
 	defer file.Close()
 
-	// BMP file structure
-	width := int32(d.width)
-	height := int32(d.height)
-
-	// Calculate padding for BMP format (rows must be multiple of 4 bytes)
-	rowSize := (width + 31) / 32 * 4
-	imageSize := rowSize * height
-	fileSize := 54 + imageSize // 54 bytes for headers + image data
-
-	// BMP File Header (14 bytes)
-	bmpHeader := []byte{
-		'B', 'M', // Signature
-		0, 0, 0, 0, // File size (to be filled)
-		0, 0, // Reserved
-		0, 0, // Reserved
-		54, 0, 0, 0, // Offset to pixel data
-	}
-	binary.LittleEndian.PutUint32(bmpHeader[2:6], uint32(fileSize))
-
-	// BMP Info Header (40 bytes)
-	infoHeader := make([]byte, 40)
-	binary.LittleEndian.PutUint32(infoHeader[0:4], 40)                  // Header size
-	binary.LittleEndian.PutUint32(infoHeader[4:8], uint32(width))       // Width
-	binary.LittleEndian.PutUint32(infoHeader[8:12], uint32(height))     // Height
-	binary.LittleEndian.PutUint16(infoHeader[12:14], 1)                 // Planes
-	binary.LittleEndian.PutUint16(infoHeader[14:16], 1)                 // Bits per pixel (monochrome)
-	binary.LittleEndian.PutUint32(infoHeader[16:20], 0)                 // Compression
-	binary.LittleEndian.PutUint32(infoHeader[20:24], uint32(imageSize)) // Image size
-	binary.LittleEndian.PutUint32(infoHeader[24:28], 2835)              // X pixels per meter
-	binary.LittleEndian.PutUint32(infoHeader[28:32], 2835)              // Y pixels per meter
-	binary.LittleEndian.PutUint32(infoHeader[32:36], 2)                 // Colors used (black and white)
-	binary.LittleEndian.PutUint32(infoHeader[36:40], 0)                 // Important colors
-
-	// Write headers
-	if _, err := file.Write(bmpHeader); err != nil {
-		return ErrFileWrite
-	}
-	if _, err := file.Write(infoHeader); err != nil {
-		return ErrFileWrite
-	}
-
-	// Color palette for monochrome BMP (8 bytes)
-	colorPalette := []byte{
-		0x00, 0x00, 0x00, 0x00, // Black (BGRA)
-		0xFF, 0xFF, 0xFF, 0x00, // White (BGRA)
-	}
-	if _, err := file.Write(colorPalette); err != nil {
+	if err := bmp.Encode(file, int(d.width), int(d.height), d.frame().GetPixel); err != nil {
 		return ErrFileWrite
 	}
 
-	// Convert buffer to BMP pixel data
-	// BMP rows are stored bottom-to-top, so we need to flip the image
-	pixelData := make([]byte, imageSize)
-
-	for y := int16(0); y < int16(height); y++ {
-		bmpRow := int(height) - 1 - int(y) // Flip vertically for BMP format
-		rowStart := int64(bmpRow) * int64(rowSize)
-
-		for x := int16(0); x < int16(width); x += 8 {
-			// Get the source byte from our buffer
-			srcByteIndex := int(x/8) + (int(y)/8)*int(d.width)
-			var srcByte uint8
-			if srcByteIndex < len(d.buffer) {
-				// Extract the relevant bits for this row from the source byte
-				bitOffset := y & 7
-				srcByte = (d.buffer[srcByteIndex] >> bitOffset) & 1
-				if srcByte != 0 {
-					srcByte = 0xFF
-				}
-			}
-
-			// Convert 8 pixels at a time
-			dstByteIndex := rowStart + int64(x/8)
-			if dstByteIndex < int64(len(pixelData)) {
-				var dstByte uint8
-				for bit := 0; bit < 8 && x+int16(bit) < int16(width); bit++ {
-					if d.GetPixel(uint8(x+int16(bit)), uint8(y)) {
-						dstByte |= 1 << (7 - bit) // MSB first for BMP
-					}
-				}
-				pixelData[dstByteIndex] = dstByte
-			}
-		}
-	}
+	return nil
+}
 
-	// Write pixel data
-	if _, err := file.Write(pixelData); err != nil {
-		return ErrFileWrite
+// Capabilities describes the bitmap display: monochrome, written whole on
+// every flush, with the configured pixel pitch
+func (d *display) Capabilities() t8go.Capabilities {
+	return t8go.Capabilities{
+		GrayscaleBits: 1,
+		PixelPitchX:   d.pitch,
+		PixelPitchY:   d.pitch,
 	}
-
-	return nil
 }
@@ -1,16 +1,29 @@
 package bitmap
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/redghc/t8go"
+)
 
 // Config holds the configuration parameters for a bitmap display instance.
 type Config struct {
 	Width    uint16 // Display width in pixels (must be > 0)
 	Height   uint16 // Display height in pixels (must be > 0)
 	Filename string // Output bitmap filename (defaults to "display.bmp" if empty)
+
+	// PixelPitch is the pixel pitch in micrometers reported through
+	// Capabilities, to preview physical layouts of a panel (0 = unknown).
+	PixelPitch uint16
+
+	// Layout is the pixel packing of the buffer (default: page-major, as on
+	// the SSD1306), to match the controller it stands in for.
+	Layout t8go.BufferLayout
 }
 
 // Common errors returned by the bitmap driver.
 var (
 	ErrInvalidDimensions = errors.New("invalid display dimensions")  // Width or height is zero
 	ErrFileWrite         = errors.New("failed to write bitmap file") // Bitmap file write failed
+	ErrInvalidLayout     = errors.New("unsupported buffer layout")   // Layout is not a known BufferLayout
 )
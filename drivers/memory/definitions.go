@@ -0,0 +1,27 @@
+package memory
+
+import (
+	"errors"
+
+	"github.com/redghc/t8go"
+)
+
+// Config holds the configuration parameters for an in-memory display instance.
+type Config struct {
+	Width  uint16 // Display width in pixels (must be > 0)
+	Height uint16 // Display height in pixels (must be > 0)
+
+	// PixelPitch is the pixel pitch in micrometers reported through
+	// Capabilities, to preview physical layouts of a panel (0 = unknown).
+	PixelPitch uint16
+
+	// Layout is the pixel packing of the buffer (default: page-major, as on
+	// the SSD1306), to match the controller it stands in for.
+	Layout t8go.BufferLayout
+}
+
+// Common errors returned by the memory driver.
+var (
+	ErrInvalidDimensions = errors.New("invalid display dimensions") // Width or height is zero
+	ErrInvalidLayout     = errors.New("unsupported buffer layout")  // Layout is not a known BufferLayout
+)
@@ -0,0 +1,143 @@
+// Package memory provides an in-memory display driver for t8go graphics.
+// It implements the t8go.Display interface without any physical output,
+// which makes it suitable for off-screen rendering (transitions, caching)
+// and as a null driver for tests and benchmarks.
+package memory
+
+import (
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/framebuf"
+)
+
+// display implements the t8go.Display interface backed only by memory.
+type display struct {
+	width   uint16            // Display width in pixels
+	height  uint16            // Display height in pixels
+	buffer  []byte            // Display buffer, packed as layout
+	bufSize int               // Buffer size in bytes
+	pitch   uint16            // Pixel pitch in micrometers (0 = unknown)
+	layout  t8go.BufferLayout // Pixel packing of buffer
+}
+
+var (
+	_ t8go.IDisplay    = &display{}
+	_ t8go.ISpanDrawer = &display{}
+	_ t8go.IBufferInfo = &display{}
+
+	_ t8go.IRegionFlusher = &display{}
+	_ t8go.ICapabilities  = &display{}
+)
+
+// New creates a new in-memory display with the specified dimensions.
+// Returns an error if the dimensions are invalid (zero width or height) or
+// the layout is unknown.
+func New(config Config) (t8go.IDisplay, error) {
+	if config.Width == 0 || config.Height == 0 {
+		return nil, ErrInvalidDimensions
+	}
+	if !config.Layout.Valid() {
+		return nil, ErrInvalidLayout
+	}
+
+	bufSize := config.Layout.Size(int(config.Width), int(config.Height))
+
+	d := &display{
+		width:   config.Width,
+		height:  config.Height,
+		buffer:  make([]byte, bufSize),
+		bufSize: bufSize,
+		pitch:   config.PixelPitch,
+		layout:  config.Layout,
+	}
+
+	return d, nil
+}
+
+// Size returns the display dimensions
+func (d *display) Size() (width, height uint16) {
+	return d.width, d.height
+}
+
+// BufferSize returns the size of the display buffer
+func (d *display) BufferSize() int {
+	return d.bufSize
+}
+
+// Buffer returns the display buffer
+func (d *display) Buffer() []byte {
+	return d.buffer
+}
+
+// ClearBuffer clears the display buffer
+func (d *display) ClearBuffer() {
+	clear(d.buffer)
+}
+
+// ClearDisplay clears the display buffer (there is no physical display to update)
+func (d *display) ClearDisplay() {
+	d.ClearBuffer()
+}
+
+// Command is a no-op for the memory display (maintains interface compatibility)
+func (d *display) Command(cmd byte) error {
+	return nil
+}
+
+// Display is a no-op for the memory display; the buffer is the output
+func (d *display) Display() error {
+	return nil
+}
+
+// DisplayRegion is a no-op like Display; it lets benchmarks and tests
+// exercise the partial update path of T8Go.FlushRegion
+func (d *display) DisplayRegion(x0, y0, x1, y1 int) error {
+	return nil
+}
+
+// SetPixel sets a pixel at the given coordinates
+// Out-of-bounds are safely ignored.
+func (d *display) SetPixel(x, y int16, color bool) {
+	d.frame().SetPixel(int(x), int(y), color)
+}
+
+// GetPixel returns the current pixel state from the buffer
+func (d *display) GetPixel(x, y int16) bool {
+	return d.frame().GetPixel(int(x), int(y))
+}
+
+// DrawHSpan sets or clears a horizontal run of pixels starting at (x, y)
+func (d *display) DrawHSpan(x, y, length int16, on bool) {
+	d.frame().HSpan(int(x), int(y), int(length), on)
+}
+
+// FillRect sets or clears a rectangle with its top-left corner at (x, y)
+func (d *display) FillRect(x, y, width, height int16, on bool) {
+	d.frame().FillRect(int(x), int(y), int(width), int(height), on)
+}
+
+// BufferInfo describes the display buffer so T8Go can draw into it directly
+func (d *display) BufferInfo() t8go.BufferInfo {
+	return t8go.BufferInfo{
+		Data:   d.buffer,
+		Width:  d.width,
+		Height: d.height,
+		Stride: d.layout.Stride(int(d.width), int(d.height)),
+		Layout: d.layout,
+	}
+}
+
+// frame returns a framebuf view of the display buffer
+func (d *display) frame() framebuf.Buffer {
+	return framebuf.Buffer{Data: d.buffer, Width: int(d.width), Height: int(d.height), Layout: d.layout}
+}
+
+// Capabilities describes the memory display: monochrome, with region flushes
+// and the configured pixel pitch
+func (d *display) Capabilities() t8go.Capabilities {
+	return t8go.Capabilities{
+		PartialUpdate: true,
+		GrayscaleBits: 1,
+		PixelPitchX:   d.pitch,
+		PixelPitchY:   d.pitch,
+	}
+}
@@ -0,0 +1,61 @@
+package remote
+
+import "errors"
+
+// Encoding identifies how the payload of a frame is stored.
+type Encoding uint8
+
+const (
+	EncodingRaw   Encoding = iota // Buffer bytes as is
+	EncodingRLE                   // Run-length encoded buffer: (count, value) pairs, count 1..255
+	EncodingDelta                 // Run-length encoded XOR with the previous frame
+)
+
+// MaxDimension is the largest width and height of a remote display. It
+// bounds the frame a viewer allocates for a header it has not checked yet
+// (128 KiB at most).
+const MaxDimension = 1024
+
+// Config holds the configuration parameters for a remote display.
+type Config struct {
+	Width    uint16   // Display width in pixels (1..MaxDimension)
+	Height   uint16   // Display height in pixels (1..MaxDimension)
+	Encoding Encoding // Frame compression (default: EncodingRaw)
+
+	// KeyframeEvery sends a complete (RLE) frame after this many delta frames,
+	// so viewers that join late or lose a datagram recover (default: 30).
+	// Only used with EncodingDelta.
+	KeyframeEvery uint16
+}
+
+// Frame is a decoded frame in the SSD1306-style page layout.
+type Frame struct {
+	Width    uint16 // Frame width in pixels
+	Height   uint16 // Frame height in pixels
+	Sequence uint16 // Sequence number, incremented by the sender on every frame
+	Data     []byte // Page buffer; reused by the next call to the decoder
+}
+
+// Decoder reassembles frames sent by a remote display. Delta frames are
+// applied to the previous frame, so a decoder must see every frame from the
+// last keyframe on.
+type Decoder struct {
+	header   [headerSize]byte // Header of the frame being read
+	payload  []byte           // Payload of the frame being read (grown as needed)
+	frame    []byte           // Last decoded frame
+	width    uint16           // Width of the last decoded frame
+	height   uint16           // Height of the last decoded frame
+	sequence uint16           // Sequence number of the last decoded frame
+	synced   bool             // Whether frame holds a valid image for delta frames
+}
+
+// Common errors returned by the remote driver and decoder.
+var (
+	ErrInvalidDimensions = errors.New("invalid display dimensions")            // Width or height is zero or above MaxDimension
+	ErrInvalidEncoding   = errors.New("unknown frame encoding")                // Config or frame header names an undefined Encoding
+	ErrNilWriter         = errors.New("writer cannot be nil")                  // Nil writer passed to New
+	ErrBadHeader         = errors.New("invalid remote frame header")           // Data does not start with a frame header
+	ErrCorruptFrame      = errors.New("frame payload does not match its size") // Payload decodes to the wrong number of bytes
+	ErrFrameLost         = errors.New("delta frame without its base frame")    // A frame was lost; wait for the next keyframe
+	ErrFrameTooLarge     = errors.New("frame payload exceeds its dimensions")  // Header declares more payload than its width and height allow
+)
@@ -0,0 +1,200 @@
+package remote
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// Frame header layout (all integers little-endian):
+//
+//	offset  size  field
+//	0       3     magic "T8F"
+//	3       1     protocol version
+//	4       1     encoding
+//	5       2     sequence number
+//	7       2     width in pixels
+//	9       2     height in pixels
+//	11      4     payload length in bytes
+const (
+	protocolVersion = 1
+	headerSize      = 15
+)
+
+// appendHeader appends a frame header to dst.
+func appendHeader(dst []byte, encoding Encoding, sequence, width, height uint16, payloadSize int) []byte {
+	dst = append(dst, 'T', '8', 'F', protocolVersion, byte(encoding))
+	dst = binary.LittleEndian.AppendUint16(dst, sequence)
+	dst = binary.LittleEndian.AppendUint16(dst, width)
+	dst = binary.LittleEndian.AppendUint16(dst, height)
+	return binary.LittleEndian.AppendUint32(dst, uint32(payloadSize))
+}
+
+// appendRLE appends the run-length encoding of src to dst as (count, value)
+// pairs. When previous is non-nil, src XOR previous is encoded instead.
+func appendRLE(dst, src, previous []byte) []byte {
+	for i := 0; i < len(src); {
+		value := src[i]
+		if previous != nil {
+			value ^= previous[i]
+		}
+
+		count := 1
+		for i+count < len(src) && count < 255 {
+			next := src[i+count]
+			if previous != nil {
+				next ^= previous[i+count]
+			}
+			if next != value {
+				break
+			}
+			count++
+		}
+
+		dst = append(dst, byte(count), value)
+		i += count
+	}
+	return dst
+}
+
+// expandRLE decodes (count, value) pairs from src into dst, XOR-ing with the
+// existing contents of dst when xor is set. The pairs must fill dst exactly.
+func expandRLE(dst, src []byte, xor bool) error {
+	if len(src)%2 != 0 {
+		return ErrCorruptFrame
+	}
+
+	offset := 0
+	for i := 0; i < len(src); i += 2 {
+		count, value := int(src[i]), src[i+1]
+		if count == 0 || offset+count > len(dst) {
+			return ErrCorruptFrame
+		}
+		run := dst[offset : offset+count]
+		if xor {
+			for j := range run {
+				run[j] ^= value
+			}
+		} else {
+			for j := range run {
+				run[j] = value
+			}
+		}
+		offset += count
+	}
+
+	if offset != len(dst) {
+		return ErrCorruptFrame
+	}
+	return nil
+}
+
+// * ----- Decoder -----
+
+// NewDecoder creates a decoder for frames sent by a remote display.
+func NewDecoder() *Decoder {
+	return &Decoder{}
+}
+
+// Read reads the next frame from a stream transport (TCP, serial).
+// The returned frame data is reused by the next call.
+func (d *Decoder) Read(r io.Reader) (Frame, error) {
+	if _, err := io.ReadFull(r, d.header[:]); err != nil {
+		return Frame{}, err
+	}
+	size, err := payloadSize(d.header[:])
+	if err != nil {
+		return Frame{}, err
+	}
+
+	if cap(d.payload) < size {
+		d.payload = make([]byte, size)
+	}
+	d.payload = d.payload[:size]
+	if _, err := io.ReadFull(r, d.payload); err != nil {
+		return Frame{}, err
+	}
+	return d.decode(d.header[:], d.payload)
+}
+
+// Decode decodes a frame received as a single datagram (UDP).
+// The returned frame data is reused by the next call.
+func (d *Decoder) Decode(packet []byte) (Frame, error) {
+	if len(packet) < headerSize {
+		return Frame{}, ErrBadHeader
+	}
+	size, err := payloadSize(packet)
+	if err != nil {
+		return Frame{}, err
+	}
+	if len(packet)-headerSize != size {
+		return Frame{}, ErrCorruptFrame
+	}
+	return d.decode(packet[:headerSize], packet[headerSize:])
+}
+
+// payloadSize validates a frame header and returns its payload size, which
+// is at most the size of the largest valid payload for its dimensions. With
+// the dimensions limited to MaxDimension, a bad header cannot make the
+// decoder allocate more than the largest frame needs.
+func payloadSize(header []byte) (int, error) {
+	if header[0] != 'T' || header[1] != '8' || header[2] != 'F' || header[3] != protocolVersion {
+		return 0, ErrBadHeader
+	}
+	encoding := Encoding(header[4])
+	if encoding > EncodingDelta {
+		return 0, ErrInvalidEncoding
+	}
+	width := binary.LittleEndian.Uint16(header[7:])
+	height := binary.LittleEndian.Uint16(header[9:])
+	if width == 0 || height == 0 || width > MaxDimension || height > MaxDimension {
+		return 0, ErrInvalidDimensions
+	}
+
+	// Run-length pairs take at most two bytes per buffer byte
+	limit := uint64(width) * ((uint64(height) + 7) / 8)
+	if encoding != EncodingRaw {
+		limit *= 2
+	}
+	size := binary.LittleEndian.Uint32(header[11:])
+	if uint64(size) > limit {
+		return 0, ErrFrameTooLarge
+	}
+	return int(size), nil
+}
+
+// decode applies a frame validated by payloadSize to the decoder state.
+func (d *Decoder) decode(header, payload []byte) (Frame, error) {
+	encoding := Encoding(header[4])
+	sequence := binary.LittleEndian.Uint16(header[5:])
+	width := binary.LittleEndian.Uint16(header[7:])
+	height := binary.LittleEndian.Uint16(header[9:])
+
+	// Delta frames need the exact previous frame
+	if encoding == EncodingDelta &&
+		(!d.synced || sequence != d.sequence+1 || width != d.width || height != d.height) {
+		d.synced = false
+		return Frame{}, ErrFrameLost
+	}
+
+	size := int(width) * ((int(height) + 7) / 8)
+	if encoding == EncodingRaw && len(payload) != size {
+		return Frame{}, ErrCorruptFrame
+	}
+	if cap(d.frame) < size {
+		d.frame = make([]byte, size)
+	}
+	d.frame = d.frame[:size]
+	d.synced = false
+
+	switch encoding {
+	case EncodingRaw:
+		copy(d.frame, payload)
+	case EncodingRLE, EncodingDelta:
+		if err := expandRLE(d.frame, payload, encoding == EncodingDelta); err != nil {
+			return Frame{}, err
+		}
+	}
+
+	d.width, d.height, d.sequence, d.synced = width, height, sequence, true
+	return Frame{Width: width, Height: height, Sequence: sequence, Data: d.frame}, nil
+}
@@ -0,0 +1,183 @@
+// Package remote provides a display driver that streams frames to another
+// machine, so headless devices can mirror their screen to a laptop for
+// support and demos. Frames are written to any io.Writer (a TCP or UDP
+// connection, a serial port) and decoded with Decoder; cmd/t8goview is a
+// ready-made viewer.
+package remote
+
+import (
+	"io"
+
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/framebuf"
+)
+
+// display implements the t8go.Display interface by streaming its buffer.
+type display struct {
+	writer io.Writer // Transport that receives encoded frames
+
+	width   uint16 // Display width in pixels
+	height  uint16 // Display height in pixels
+	buffer  []byte // Display buffer (SSD1306-style page layout)
+	bufSize int    // Buffer size in bytes
+
+	encoding      Encoding // Configured frame encoding
+	keyframeEvery uint16   // Delta frames between keyframes
+	sinceKeyframe uint16   // Delta frames sent since the last keyframe
+	sequence      uint16   // Sequence number of the next frame
+	previous      []byte   // Last sent frame, base of delta frames (EncodingDelta only)
+	packet        []byte   // Encoded frame, reused between Display calls
+}
+
+var (
+	_ t8go.IDisplay    = &display{}
+	_ t8go.ISpanDrawer = &display{}
+	_ t8go.IBufferInfo = &display{}
+)
+
+// New creates a remote display that writes every frame to w.
+// Each frame is sent with a single Write call, so datagram transports carry
+// one frame per packet.
+func New(w io.Writer, config Config) (t8go.IDisplay, error) {
+	if w == nil {
+		return nil, ErrNilWriter
+	}
+	if config.Width == 0 || config.Height == 0 || config.Width > MaxDimension || config.Height > MaxDimension {
+		return nil, ErrInvalidDimensions
+	}
+	if config.Encoding > EncodingDelta {
+		return nil, ErrInvalidEncoding
+	}
+	if config.KeyframeEvery == 0 {
+		config.KeyframeEvery = 30
+	}
+
+	bufSize := framebuf.Size(int(config.Width), int(config.Height))
+
+	d := &display{
+		writer:        w,
+		width:         config.Width,
+		height:        config.Height,
+		buffer:        make([]byte, bufSize),
+		bufSize:       bufSize,
+		encoding:      config.Encoding,
+		keyframeEvery: config.KeyframeEvery,
+		packet:        make([]byte, 0, headerSize+2*bufSize),
+	}
+	if d.encoding == EncodingDelta {
+		d.previous = make([]byte, bufSize)
+	}
+
+	return d, nil
+}
+
+// * ----- Display methods -----
+
+// Size returns the display dimensions
+func (d *display) Size() (width, height uint16) {
+	return d.width, d.height
+}
+
+// BufferSize returns the size of the display buffer
+func (d *display) BufferSize() int {
+	return d.bufSize
+}
+
+// Buffer returns the display buffer
+func (d *display) Buffer() []byte {
+	return d.buffer
+}
+
+// ClearBuffer clears the display buffer
+func (d *display) ClearBuffer() {
+	clear(d.buffer)
+}
+
+// ClearDisplay clears the buffer and sends the empty frame
+func (d *display) ClearDisplay() {
+	d.ClearBuffer()
+	_ = d.Display()
+}
+
+// Command is a no-op for the remote display (maintains interface compatibility)
+func (d *display) Command(cmd byte) error {
+	return nil
+}
+
+// Display encodes the buffer with the configured encoding and writes it.
+// Compressed frames that would be larger than the raw buffer are sent raw.
+func (d *display) Display() error {
+	encoding := d.encoding
+	if encoding == EncodingDelta && (d.sequence == 0 || d.sinceKeyframe >= d.keyframeEvery) {
+		encoding = EncodingRLE
+	}
+
+	// Encode the payload after room for the header, then fill in the header
+	packet := d.packet[:headerSize]
+	switch encoding {
+	case EncodingRLE:
+		packet = appendRLE(packet, d.buffer, nil)
+	case EncodingDelta:
+		packet = appendRLE(packet, d.buffer, d.previous)
+	}
+	if encoding == EncodingRaw || len(packet)-headerSize > d.bufSize {
+		encoding = EncodingRaw
+		packet = append(packet[:headerSize], d.buffer...)
+	}
+	appendHeader(packet[:0], encoding, d.sequence, d.width, d.height, len(packet)-headerSize)
+	d.packet = packet
+
+	if _, err := d.writer.Write(packet); err != nil {
+		return err
+	}
+
+	d.sequence++
+	if encoding == EncodingDelta {
+		d.sinceKeyframe++
+	} else {
+		d.sinceKeyframe = 0
+	}
+	if d.previous != nil {
+		copy(d.previous, d.buffer)
+	}
+	return nil
+}
+
+// SetPixel sets a pixel at the given coordinates
+// Out-of-bounds are safely ignored.
+func (d *display) SetPixel(x, y int16, color bool) {
+	d.frame().SetPixel(int(x), int(y), color)
+}
+
+// GetPixel returns the current pixel state from the buffer
+func (d *display) GetPixel(x, y int16) bool {
+	return d.frame().GetPixel(int(x), int(y))
+}
+
+// * ----- Fast paths -----
+
+// DrawHSpan sets or clears a horizontal run of pixels starting at (x, y)
+func (d *display) DrawHSpan(x, y, length int16, on bool) {
+	d.frame().HSpan(int(x), int(y), int(length), on)
+}
+
+// FillRect sets or clears a rectangle with its top-left corner at (x, y)
+func (d *display) FillRect(x, y, width, height int16, on bool) {
+	d.frame().FillRect(int(x), int(y), int(width), int(height), on)
+}
+
+// BufferInfo describes the display buffer so T8Go can draw into it directly
+func (d *display) BufferInfo() t8go.BufferInfo {
+	return t8go.BufferInfo{
+		Data:   d.buffer,
+		Width:  d.width,
+		Height: d.height,
+		Stride: int(d.width),
+		Layout: t8go.LayoutPageMajor,
+	}
+}
+
+// frame returns a framebuf view of the display buffer
+func (d *display) frame() framebuf.Buffer {
+	return framebuf.Buffer{Data: d.buffer, Width: int(d.width), Height: int(d.height)}
+}
@@ -1,7 +1,27 @@
 package ssd1306
 
-import "errors"
+import (
+	"errors"
+	"strconv"
+)
 
 var (
 	ErrI2CBusNil = errors.New("I2C bus cannot be nil")
 )
+
+// FlushError is returned by a flush that still failed after every retry (see
+// Config.Retries). It wraps the bus error of the last attempt.
+type FlushError struct {
+	Attempts int   // Number of attempts made
+	Err      error // Error of the last attempt
+}
+
+// Error describes the failed flush and its last bus error.
+func (e *FlushError) Error() string {
+	return "flush failed after " + strconv.Itoa(e.Attempts) + " attempts: " + e.Err.Error()
+}
+
+// Unwrap returns the error of the last attempt.
+func (e *FlushError) Unwrap() error {
+	return e.Err
+}
@@ -5,8 +5,12 @@ package ssd1306
 
 import (
 	"machine"
+	"sync"
+	"time"
 
 	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/framebuf"
+	"github.com/redghc/t8go/panel"
 )
 
 // * ----- Definitions -----
@@ -16,28 +20,113 @@ type Config struct {
 	Width   uint8   // Display width in pixels (default: 128)
 	Height  uint8   // Display height in pixels (default: 64)
 	VCCMode VCCMode // VCC generation mode (default: VCC_SWITCH_CAP)
+
+	// PixelPitch is the pixel pitch of the panel in micrometers, reported
+	// through Capabilities for physical layouts (default: 170 for 64-row
+	// 0.96" panels, 175 for 32-row 0.91" panels).
+	PixelPitch uint16
+
+	// Panel replaces the built-in initialization sequence and geometry with
+	// a table generated by cmd/t8gopanel, for panels with other sizes,
+	// offsets, remapping or COM wiring. Width, Height and VCCMode are then
+	// ignored (default: nil, a standard module of Width x Height).
+	Panel *panel.Table
+
+	// Transfer sends the frame data of DisplayAsync without occupying the
+	// CPU, typically through the DMA engine of the target's I²C peripheral,
+	// so the next frame can be drawn while this one streams. The addressing
+	// commands are still written by the driver. When Transfer is nil
+	// (default) or fails to start, DisplayAsync writes the frame from a
+	// goroutine instead. Display always blocks until the frame is sent.
+	Transfer TransferFunc
+
+	// Transfer tuning for I²C stacks that fail or stall on large writes.
+	MaxChunkSize int           // Maximum data bytes per I²C write (default: 0, whole transfer at once)
+	ChunkDelay   time.Duration // Pause between data chunks (default: 0, no pause)
+	Frequency    uint32        // Bus frequency hint in Hz, applied when the bus supports SetBaudRate (default: 0, unchanged)
+
+	// Recovery from transient bus errors, such as NACKs on long cables or
+	// during supply dips. A failed flush is sent again after re-initializing
+	// the panel, which may have reset; once every attempt failed, the flush
+	// returns a *FlushError. With BusConfig set, each retry first clocks out
+	// a slave holding SDA low and configures the bus again with it, so its
+	// SCL and SDA pins must be set.
+	Retries    int                // Extra attempts of a failed flush (default: 0, no retries)
+	RetryDelay time.Duration      // Pause before every retry (default: 0, no pause)
+	BusConfig  *machine.I2CConfig // Bus configuration restored after clocking the bus free (default: nil, no bus recovery)
 }
 
+// TransferFunc starts writing data, which begins with its control byte, to
+// the I²C device at address and returns without waiting for the write to
+// end. It must call done once with the result after the bus is free again,
+// and must leave data untouched until then. It returns an error, without
+// calling done, when the transfer cannot start. MaxChunkSize, ChunkDelay and
+// Retries do not apply to these transfers.
+type TransferFunc func(address uint8, data []byte, done func(error)) error
+
 // display represents an SSD1306 OLED display instance.
 type display struct {
 	bus     *machine.I2C // I2C bus interface
 	address AddressMode  // I2C device address
 
-	width     uint8   // Display width in pixels
-	height    uint8   // Display height in pixels
-	pageCount uint8   // Number of 8-pixel high pages (height / 8)
-	stride    int     // Bytes per page (equals width)
-	vccMode   VCCMode // VCC generation mode
+	width        uint8  // Display width in pixels
+	height       uint8  // Display height in pixels
+	pageCount    uint8  // Number of 8-pixel high pages (height / 8)
+	stride       int    // Bytes per page (equals width)
+	columnOffset uint8  // First controller column wired to the panel
+	chargePump   bool   // Whether the internal charge pump supplies the panel
+	initSeq      []byte // Panel initialization commands (see panel.Table)
+	pitch        uint16 // Pixel pitch in micrometers
 
 	buffer  []byte // Display buffer
 	bufSize int    // Buffer size in bytes
 
+	// Transfer tuning
+	maxChunk   int           // Maximum data bytes per I²C write (0 = unlimited)
+	chunkDelay time.Duration // Pause between data chunks
+
+	// Bus error recovery
+	retries    int                // Extra attempts of a failed flush
+	retryDelay time.Duration      // Pause before every retry
+	busConfig  *machine.I2CConfig // Bus configuration restored by recoverBus (nil = no bus recovery)
+	contrast   uint8              // Contrast register value, restored by init
+	asleep     bool               // Whether the panel is sleeping, kept by init
+
+	// Asynchronous flush state
+	transfer TransferFunc   // Non-blocking frame data write (nil = goroutine)
+	txBuf    []byte         // Control byte and snapshot of the frame being flushed (allocated on first use)
+	pending  sync.WaitGroup // Tracks the in-flight asynchronous flush
+
+	// Transaction batching (see Begin)
+	batchDepth int           // Nesting depth of Begin calls (0 = sending immediately)
+	batch      []byte        // Queued transactions, each starting with its control byte
+	starts     []int         // Offset in batch of every queued transaction
+	queued     []byte        // Commands queued since the last data transaction
+	streaming  bool          // The last transaction is a data stream that can be extended
+	chunkData  int           // Data bytes in the last transaction
+	batchDone  []func(error) // DisplayAsync callbacks waiting for End
+
 	// Pre-allocated command buffers to avoid allocations
 	cmdBuf  [32]byte // Command buffer for sending display commands
 	addrBuf [6]byte  // Address buffer for I2C operations
 }
 
-var _ t8go.IDisplay = &display{}
+// baudRateSetter is implemented by I²C buses that can change their frequency.
+type baudRateSetter interface {
+	SetBaudRate(br uint32) error
+}
+
+var (
+	_ t8go.IDisplay       = &display{}
+	_ t8go.IAsyncDisplay  = &display{}
+	_ t8go.IBatcher       = &display{}
+	_ t8go.ISpanDrawer    = &display{}
+	_ t8go.IBufferInfo    = &display{}
+	_ t8go.ICapabilities  = &display{}
+	_ t8go.IPowerManager  = &display{}
+	_ t8go.IRegionFlusher = &display{}
+	_ t8go.IReinitializer = &display{}
+)
 
 // * ----- Constructors -----
 
@@ -57,74 +146,105 @@ func NewI2C(bus *machine.I2C, address AddressMode, config Config) (t8go.IDisplay
 		config.VCCMode = VCC_SWITCH_CAP
 	}
 
+	// Build the table of a standard module unless one is given
+	table := config.Panel
+	if table == nil {
+		standard, err := panel.Description{
+			Width:       config.Width,
+			Height:      config.Height,
+			ExternalVCC: config.VCCMode == VCC_EXTERNAL,
+		}.Table()
+		if err != nil {
+			return nil, err
+		}
+		table = &standard
+	} else if table.Width == 0 || table.Height == 0 || table.Height%8 != 0 {
+		return nil, panel.ErrInvalidGeometry
+	}
+	config.Width, config.Height = table.Width, table.Height
+
+	if config.PixelPitch == 0 {
+		config.PixelPitch = 170
+		if config.Height <= 32 {
+			config.PixelPitch = 175
+		}
+	}
+
 	bufferSize := int(config.Width) * int(config.Height) / 8
 
 	d := &display{
-		bus:       bus,
-		address:   address,
-		width:     config.Width,
-		height:    config.Height,
-		pageCount: config.Height / 8,
-		stride:    int(config.Width),
-		vccMode:   config.VCCMode,
-		buffer:    make([]byte, bufferSize),
-		bufSize:   bufferSize,
+		bus:          bus,
+		address:      address,
+		width:        config.Width,
+		height:       config.Height,
+		pageCount:    config.Height / 8,
+		stride:       int(config.Width),
+		columnOffset: table.ColumnOffset,
+		chargePump:   table.ChargePump,
+		initSeq:      table.Init,
+		pitch:        config.PixelPitch,
+		transfer:     config.Transfer,
+		maxChunk:     max(config.MaxChunkSize, 0),
+		chunkDelay:   config.ChunkDelay,
+		retries:      max(config.Retries, 0),
+		retryDelay:   config.RetryDelay,
+		contrast:     table.Contrast,
+		buffer:       make([]byte, bufferSize),
+		bufSize:      bufferSize,
+	}
+	if config.BusConfig != nil {
+		busConfig := *config.BusConfig
+		d.busConfig = &busConfig
+	}
+
+	// Apply the bus frequency hint where the target supports it
+	if config.Frequency != 0 {
+		if tuner, ok := any(bus).(baudRateSetter); ok {
+			if err := tuner.SetBaudRate(config.Frequency); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	// Initialize the display
-	if err := d.init(d.width, d.height); err != nil {
+	if err := d.init(); err != nil {
 		return nil, err
 	}
 
 	return d, nil
 }
 
-// init initializes the display
-func (d *display) init(width, height uint8) error {
-	// Determine VCC-dependent settings
-	var chargePump, contrast, preCharge uint8
-	if d.vccMode == VCC_EXTERNAL {
-		chargePump = CHARGE_PUMP_SETTING_OFF
-		contrast = 0x9F
-		preCharge = 0x22
-	} else {
+// init sends the panel initialization sequence, then sets the charge pump and
+// contrast and turns the display on, keeping the contrast set by
+// SetBrightness and the sleep state. Short sequences go in a single write.
+func (d *display) init() error {
+	chargePump, power := uint8(CHARGE_PUMP_SETTING_OFF), uint8(SET_DISPLAY_ON)
+	if d.chargePump && !d.asleep {
 		chargePump = CHARGE_PUMP_SETTING_ON
-		contrast = 0xCF
-		preCharge = 0xF1
 	}
-
-	var comPins uint8
-	if height == 32 {
-		comPins = 0x02
-	} else {
-		comPins = 0x12
+	if d.asleep {
+		power = SET_DISPLAY_OFF
 	}
 
-	// Build initialization sequence in pre-allocated buffer
 	cmdSeq := d.cmdBuf[:0]
+	if len(d.initSeq)+5 <= len(d.cmdBuf) {
+		cmdSeq = append(cmdSeq, d.initSeq...)
+	} else if err := d.commandStream(d.initSeq...); err != nil {
+		return err
+	}
 	cmdSeq = append(cmdSeq,
-		SET_DISPLAY_OFF,
-		SET_DISPLAY_CLOCK_DIVIDE_RATIO, 0x80,
-		SET_MULTIPLEX_RATIO, height-1,
-		SET_DISPLAY_OFFSET, 0x00,
-		SET_START_LINE|0x00,
 		CHARGE_PUMP_SETTING, chargePump,
-		SET_MEMORY_ADDRESSING_MODE, horizontalAddressingMode,
-
-		SET_SEGMENT_REMAP|0x01,
-		SET_COM_OUTPUT_SCAN_DIRECTION_DEC,
-
-		SET_COM_PINS, comPins,
-		SET_CONTRAST, contrast,
-
-		SET_PRE_CHARGE_PERIOD, preCharge,
-		SET_VCOM_DESELECT_LEVEL, 0x20,
-		DISPLAY_ALL_ON_RESUME,
-		SET_NORMAL_DISPLAY,
-		DEACTIVATE_SCROLL,
-		SET_DISPLAY_ON,
+		SET_CONTRAST, d.contrast,
+		power,
 	)
-	return d.CommandStream(cmdSeq...)
+	return d.commandStream(cmdSeq...)
+}
+
+// Reinit sends the initialization sequence again, for example after the
+// panel supply was cycled. The buffer is kept; call Display to restore it.
+func (d *display) Reinit() error {
+	d.pending.Wait()
+	return d.init()
 }
 
 // * ----- Getter methods -----
@@ -157,38 +277,318 @@ func (d *display) ClearDisplay() {
 	_ = d.Display()
 }
 
-// Command sends a single command byte to the display
+// Command sends a single command byte to the display, or queues it during a
+// transaction.
 func (d *display) Command(cmd byte) error {
+	d.pending.Wait()
+	if d.batchDepth > 0 {
+		d.queued = append(d.queued, cmd)
+		d.streaming = false
+		return nil
+	}
 	return d.bus.WriteRegister(d.address, CONTROL_CMD_SINGLE, []byte{cmd})
 }
 
 // CommandStream writes multiple command bytes with a single control prefix.
 func (d *display) CommandStream(cmds ...byte) error {
+	d.pending.Wait()
+	return d.commandStream(cmds...)
+}
+
+// commandStream writes command bytes without waiting for a background flush.
+// During a transaction the commands are queued instead.
+func (d *display) commandStream(cmds ...byte) error {
+	if d.batchDepth > 0 {
+		d.queued = append(d.queued, cmds...)
+		d.streaming = false
+		return nil
+	}
 	return d.bus.WriteRegister(d.address, CONTROL_CMD_STREAM, cmds)
 }
 
 // Display flushes the full backbuffer to the panel using horizontal addressing.
+// During a transaction the frame is queued.
 func (d *display) Display() error {
-	// Set addressing window to full screen.
+	d.pending.Wait()
+	return d.flush(d.buffer)
+}
+
+// DisplayAsync snapshots the backbuffer and flushes it through Config.Transfer,
+// or from a goroutine without it, so the next frame can be drawn while the
+// previous one is still streaming. done receives the result of this frame.
+// A second call waits for the previous flush to finish before taking its snapshot.
+// During a transaction the frame is queued and done receives the result of End.
+func (d *display) DisplayAsync(done func(error)) {
+	d.pending.Wait()
+
+	if d.batchDepth > 0 {
+		_ = d.flush(d.buffer)
+		if done != nil {
+			d.batchDone = append(d.batchDone, done)
+		}
+		return
+	}
+	d.startFlush(done)
+}
+
+// startFlush snapshots the backbuffer and transfers it with the transfer hook,
+// falling back to a goroutine. The caller must ensure no other flush is in flight.
+func (d *display) startFlush(done func(error)) {
+	if d.txBuf == nil {
+		d.txBuf = make([]byte, 1+d.bufSize)
+		d.txBuf[0] = CONTROL_DATA_STREAM
+	}
+	frame := d.txBuf[1:]
+	copy(frame, d.buffer)
+
+	finish := func(err error) {
+		d.pending.Done()
+		if done != nil {
+			done(err)
+		}
+	}
+	d.pending.Add(1)
+	if d.transfer != nil && d.startTransfer(finish) {
+		return
+	}
+	go func() {
+		finish(d.flush(frame))
+	}()
+}
+
+// startTransfer sends the addressing commands and hands the snapshot in txBuf
+// to the transfer hook, and reports whether the hook took it. A failed
+// addressing write is reported to finish like a failed transfer.
+func (d *display) startTransfer(finish func(error)) bool {
+	if err := d.setWindow(); err != nil {
+		finish(err)
+		return true
+	}
+	return d.transfer(d.address, d.txBuf, finish) == nil
+}
+
+// flush writes a full frame to the panel, retrying after bus errors.
+func (d *display) flush(frame []byte) error {
+	err := d.sendFrame(frame)
+	for attempt := 1; err != nil && attempt <= d.retries; attempt++ {
+		if err = d.recoverPanel(); err == nil {
+			err = d.sendFrame(frame)
+		}
+	}
+	return d.failed(err)
+}
+
+// sendFrame writes a full frame to the panel using horizontal addressing.
+func (d *display) sendFrame(frame []byte) error {
+	if err := d.setWindow(); err != nil {
+		return err
+	}
+	return d.writeData(frame)
+}
+
+// setWindow sets the addressing window to the full screen.
+func (d *display) setWindow() error {
 	addrSeq := d.addrBuf[:6]
 	addrSeq[0] = SET_COLUMN_ADDRESS
-	addrSeq[1] = 0x00
-	addrSeq[2] = d.width - 1
+	addrSeq[1] = d.columnOffset
+	addrSeq[2] = d.columnOffset + d.width - 1
 	addrSeq[3] = SET_PAGE_ADDRESS
 	addrSeq[4] = 0x00
 	addrSeq[5] = d.pageCount - 1
+	return d.commandStream(addrSeq...)
+}
+
+// writeData streams display data, split into chunks of at most maxChunk bytes.
+// During a transaction a copy of the data is queued instead.
+func (d *display) writeData(data []byte) error {
+	if d.batchDepth > 0 {
+		d.queueData(data)
+		return nil
+	}
+	if d.maxChunk == 0 || len(data) <= d.maxChunk {
+		return d.bus.WriteRegister(d.address, CONTROL_DATA_STREAM, data)
+	}
+
+	for start := 0; start < len(data); start += d.maxChunk {
+		if start > 0 && d.chunkDelay > 0 {
+			time.Sleep(d.chunkDelay)
+		}
+		end := min(start+d.maxChunk, len(data))
+		if err := d.bus.WriteRegister(d.address, CONTROL_DATA_STREAM, data[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// * ----- Bus recovery -----
 
-	// Send addressing commands
-	if err := d.CommandStream(addrSeq...); err != nil {
+// recoverPanel prepares a retry after a bus error: it waits RetryDelay, frees
+// the bus when BusConfig is set and sends the initialization sequence again,
+// since a supply dip may have reset the panel.
+func (d *display) recoverPanel() error {
+	if d.retryDelay > 0 {
+		time.Sleep(d.retryDelay)
+	}
+	if d.busConfig != nil {
+		if err := d.recoverBus(); err != nil {
+			return err
+		}
+	}
+	return d.init()
+}
+
+// recoverBus frees a bus held by a slave that lost clock sync in the middle
+// of a byte: up to 9 SCL pulses shift out the rest of the byte until SDA is
+// released, a STOP condition resets every slave, and the bus is configured
+// again.
+func (d *display) recoverBus() error {
+	scl, sda := d.busConfig.SCL, d.busConfig.SDA
+	sda.Configure(machine.PinConfig{Mode: machine.PinInputPullup})
+	scl.Configure(machine.PinConfig{Mode: machine.PinOutput})
+	scl.High()
+	for range 9 {
+		if sda.Get() {
+			break
+		}
+		scl.Low()
+		time.Sleep(5 * time.Microsecond)
+		scl.High()
+		time.Sleep(5 * time.Microsecond)
+	}
+
+	// STOP: SDA rises while SCL is high
+	sda.Configure(machine.PinConfig{Mode: machine.PinOutput})
+	scl.Low()
+	sda.Low()
+	time.Sleep(5 * time.Microsecond)
+	scl.High()
+	time.Sleep(5 * time.Microsecond)
+	sda.High()
+	time.Sleep(5 * time.Microsecond)
+
+	return d.bus.Configure(*d.busConfig)
+}
+
+// failed wraps the error of a flush that was retried into a *FlushError.
+// Errors without retries configured are returned as they are.
+func (d *display) failed(err error) error {
+	if err == nil || d.retries == 0 {
 		return err
 	}
+	return &FlushError{Attempts: d.retries + 1, Err: err}
+}
+
+// * ----- Transactions -----
+
+// Begin starts a transaction: until the matching End, commands and buffer
+// updates are queued instead of sent. End coalesces them into as few I²C
+// writes as possible: queued commands travel in the same write as the data
+// that follows them, and the pages of a region in a single data stream.
+// Transactions nest; only the outermost End sends.
+func (d *display) Begin() {
+	if d.batchDepth == 0 {
+		d.pending.Wait()
+		if d.batch == nil {
+			d.batch = make([]byte, 0, d.bufSize+len(d.cmdBuf))
+		}
+	}
+	d.batchDepth++
+}
+
+// End finishes a transaction started with Begin and, for the outermost one,
+// sends everything queued since, retrying the whole transaction after bus
+// errors. Returns the bus error of the last attempt; the transaction is
+// dropped after a failure. Without an open transaction, End
+// does nothing.
+func (d *display) End() error {
+	if d.batchDepth == 0 {
+		return nil
+	}
+	d.batchDepth--
+	if d.batchDepth > 0 {
+		return nil
+	}
+
+	// Commands not followed by data go in a final command stream
+	if len(d.queued) > 0 {
+		d.starts = append(d.starts, len(d.batch))
+		d.batch = append(d.batch, CONTROL_CMD_STREAM)
+		d.batch = append(d.batch, d.queued...)
+	}
+
+	err := d.sendBatch()
+	for attempt := 1; err != nil && attempt <= d.retries; attempt++ {
+		if err = d.recoverPanel(); err == nil {
+			err = d.sendBatch()
+		}
+	}
+	err = d.failed(err)
+
+	for _, done := range d.batchDone {
+		done(err)
+	}
+	clear(d.batchDone)
+	d.batchDone = d.batchDone[:0]
+	d.batch, d.starts, d.queued = d.batch[:0], d.starts[:0], d.queued[:0]
+	d.streaming = false
+	return err
+}
 
-	return d.bus.WriteRegister(d.address, CONTROL_DATA_STREAM, d.buffer)
+// sendBatch writes the queued transactions, stopping at the first bus error.
+func (d *display) sendBatch() error {
+	for i, start := range d.starts {
+		end := len(d.batch)
+		if i+1 < len(d.starts) {
+			end = d.starts[i+1]
+		}
+		if i > 0 && d.chunkDelay > 0 {
+			time.Sleep(d.chunkDelay)
+		}
+		if err := d.bus.WriteRegister(d.address, d.batch[start], d.batch[start+1:end]); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-// DisplayRegion updates a rectangular region aligned to page rows.
-// It reduces I²C traffic when drawing incrementally.
+// queueData appends data to the transaction. It extends the open data stream
+// or starts a new write that carries the queued commands first, each with a
+// continuation control byte, and respects maxChunk.
+func (d *display) queueData(data []byte) {
+	for len(data) > 0 {
+		if !d.streaming {
+			d.starts = append(d.starts, len(d.batch))
+			for _, cmd := range d.queued {
+				d.batch = append(d.batch, CONTROL_CMD_SINGLE, cmd)
+			}
+			d.batch = append(d.batch, CONTROL_DATA_STREAM)
+			d.queued = d.queued[:0]
+			d.streaming, d.chunkData = true, 0
+		}
+
+		n := len(data)
+		if d.maxChunk > 0 {
+			n = min(n, d.maxChunk-d.chunkData)
+		}
+		d.batch = append(d.batch, data[:n]...)
+		d.chunkData += n
+		data = data[n:]
+
+		if d.maxChunk > 0 && d.chunkData == d.maxChunk {
+			d.streaming = false
+		}
+	}
+}
+
+// * ----- Partial updates -----
+
+// DisplayRegion updates a rectangular region aligned to page rows, retrying
+// after bus errors. It reduces I²C traffic when drawing incrementally.
+// Regions entirely off the panel send nothing.
 func (d *display) DisplayRegion(x0, y0, x1, y1 int) error {
+	d.pending.Wait()
+
 	if x0 > x1 {
 		x0, x1 = x1, x0
 	}
@@ -207,20 +607,35 @@ func (d *display) DisplayRegion(x0, y0, x1, y1 int) error {
 	if y1 >= int(d.height) {
 		y1 = int(d.height) - 1
 	}
+	if x0 > x1 || y0 > y1 {
+		// Nothing of the region is on the panel
+		return nil
+	}
 
+	err := d.sendRegion(x0, y0, x1, y1)
+	for attempt := 1; err != nil && attempt <= d.retries; attempt++ {
+		if err = d.recoverPanel(); err == nil {
+			err = d.sendRegion(x0, y0, x1, y1)
+		}
+	}
+	return d.failed(err)
+}
+
+// sendRegion writes the clipped region (x0, y0)-(x1, y1) to the panel.
+func (d *display) sendRegion(x0, y0, x1, y1 int) error {
 	startPage := uint8(y0 >> 3)
 	endPage := uint8(y1 >> 3)
 
 	// Setup column/page window
 	addr := d.addrBuf[:6]
 	addr[0] = SET_COLUMN_ADDRESS
-	addr[1] = byte(x0)
-	addr[2] = byte(x1)
+	addr[1] = d.columnOffset + byte(x0)
+	addr[2] = d.columnOffset + byte(x1)
 	addr[3] = SET_PAGE_ADDRESS
 	addr[4] = startPage
 	addr[5] = endPage
 
-	if err := d.CommandStream(addr...); err != nil {
+	if err := d.commandStream(addr...); err != nil {
 		return err
 	}
 
@@ -229,7 +644,7 @@ func (d *display) DisplayRegion(x0, y0, x1, y1 int) error {
 		rowOffset := page * d.stride
 		start := rowOffset + x0
 		end := rowOffset + x1 + 1
-		if err := d.bus.WriteRegister(d.address, CONTROL_DATA_STREAM, d.buffer[start:end]); err != nil {
+		if err := d.writeData(d.buffer[start:end]); err != nil {
 			return err
 		}
 	}
@@ -255,8 +670,8 @@ func (d *display) SetPixel(x, y int16, color bool) {
 }
 
 // GetPixel returns the current pixel state from the backbuffer.
-func (d *display) GetPixel(x, y uint8) bool {
-	if x < 0 || y < 0 || x >= d.width || y >= d.height {
+func (d *display) GetPixel(x, y int16) bool {
+	if x < 0 || y < 0 || x >= int16(d.width) || y >= int16(d.height) {
 		return false
 	}
 
@@ -265,3 +680,86 @@ func (d *display) GetPixel(x, y uint8) bool {
 
 	return (d.buffer[byteIndex] & bitMask) != 0
 }
+
+// * ----- Power management -----
+
+// Sleep turns the panel off and, with the internal charge pump, disables the
+// pump to minimize the current draw. The buffer and panel RAM are kept.
+func (d *display) Sleep() error {
+	d.pending.Wait()
+
+	d.asleep = true
+	cmdSeq := append(d.cmdBuf[:0], SET_DISPLAY_OFF)
+	if d.chargePump {
+		cmdSeq = append(cmdSeq, CHARGE_PUMP_SETTING, CHARGE_PUMP_SETTING_OFF)
+	}
+	return d.commandStream(cmdSeq...)
+}
+
+// Wake re-enables the charge pump (if used) and turns the panel back on.
+func (d *display) Wake() error {
+	d.pending.Wait()
+
+	d.asleep = false
+	cmdSeq := d.cmdBuf[:0]
+	if d.chargePump {
+		cmdSeq = append(cmdSeq, CHARGE_PUMP_SETTING, CHARGE_PUMP_SETTING_ON)
+	}
+	cmdSeq = append(cmdSeq, SET_DISPLAY_ON)
+	return d.commandStream(cmdSeq...)
+}
+
+// SetBrightness sets the contrast register, which controls the panel brightness.
+func (d *display) SetBrightness(level uint8) error {
+	d.pending.Wait()
+
+	d.contrast = level
+	cmdSeq := append(d.cmdBuf[:0], SET_CONTRAST, level)
+	return d.commandStream(cmdSeq...)
+}
+
+// * ----- Fast paths -----
+
+// DrawHSpan sets or clears a horizontal run of pixels starting at (x, y).
+// It writes the page bytes directly, avoiding a SetPixel call per pixel.
+// Out-of-bounds parts of the span are clipped.
+func (d *display) DrawHSpan(x, y, length int16, on bool) {
+	d.frame().HSpan(int(x), int(y), int(length), on)
+}
+
+// FillRect sets or clears a rectangle with its top-left corner at (x, y).
+// Whole pages are written byte by byte and partial pages with a bit mask,
+// which makes large fills and clears much faster than per-pixel drawing.
+// Out-of-bounds parts of the rectangle are clipped.
+func (d *display) FillRect(x, y, width, height int16, on bool) {
+	d.frame().FillRect(int(x), int(y), int(width), int(height), on)
+}
+
+// BufferInfo describes the display buffer so T8Go can draw into it directly
+func (d *display) BufferInfo() t8go.BufferInfo {
+	return t8go.BufferInfo{
+		Data:   d.buffer,
+		Width:  uint16(d.width),
+		Height: uint16(d.height),
+		Stride: d.stride,
+		Layout: t8go.LayoutPageMajor,
+	}
+}
+
+// Capabilities describes the SSD1306: a monochrome panel with page-aligned
+// partial updates (DisplayRegion) and hardware scrolling.
+func (d *display) Capabilities() t8go.Capabilities {
+	return t8go.Capabilities{
+		PartialUpdate:  true,
+		GrayscaleBits:  1,
+		HardwareScroll: true,
+		MaxTransfer:    d.maxChunk,
+		PixelPitchX:    d.pitch,
+		PixelPitchY:    d.pitch,
+	}
+}
+
+// frame returns a framebuf view of the backbuffer.
+func (d *display) frame() framebuf.Buffer {
+	return framebuf.Buffer{Data: d.buffer, Width: int(d.width), Height: int(d.height)}
+}
@@ -0,0 +1,53 @@
+package uart
+
+import (
+	"errors"
+
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/record"
+)
+
+// Config holds the configuration parameters for a UART display.
+type Config struct {
+	Width  uint16 // Display width in pixels (must be > 0)
+	Height uint16 // Display height in pixels (must be > 0)
+
+	// MaxPacket is the largest packet body in bytes (default: 256, at least
+	// 32). Larger updates are split, so the receiver needs no more than a
+	// MaxPacket buffer; it must be configured with the same value.
+	MaxPacket int
+}
+
+// IRecordingSender is implemented by UART displays to send drawing commands
+// instead of pixels. The receiver replays them on its own buffer, which
+// takes far fewer bytes than a frame for sparse screens. Get it with a type
+// assertion on the display returned by New.
+type IRecordingSender interface {
+	SendRecording(rec *record.Recording) error // SendRecording sends the commands of rec and shows the result
+}
+
+// Receiver applies the packets of a UART display to a local drawing context,
+// on the board (or PC) driving the physical panel. It implements io.Writer,
+// so received bytes can be fed with Write or io.Copy.
+//
+// Damaged packets are dropped and counted: the receiver resynchronizes at
+// the next packet delimiter, so a noisy line only loses the affected updates.
+type Receiver struct {
+	ctx       t8go.IDisplayDrawer // Context the packets are applied to
+	maxPacket int                 // Largest accepted packet body
+	encoded   []byte              // COBS bytes of the packet being received
+	decoded   []byte              // Decoded packet of the last delimiter
+	overflow  bool                // Whether the packet being received is too long
+	dirty     t8go.Rect           // Area changed since the last show packet
+	recording record.Recording    // Commands of the last draw packet
+	shown     uint32              // Show packets applied
+	dropped   uint32              // Damaged or unknown packets
+}
+
+// Common errors returned by the UART driver.
+var (
+	ErrInvalidDimensions = errors.New("invalid display dimensions")       // Width or height is zero
+	ErrInvalidPacketSize = errors.New("maximum packet size is too small") // Config.MaxPacket is below 32
+	ErrNilWriter         = errors.New("writer cannot be nil")             // Nil writer passed to New
+	ErrCommandTooLarge   = errors.New("drawing command exceeds a packet") // A recorded command does not fit in MaxPacket
+)
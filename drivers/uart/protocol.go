@@ -0,0 +1,251 @@
+package uart
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/framebuf"
+)
+
+// Packets are COBS-encoded and end with a zero byte, the only zero on the
+// line, so a receiver finds the next packet after any corruption. Decoded,
+// a packet is a type byte, a body and a CRC-16/CCITT of both (little-endian).
+// Integers in bodies are little-endian uint16.
+//
+//	type  body
+//	'R'   x, page, width, pages, then pages*width bytes in the page layout:
+//	      a block of the buffer, drawn but not yet shown
+//	'S'   width, height of the sender: show the blocks drawn since the last 'S'
+//	'D'   a record.Recording (MarshalBinary): replay its commands
+//	'C'   controller command bytes, passed to Command
+//	'P'   power operation: 0 sleep, 1 wake, 2 brightness followed by the level
+const (
+	msgRegion  = 'R'
+	msgShow    = 'S'
+	msgDraw    = 'D'
+	msgCommand = 'C'
+	msgPower   = 'P'
+
+	powerSleep      = 0
+	powerWake       = 1
+	powerBrightness = 2
+
+	regionHeader     = 8   // Size of the four region fields
+	defaultMaxPacket = 256 // Default Config.MaxPacket
+	minPacket        = 32  // Smallest Config.MaxPacket
+)
+
+// checksum returns the CRC-16/CCITT-FALSE of data.
+func checksum(data []byte) uint16 {
+	crc := uint16(0xFFFF)
+	for _, value := range data {
+		crc ^= uint16(value) << 8
+		for range 8 {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+// appendPacket appends packet with its checksum to dst, COBS-encoded and
+// followed by the zero delimiter.
+func appendPacket(dst, packet []byte) []byte {
+	var crc [2]byte
+	binary.LittleEndian.PutUint16(crc[:], checksum(packet))
+
+	code := len(dst)
+	dst = append(dst, 0) // Replaced by the length of the first block
+	for _, value := range [2][]byte{packet, crc[:]} {
+		for _, b := range value {
+			if b != 0 {
+				dst = append(dst, b)
+			}
+			if b == 0 || len(dst)-code == 255 {
+				dst[code] = byte(len(dst) - code)
+				code = len(dst)
+				dst = append(dst, 0)
+			}
+		}
+	}
+	dst[code] = byte(len(dst) - code)
+	return append(dst, 0)
+}
+
+// decodeCOBS decodes a COBS block (without its delimiter) into dst and
+// reports whether it was well formed.
+func decodeCOBS(dst, src []byte) ([]byte, bool) {
+	for i := 0; i < len(src); {
+		code := int(src[i])
+		if code == 0 || i+code > len(src) {
+			return dst, false
+		}
+		dst = append(dst, src[i+1:i+code]...)
+		i += code
+		if code < 255 && i < len(src) {
+			dst = append(dst, 0)
+		}
+	}
+	return dst, true
+}
+
+// * ----- Receiver -----
+
+// NewReceiver creates a receiver that draws on ctx, accepting packet bodies
+// up to maxPacket bytes (0 for the default of 256), like the sender's
+// Config.MaxPacket.
+func NewReceiver(ctx t8go.IDisplayDrawer, maxPacket int) *Receiver {
+	if maxPacket <= 0 {
+		maxPacket = defaultMaxPacket
+	}
+	limit := maxPacket + 3 // Type byte and checksum
+	limit += limit/254 + 1 // COBS overhead
+	return &Receiver{
+		ctx:       ctx,
+		maxPacket: maxPacket,
+		encoded:   make([]byte, 0, limit),
+		decoded:   make([]byte, 0, limit),
+	}
+}
+
+// Write decodes the packets in data and applies them, keeping a partial
+// packet for the next call. It always consumes all of data; the error is the
+// first one returned by the context while showing or sending commands.
+func (r *Receiver) Write(data []byte) (int, error) {
+	var first error
+	for _, b := range data {
+		if b != 0 {
+			if len(r.encoded) == cap(r.encoded) {
+				r.overflow = true
+			} else {
+				r.encoded = append(r.encoded, b)
+			}
+			continue
+		}
+
+		if err := r.receive(); err != nil && first == nil {
+			first = err
+		}
+		r.encoded, r.overflow = r.encoded[:0], false
+	}
+	return len(data), first
+}
+
+// Shown returns the number of frames shown, one per show packet.
+func (r *Receiver) Shown() uint32 {
+	return r.shown
+}
+
+// Dropped returns the number of damaged, oversized or unknown packets.
+func (r *Receiver) Dropped() uint32 {
+	return r.dropped
+}
+
+// receive checks the packet ended by a delimiter and applies it.
+func (r *Receiver) receive() error {
+	if len(r.encoded) == 0 {
+		return nil // Repeated delimiters resynchronize the line
+	}
+
+	var ok bool
+	r.decoded, ok = decodeCOBS(r.decoded[:0], r.encoded)
+	size := len(r.decoded) - 2
+	if r.overflow || !ok || size < 1 || size-1 > r.maxPacket ||
+		checksum(r.decoded[:size]) != binary.LittleEndian.Uint16(r.decoded[size:]) {
+		r.dropped++
+		return nil
+	}
+
+	body := r.decoded[1:size]
+	switch r.decoded[0] {
+	case msgRegion:
+		r.region(body)
+	case msgShow:
+		return r.show()
+	case msgDraw:
+		if err := r.recording.UnmarshalBinary(body); err != nil {
+			r.dropped++
+			return nil
+		}
+		r.dirty = r.ctx.Bounds()
+		if err := r.recording.Replay(r.ctx); err != nil {
+			r.dropped++
+		}
+	case msgCommand:
+		for _, cmd := range body {
+			if err := r.ctx.Command(cmd); err != nil {
+				return err
+			}
+		}
+	case msgPower:
+		return r.power(body)
+	default:
+		r.dropped++
+	}
+	return nil
+}
+
+// region draws a block of the sender's buffer.
+func (r *Receiver) region(body []byte) {
+	if len(body) < regionHeader {
+		r.dropped++
+		return
+	}
+	x := int(binary.LittleEndian.Uint16(body))
+	page := int(binary.LittleEndian.Uint16(body[2:]))
+	width := int(binary.LittleEndian.Uint16(body[4:]))
+	pages := int(binary.LittleEndian.Uint16(body[6:]))
+	data := body[regionHeader:]
+	if width == 0 || pages == 0 || len(data) != width*pages {
+		r.dropped++
+		return
+	}
+
+	block := framebuf.Buffer{Data: data, Width: width, Height: pages * 8}
+	r.ctx.DrawBuffer(int16(x), int16(page*8), block)
+	area := t8go.Rect{X: int16(x), Y: int16(page * 8), Width: int16(width), Height: int16(pages * 8)}
+	r.dirty = r.dirty.Union(area.Intersect(r.ctx.Bounds()))
+}
+
+// show sends the area changed since the last show packet to the panel.
+func (r *Receiver) show() error {
+	r.shown++
+	if r.dirty.Empty() {
+		return nil
+	}
+	region := r.dirty
+	r.dirty = t8go.Rect{}
+	return r.ctx.FlushRegion(region)
+}
+
+// power applies a power operation.
+func (r *Receiver) power(body []byte) error {
+	if len(body) == 0 {
+		r.dropped++
+		return nil
+	}
+	var err error
+	switch body[0] {
+	case powerSleep:
+		err = r.ctx.Sleep()
+	case powerWake:
+		err = r.ctx.Wake()
+	case powerBrightness:
+		if len(body) < 2 {
+			r.dropped++
+			return nil
+		}
+		err = r.ctx.SetBrightness(body[1])
+	default:
+		r.dropped++
+		return nil
+	}
+	if errors.Is(err, t8go.ErrUnsupported) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,276 @@
+// Package uart provides a display driver for a panel attached to another
+// board: frames, or drawing commands, are sent over a UART (or any
+// io.Writer) to a second MCU or a PC that acts as the physical display.
+//
+// The protocol (see protocol.go) frames every packet with COBS and a
+// CRC-16, so the receiving side resynchronizes after line noise instead of
+// drawing garbage. Display sends the whole buffer and FlushRegion only the
+// changed pages; SendRecording (IRecordingSender) sends drawing commands for
+// the receiver to rasterize itself. Command, Sleep, Wake and SetBrightness
+// are forwarded to the receiving panel.
+//
+// On the receiving side, a Receiver applies the packets to a t8go context
+// that drives the real panel:
+//
+//	receiver := uart.NewReceiver(gfx, 0)
+//	for {
+//		n, _ := machine.DefaultUART.Read(chunk)
+//		receiver.Write(chunk[:n])
+//	}
+package uart
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/framebuf"
+	"github.com/redghc/t8go/record"
+)
+
+// display implements the t8go.Display interface by sending its buffer.
+type display struct {
+	writer io.Writer // UART or other transport receiving the packets
+
+	width     uint16 // Display width in pixels
+	height    uint16 // Display height in pixels
+	buffer    []byte // Display buffer (SSD1306-style page layout)
+	bufSize   int    // Buffer size in bytes
+	maxPacket int    // Largest packet body in bytes
+
+	body   []byte // Packet being built, reused between packets
+	packet []byte // Encoded packet, reused between packets
+}
+
+var (
+	_ t8go.IDisplay    = &display{}
+	_ t8go.ISpanDrawer = &display{}
+	_ t8go.IBufferInfo = &display{}
+
+	_ t8go.IRegionFlusher = &display{}
+	_ t8go.IPowerManager  = &display{}
+	_ t8go.ICapabilities  = &display{}
+	_ IRecordingSender    = &display{}
+)
+
+// New creates a UART display that writes its packets to w, one Write call
+// per packet.
+func New(w io.Writer, config Config) (t8go.IDisplay, error) {
+	if w == nil {
+		return nil, ErrNilWriter
+	}
+	if config.Width == 0 || config.Height == 0 {
+		return nil, ErrInvalidDimensions
+	}
+	if config.MaxPacket == 0 {
+		config.MaxPacket = defaultMaxPacket
+	}
+	if config.MaxPacket < minPacket {
+		return nil, ErrInvalidPacketSize
+	}
+
+	bufSize := framebuf.Size(int(config.Width), int(config.Height))
+	packetSize := config.MaxPacket + 3 // Type byte and checksum
+
+	d := &display{
+		writer:    w,
+		width:     config.Width,
+		height:    config.Height,
+		buffer:    make([]byte, bufSize),
+		bufSize:   bufSize,
+		maxPacket: config.MaxPacket,
+		body:      make([]byte, 0, packetSize),
+		packet:    make([]byte, 0, packetSize+packetSize/254+2),
+	}
+
+	return d, nil
+}
+
+// * ----- Display methods -----
+
+// Size returns the display dimensions
+func (d *display) Size() (width, height uint16) {
+	return d.width, d.height
+}
+
+// BufferSize returns the size of the display buffer
+func (d *display) BufferSize() int {
+	return d.bufSize
+}
+
+// Buffer returns the display buffer
+func (d *display) Buffer() []byte {
+	return d.buffer
+}
+
+// ClearBuffer clears the display buffer
+func (d *display) ClearBuffer() {
+	clear(d.buffer)
+}
+
+// ClearDisplay clears the buffer and sends the empty frame
+func (d *display) ClearDisplay() {
+	d.ClearBuffer()
+	_ = d.Display()
+}
+
+// Command forwards a controller command to the receiving panel
+func (d *display) Command(cmd byte) error {
+	return d.send(append(d.body[:0], msgCommand, cmd))
+}
+
+// Display sends the whole buffer and shows it
+func (d *display) Display() error {
+	return d.DisplayRegion(0, 0, int(d.width)-1, int(d.height)-1)
+}
+
+// DisplayRegion sends the pages covering the inclusive box (x0, y0)-(x1, y1)
+// and shows them. Regions wider than a packet are split into several.
+func (d *display) DisplayRegion(x0, y0, x1, y1 int) error {
+	x0, y0 = max(x0, 0), max(y0, 0)
+	x1, y1 = min(x1, int(d.width)-1), min(y1, int(d.height)-1)
+	if x0 <= x1 && y0 <= y1 {
+		width, lastPage := x1-x0+1, y1/8
+		room := d.maxPacket - regionHeader
+		for page := y0 / 8; page <= lastPage; {
+			if width <= room {
+				pages := min(room/width, lastPage-page+1)
+				if err := d.sendRegion(x0, page, width, pages); err != nil {
+					return err
+				}
+				page += pages
+				continue
+			}
+			for x := x0; x <= x1; x += room {
+				if err := d.sendRegion(x, page, min(room, x1-x+1), 1); err != nil {
+					return err
+				}
+			}
+			page++
+		}
+	}
+	return d.sendShow()
+}
+
+// SendRecording sends the commands of rec for the receiver to draw on its
+// buffer, then shows the result. Long recordings are split into several
+// packets between commands. The local buffer is left unchanged.
+func (d *display) SendRecording(rec *record.Recording) error {
+	data, err := rec.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	header := data[:4] // Format header, repeated in every packet
+	commands := data[4:]
+	for len(commands) > 0 {
+		body := append(d.body[:0], msgDraw)
+		body = append(body, header...)
+		for len(commands) > 0 {
+			size := 1 + 2*record.Op(commands[0]).ArgCount()
+			if len(body)-1+size > d.maxPacket {
+				break
+			}
+			body = append(body, commands[:size]...)
+			commands = commands[size:]
+		}
+		if len(body) == 1+len(header) {
+			return ErrCommandTooLarge
+		}
+		if err := d.send(body); err != nil {
+			return err
+		}
+	}
+	return d.sendShow()
+}
+
+// SetPixel sets a pixel at the given coordinates
+// Out-of-bounds are safely ignored.
+func (d *display) SetPixel(x, y int16, color bool) {
+	d.frame().SetPixel(int(x), int(y), color)
+}
+
+// GetPixel returns the current pixel state from the buffer
+func (d *display) GetPixel(x, y int16) bool {
+	return d.frame().GetPixel(int(x), int(y))
+}
+
+// * ----- Power -----
+
+// Sleep turns the receiving panel off
+func (d *display) Sleep() error {
+	return d.send(append(d.body[:0], msgPower, powerSleep))
+}
+
+// Wake turns the receiving panel back on
+func (d *display) Wake() error {
+	return d.send(append(d.body[:0], msgPower, powerWake))
+}
+
+// SetBrightness sets the brightness of the receiving panel
+func (d *display) SetBrightness(level uint8) error {
+	return d.send(append(d.body[:0], msgPower, powerBrightness, level))
+}
+
+// * ----- Fast paths -----
+
+// DrawHSpan sets or clears a horizontal run of pixels starting at (x, y)
+func (d *display) DrawHSpan(x, y, length int16, on bool) {
+	d.frame().HSpan(int(x), int(y), int(length), on)
+}
+
+// FillRect sets or clears a rectangle with its top-left corner at (x, y)
+func (d *display) FillRect(x, y, width, height int16, on bool) {
+	d.frame().FillRect(int(x), int(y), int(width), int(height), on)
+}
+
+// BufferInfo describes the display buffer so T8Go can draw into it directly
+func (d *display) BufferInfo() t8go.BufferInfo {
+	return t8go.BufferInfo{
+		Data:   d.buffer,
+		Width:  d.width,
+		Height: d.height,
+		Stride: int(d.width),
+		Layout: t8go.LayoutPageMajor,
+	}
+}
+
+// Capabilities reports region flushes, limited to one packet per transfer
+func (d *display) Capabilities() t8go.Capabilities {
+	return t8go.Capabilities{PartialUpdate: true, GrayscaleBits: 1, MaxTransfer: d.maxPacket}
+}
+
+// * ----- Packets -----
+
+// sendRegion sends a block of whole pages of the buffer.
+func (d *display) sendRegion(x, page, width, pages int) error {
+	body := append(d.body[:0], msgRegion)
+	for _, value := range [4]int{x, page, width, pages} {
+		body = binary.LittleEndian.AppendUint16(body, uint16(value))
+	}
+	for row := page; row < page+pages; row++ {
+		start := row*int(d.width) + x
+		body = append(body, d.buffer[start:start+width]...)
+	}
+	return d.send(body)
+}
+
+// sendShow sends a show packet with the display size.
+func (d *display) sendShow() error {
+	body := append(d.body[:0], msgShow)
+	body = binary.LittleEndian.AppendUint16(body, d.width)
+	body = binary.LittleEndian.AppendUint16(body, d.height)
+	return d.send(body)
+}
+
+// send writes a packet with a single Write call.
+func (d *display) send(body []byte) error {
+	d.packet = appendPacket(d.packet[:0], body)
+	_, err := d.writer.Write(d.packet)
+	return err
+}
+
+// frame returns a framebuf view of the display buffer
+func (d *display) frame() framebuf.Buffer {
+	return framebuf.Buffer{Data: d.buffer, Width: int(d.width), Height: int(d.height)}
+}
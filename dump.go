@@ -0,0 +1,102 @@
+package t8go
+
+import (
+	"io"
+	"unicode/utf8"
+
+	"github.com/redghc/t8go/bmp"
+)
+
+// brailleDots maps a pixel offset (x 0..1, y 0..3) inside a braille cell to
+// its dot bit (Unicode braille patterns start at U+2800).
+var brailleDots = [4][2]byte{
+	{0x01, 0x08},
+	{0x02, 0x10},
+	{0x04, 0x20},
+	{0x40, 0x80},
+}
+
+// DumpASCII writes the buffer to w as text, one line per pixel row, with '#'
+// for pixels that are on and '.' for pixels that are off. It is meant for
+// failing tests and serial debug logs.
+func (t *T8Go) DumpASCII(w io.Writer) error {
+	return t.dumpASCII(w, t.Bounds())
+}
+
+// DumpBraille writes the buffer to w using Unicode braille characters, each
+// showing a 2x4 pixel cell, so a 128x64 display fits in 64x16 characters.
+func (t *T8Go) DumpBraille(w io.Writer) error {
+	return t.dumpBraille(w, t.Bounds())
+}
+
+// ExportRegion writes the pixels inside region to w in the given format, so
+// tests and tools can capture a single widget and keep golden images small
+// and unaffected by the rest of the screen. The region is clipped to the
+// display and the output starts at its top-left corner. ErrEmptyRegion is
+// returned when nothing of the region is on the display.
+func (t *T8Go) ExportRegion(region Rect, w io.Writer, format ExportFormat) error {
+	region = region.Intersect(t.Bounds())
+	if region.Empty() {
+		return ErrEmptyRegion
+	}
+
+	switch format {
+	case ExportASCII:
+		return t.dumpASCII(w, region)
+	case ExportBraille:
+		return t.dumpBraille(w, region)
+	case ExportBMP:
+		return bmp.Encode(w, int(region.Width), int(region.Height), func(x, y int) bool {
+			return t.GetPixel(region.X+int16(x), region.Y+int16(y))
+		})
+	default:
+		return ErrExportFormat
+	}
+}
+
+// dumpASCII writes the pixels of region in the DumpASCII format.
+func (t *T8Go) dumpASCII(w io.Writer, region Rect) error {
+	line := make([]byte, int(region.Width)+1)
+	line[region.Width] = '\n'
+
+	for y := range region.Height {
+		for x := range region.Width {
+			if t.GetPixel(region.X+x, region.Y+y) {
+				line[x] = '#'
+			} else {
+				line[x] = '.'
+			}
+		}
+		if _, err := w.Write(line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dumpBraille writes the pixels of region in the DumpBraille format. Cells
+// reaching past the region show its pixels only.
+func (t *T8Go) dumpBraille(w io.Writer, region Rect) error {
+	columns := (int(region.Width) + 1) / 2
+	line := make([]byte, 0, columns*utf8.UTFMax+1)
+
+	for cellY := int16(0); cellY < region.Height; cellY += 4 {
+		line = line[:0]
+		for cellX := int16(0); cellX < region.Width; cellX += 2 {
+			var dots rune
+			for dy := range min(4, region.Height-cellY) {
+				for dx := range min(2, region.Width-cellX) {
+					if t.GetPixel(region.X+cellX+dx, region.Y+cellY+dy) {
+						dots |= rune(brailleDots[dy][dx])
+					}
+				}
+			}
+			line = utf8.AppendRune(line, 0x2800+dots)
+		}
+		line = append(line, '\n')
+		if _, err := w.Write(line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,12 @@
+package t8go
+
+import "errors"
+
+// Common errors returned or recorded (see T8Go.Err) by the graphics context.
+var (
+	ErrStateUnderflow     = errors.New("PopState without matching PushState")         // PopState called with an empty state stack
+	ErrTransformUnderflow = errors.New("PopTransform without matching PushTransform") // PopTransform called with an empty transform stack
+	ErrUnsupported        = errors.New("operation not supported")                     // Driver lacks the optional interface for the operation, or the t8go_minimal build left it out
+	ErrExportFormat       = errors.New("unknown export format")                       // ExportRegion called with an unknown ExportFormat
+	ErrEmptyRegion        = errors.New("region outside the display")                  // ExportRegion called with a region not overlapping the display
+)
@@ -0,0 +1,25 @@
+package t8go
+
+// PixelFilter rewrites every pixel written by drawing calls, for global
+// effects such as scanlines or masking to the window of a round bezel. It
+// receives the pixel and the state being drawn and returns the state to
+// write and whether to write the pixel at all.
+type PixelFilter func(x, y int16, on bool) (result bool, write bool)
+
+// SetPixelFilter installs filter on every pixel written by drawing calls,
+// including SetPixel, ClearRegion and DrawBuffer, but not ClearBuffer. While
+// a filter is installed the span and direct buffer fast paths are bypassed,
+// so drawing is slower. Pass nil to remove it; an unset filter costs a
+// single nil check per pixel or span. t8go_minimal builds compile the hook
+// out entirely and record ErrUnsupported (see Err) when a filter is set.
+func (t *T8Go) SetPixelFilter(filter PixelFilter) {
+	if !filterEnabled && filter != nil {
+		t.setErr(ErrUnsupported)
+	}
+	t.filter = filter
+}
+
+// filtered reports whether pixels must go through the pixel filter.
+func (t *T8Go) filtered() bool {
+	return filterEnabled && t.filter != nil
+}
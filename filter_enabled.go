@@ -0,0 +1,5 @@
+//go:build !t8go_minimal
+
+package t8go
+
+const filterEnabled = true // Pixels are passed to the filter set with SetPixelFilter
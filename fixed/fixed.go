@@ -0,0 +1,180 @@
+// Package fixed provides Q8.8 and Q16.16 fixed-point numbers for animation,
+// scaling and rotation math, so t8go never needs floating point on FPU-less
+// microcontrollers. All operations use integer arithmetic with a wider
+// intermediate type and truncate towards negative infinity unless noted.
+package fixed
+
+// Q8 is a signed Q8.8 fixed-point number: 8 integer bits and 8 fractional bits
+// (range -128..127.996, resolution 1/256).
+type Q8 int16
+
+// Q16 is a signed Q16.16 fixed-point number: 16 integer bits and 16 fractional
+// bits (range -32768..32767.99998, resolution 1/65536).
+type Q16 int32
+
+// Fractional bits and the value 1.0 of each format.
+const (
+	Q8Shift  = 8
+	Q16Shift = 16
+
+	Q8One  Q8  = 1 << Q8Shift
+	Q16One Q16 = 1 << Q16Shift
+)
+
+// * ----- Q8.8 -----
+
+// Q8FromInt converts an integer to Q8.8.
+func Q8FromInt(value int16) Q8 {
+	return Q8(value << Q8Shift)
+}
+
+// Q8FromRatio returns numerator/denominator as Q8.8.
+// A zero denominator returns 0.
+func Q8FromRatio(numerator, denominator int16) Q8 {
+	if denominator == 0 {
+		return 0
+	}
+	return Q8(int32(numerator) << Q8Shift / int32(denominator))
+}
+
+// Int returns the integer part of q, rounded towards negative infinity.
+func (q Q8) Int() int16 {
+	return int16(q >> Q8Shift)
+}
+
+// Round returns q rounded to the nearest integer (halves rounded up).
+func (q Q8) Round() int16 {
+	return int16((int32(q) + 1<<(Q8Shift-1)) >> Q8Shift)
+}
+
+// Frac returns the fractional part of q (always in 0..Q8One-1).
+func (q Q8) Frac() Q8 {
+	return q & (Q8One - 1)
+}
+
+// Mul returns q * r.
+func (q Q8) Mul(r Q8) Q8 {
+	return Q8(int32(q) * int32(r) >> Q8Shift)
+}
+
+// Div returns q / r. Division by zero saturates to the largest value with the sign of q.
+func (q Q8) Div(r Q8) Q8 {
+	if r == 0 {
+		if q < 0 {
+			return -1 << 15
+		}
+		return 1<<15 - 1
+	}
+	return Q8(int32(q) << Q8Shift / int32(r))
+}
+
+// Lerp interpolates between q (t = 0) and to (t = Q8One).
+// Values of t outside 0..Q8One extrapolate.
+func (q Q8) Lerp(to Q8, t Q8) Q8 {
+	return Q8(int32(q) + (int32(to)-int32(q))*int32(t)>>Q8Shift)
+}
+
+// Sqrt returns the square root of q. Negative values return 0.
+func (q Q8) Sqrt() Q8 {
+	if q <= 0 {
+		return 0
+	}
+	return Q8(isqrt(uint64(q) << Q8Shift))
+}
+
+// Q16 converts q to Q16.16 without loss.
+func (q Q8) Q16() Q16 {
+	return Q16(int32(q) << (Q16Shift - Q8Shift))
+}
+
+// * ----- Q16.16 -----
+
+// Q16FromInt converts an integer to Q16.16.
+func Q16FromInt(value int16) Q16 {
+	return Q16(int32(value) << Q16Shift)
+}
+
+// Q16FromRatio returns numerator/denominator as Q16.16.
+// A zero denominator returns 0.
+func Q16FromRatio(numerator, denominator int32) Q16 {
+	if denominator == 0 {
+		return 0
+	}
+	return Q16(int64(numerator) << Q16Shift / int64(denominator))
+}
+
+// Int returns the integer part of q, rounded towards negative infinity.
+func (q Q16) Int() int16 {
+	return int16(q >> Q16Shift)
+}
+
+// Round returns q rounded to the nearest integer (halves rounded up).
+func (q Q16) Round() int16 {
+	return int16((int64(q) + 1<<(Q16Shift-1)) >> Q16Shift)
+}
+
+// Frac returns the fractional part of q (always in 0..Q16One-1).
+func (q Q16) Frac() Q16 {
+	return q & (Q16One - 1)
+}
+
+// Mul returns q * r.
+func (q Q16) Mul(r Q16) Q16 {
+	return Q16(int64(q) * int64(r) >> Q16Shift)
+}
+
+// MulInt returns q * value without converting value to fixed point first.
+func (q Q16) MulInt(value int32) Q16 {
+	return Q16(int64(q) * int64(value))
+}
+
+// Div returns q / r. Division by zero saturates to the largest value with the sign of q.
+func (q Q16) Div(r Q16) Q16 {
+	if r == 0 {
+		if q < 0 {
+			return -1 << 31
+		}
+		return 1<<31 - 1
+	}
+	return Q16(int64(q) << Q16Shift / int64(r))
+}
+
+// Lerp interpolates between q (t = 0) and to (t = Q16One).
+// Values of t outside 0..Q16One extrapolate.
+func (q Q16) Lerp(to Q16, t Q16) Q16 {
+	return Q16(int64(q) + (int64(to)-int64(q))*int64(t)>>Q16Shift)
+}
+
+// Sqrt returns the square root of q. Negative values return 0.
+func (q Q16) Sqrt() Q16 {
+	if q <= 0 {
+		return 0
+	}
+	return Q16(isqrt(uint64(q) << Q16Shift))
+}
+
+// Q8 converts q to Q8.8, dropping the extra fractional bits.
+// Values outside the Q8.8 range wrap.
+func (q Q16) Q8() Q8 {
+	return Q8(q >> (Q16Shift - Q8Shift))
+}
+
+// isqrt returns floor(sqrt(value)) using the binary digit-by-digit method.
+func isqrt(value uint64) uint64 {
+	var result uint64
+	bit := uint64(1) << 62
+	for bit > value {
+		bit >>= 2
+	}
+
+	for bit != 0 {
+		if value >= result+bit {
+			value -= result + bit
+			result = result>>1 + bit
+		} else {
+			result >>= 1
+		}
+		bit >>= 2
+	}
+	return result
+}
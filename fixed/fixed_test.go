@@ -0,0 +1,154 @@
+package fixed_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/redghc/t8go/fixed"
+)
+
+func TestDivSaturates(t *testing.T) {
+	tests := []struct {
+		name string
+		got  int64
+		want int64
+	}{
+		{"Q8 positive by zero", int64(fixed.Q8One.Div(0)), math.MaxInt16},
+		{"Q8 zero by zero", int64(fixed.Q8(0).Div(0)), math.MaxInt16},
+		{"Q8 negative by zero", int64((-fixed.Q8One).Div(0)), math.MinInt16},
+		{"Q8 regular", int64((3 * fixed.Q8One).Div(2 * fixed.Q8One)), int64(fixed.Q8One * 3 / 2)},
+		{"Q16 positive by zero", int64(fixed.Q16One.Div(0)), math.MaxInt32},
+		{"Q16 zero by zero", int64(fixed.Q16(0).Div(0)), math.MaxInt32},
+		{"Q16 negative by zero", int64((-fixed.Q16One).Div(0)), math.MinInt32},
+		{"Q16 regular", int64((3 * fixed.Q16One).Div(2 * fixed.Q16One)), int64(fixed.Q16One * 3 / 2)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %d, want %d", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQ8IntAndRound(t *testing.T) {
+	tests := []struct {
+		value fixed.Q8
+		int   int16
+		round int16
+	}{
+		{0, 0, 0},
+		{fixed.Q8One / 2, 0, 1},
+		{-1, -1, 0},
+		{-fixed.Q8One / 2, -1, 0},
+		{-fixed.Q8One, -1, -1},
+		{-fixed.Q8One * 3 / 2, -2, -1},
+		{-fixed.Q8One*3/2 - 1, -2, -2},
+		{math.MinInt16, -128, -128},
+		{math.MaxInt16, 127, 128},
+	}
+
+	for _, tt := range tests {
+		if got := tt.value.Int(); got != tt.int {
+			t.Errorf("Q8(%d).Int() = %d, want %d", tt.value, got, tt.int)
+		}
+		if got := tt.value.Round(); got != tt.round {
+			t.Errorf("Q8(%d).Round() = %d, want %d", tt.value, got, tt.round)
+		}
+	}
+}
+
+func TestQ16Int(t *testing.T) {
+	tests := []struct {
+		value fixed.Q16
+		want  int16
+	}{
+		{fixed.Q16One * 3 / 2, 1},
+		{-1, -1},
+		{-fixed.Q16One * 3 / 2, -2},
+		{math.MaxInt32, math.MaxInt16},
+		{math.MinInt32, math.MinInt16},
+	}
+
+	for _, tt := range tests {
+		if got := tt.value.Int(); got != tt.want {
+			t.Errorf("Q16(%d).Int() = %d, want %d", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestSqrt(t *testing.T) {
+	tests := []struct {
+		name string
+		got  int64
+		want int64
+	}{
+		{"Q8 zero", int64(fixed.Q8(0).Sqrt()), 0},
+		{"Q8 one", int64(fixed.Q8One.Sqrt()), int64(fixed.Q8One)},
+		{"Q8 four", int64((4 * fixed.Q8One).Sqrt()), int64(2 * fixed.Q8One)},
+		{"Q8 max", int64(fixed.Q8(math.MaxInt16).Sqrt()), 2896},
+		{"Q8 negative", int64((-fixed.Q8One).Sqrt()), 0},
+		{"Q16 zero", int64(fixed.Q16(0).Sqrt()), 0},
+		{"Q16 one", int64(fixed.Q16One.Sqrt()), int64(fixed.Q16One)},
+		{"Q16 four", int64((4 * fixed.Q16One).Sqrt()), int64(2 * fixed.Q16One)},
+		{"Q16 max", int64(fixed.Q16(math.MaxInt32).Sqrt()), 11863283},
+		{"Q16 negative", int64((-fixed.Q16One).Sqrt()), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %d, want %d", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLerpExtrapolates(t *testing.T) {
+	tests := []struct {
+		name string
+		got  int64
+		want int64
+	}{
+		{"Q8 start", int64(fixed.Q8One.Lerp(3*fixed.Q8One, 0)), int64(fixed.Q8One)},
+		{"Q8 end", int64(fixed.Q8One.Lerp(3*fixed.Q8One, fixed.Q8One)), int64(3 * fixed.Q8One)},
+		{"Q8 before start", int64(fixed.Q8One.Lerp(3*fixed.Q8One, -fixed.Q8One/2)), 0},
+		{"Q8 past end", int64(fixed.Q8One.Lerp(3*fixed.Q8One, 2*fixed.Q8One)), int64(5 * fixed.Q8One)},
+		{"Q8 reversed", int64((3 * fixed.Q8One).Lerp(fixed.Q8One, -fixed.Q8One)), int64(5 * fixed.Q8One)},
+		{"Q16 start", int64(fixed.Q16One.Lerp(3*fixed.Q16One, 0)), int64(fixed.Q16One)},
+		{"Q16 end", int64(fixed.Q16One.Lerp(3*fixed.Q16One, fixed.Q16One)), int64(3 * fixed.Q16One)},
+		{"Q16 before start", int64(fixed.Q16One.Lerp(3*fixed.Q16One, -fixed.Q16One)), int64(-fixed.Q16One)},
+		{"Q16 past end", int64(fixed.Q16One.Lerp(3*fixed.Q16One, 2*fixed.Q16One)), int64(5 * fixed.Q16One)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %d, want %d", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQ16ToQ8(t *testing.T) {
+	tests := []struct {
+		value fixed.Q16
+		want  fixed.Q8
+	}{
+		{fixed.Q16One * 3 / 2, fixed.Q8One * 3 / 2},
+		{fixed.Q16One + 255, fixed.Q8One},
+		{-1, -1},
+		{fixed.Q16FromInt(127), fixed.Q8FromInt(127)},
+		{fixed.Q16FromInt(-128), fixed.Q8FromInt(-128)},
+		{fixed.Q16FromInt(128), math.MinInt16},                    // Wraps past the Q8.8 maximum
+		{fixed.Q16FromInt(-129), fixed.Q8FromInt(127)},            // Wraps past the Q8.8 minimum
+		{fixed.Q16FromInt(256) + fixed.Q16One/2, fixed.Q8One / 2}, // Integer bits beyond Q8.8 are dropped
+	}
+
+	for _, tt := range tests {
+		if got := tt.value.Q8(); got != tt.want {
+			t.Errorf("Q16(%d).Q8() = %d, want %d", tt.value, got, tt.want)
+		}
+	}
+}
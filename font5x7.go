@@ -0,0 +1,223 @@
+//go:build !t8go_minimal
+
+package t8go
+
+// Font5x7 is the builtin monospaced font, used by DrawText until SetFont
+// selects another one. It covers printable ASCII (' ' to '~'), the degree
+// sign and the white square '□', drawn for missing characters, with 5x7
+// pixel glyphs in a 6 pixel advance; descenders use one row below the
+// baseline.
+var Font5x7 = &Font{
+	Name:       "5x7",
+	Bitmap:     font5x7Bitmap,
+	Glyphs:     font5x7Glyphs,
+	Ascent:     7,
+	Descent:    1,
+	LineHeight: 9,
+	Fallback:   '□',
+}
+
+// defaultFont is the font of DrawText until SetFont selects one.
+var defaultFont = Font5x7
+
+// font5x7Bitmap holds the 5x8 glyph cells of Font5x7, 5 bytes per glyph.
+var font5x7Bitmap = []byte{
+	0x00, 0x00, 0x00, 0x00, 0x00, // space
+	0x21, 0x08, 0x42, 0x00, 0x80, // !
+	0x52, 0x94, 0x00, 0x00, 0x00, // "
+	0x52, 0xBE, 0xAF, 0xA9, 0x40, // #
+	0x23, 0xE8, 0xE2, 0xF8, 0x80, // $
+	0xC6, 0x44, 0x44, 0x4C, 0x60, // %
+	0x45, 0x28, 0x8A, 0xC9, 0xA0, // &
+	0x31, 0x88, 0x80, 0x00, 0x00, // '
+	0x11, 0x10, 0x84, 0x10, 0x40, // (
+	0x41, 0x04, 0x21, 0x11, 0x00, // )
+	0x25, 0x5D, 0xF7, 0x54, 0x80, // *
+	0x01, 0x09, 0xF2, 0x10, 0x00, // +
+	0x00, 0x00, 0x03, 0x18, 0x88, // ,
+	0x00, 0x01, 0xF0, 0x00, 0x00, // -
+	0x00, 0x00, 0x00, 0x18, 0xC0, // .
+	0x00, 0x44, 0x44, 0x40, 0x00, // /
+	0x74, 0x67, 0x5C, 0xC5, 0xC0, // 0
+	0x23, 0x08, 0x42, 0x11, 0xC0, // 1
+	0x74, 0x42, 0xE8, 0x43, 0xE0, // 2
+	0xF8, 0x44, 0x60, 0xC5, 0xC0, // 3
+	0x11, 0x95, 0x2F, 0x88, 0x40, // 4
+	0xFC, 0x3C, 0x10, 0xC5, 0xC0, // 5
+	0x3A, 0x21, 0xE8, 0xC5, 0xC0, // 6
+	0xF8, 0x42, 0x22, 0x22, 0x00, // 7
+	0x74, 0x62, 0xE8, 0xC5, 0xC0, // 8
+	0x74, 0x62, 0xF0, 0x8B, 0x80, // 9
+	0x00, 0x08, 0x02, 0x00, 0x00, // :
+	0x00, 0x08, 0x02, 0x11, 0x00, // ;
+	0x08, 0x88, 0x82, 0x08, 0x20, // <
+	0x00, 0x3E, 0x0F, 0x80, 0x00, // =
+	0x41, 0x04, 0x11, 0x11, 0x00, // >
+	0x74, 0x42, 0x62, 0x00, 0x80, // ?
+	0x74, 0x6B, 0x7B, 0x41, 0xE0, // @
+	0x22, 0xA3, 0x1F, 0xC6, 0x20, // A
+	0xF4, 0x63, 0xE8, 0xC7, 0xC0, // B
+	0x74, 0x61, 0x08, 0x45, 0xC0, // C
+	0xF4, 0x63, 0x18, 0xC7, 0xC0, // D
+	0xFC, 0x21, 0xE8, 0x43, 0xE0, // E
+	0xFC, 0x21, 0xE8, 0x42, 0x00, // F
+	0x7C, 0x61, 0x09, 0xC5, 0xE0, // G
+	0x8C, 0x63, 0xF8, 0xC6, 0x20, // H
+	0x71, 0x08, 0x42, 0x11, 0xC0, // I
+	0x38, 0x84, 0x21, 0x49, 0x80, // J
+	0x8C, 0xA9, 0x8A, 0x4A, 0x20, // K
+	0x84, 0x21, 0x08, 0x43, 0xE0, // L
+	0x8E, 0xEB, 0x5A, 0xC6, 0x20, // M
+	0x8C, 0x73, 0x59, 0xC6, 0x20, // N
+	0x74, 0x63, 0x18, 0xC5, 0xC0, // O
+	0xF4, 0x63, 0xE8, 0x42, 0x00, // P
+	0x74, 0x63, 0x1A, 0xC9, 0xA0, // Q
+	0xF4, 0x63, 0xEA, 0x4A, 0x20, // R
+	0x74, 0x60, 0xE0, 0xC5, 0xC0, // S
+	0xFD, 0x48, 0x42, 0x10, 0x80, // T
+	0x8C, 0x63, 0x18, 0xC5, 0xC0, // U
+	0x8C, 0x63, 0x18, 0xA8, 0x80, // V
+	0x8C, 0x63, 0x5A, 0xD5, 0x40, // W
+	0x8C, 0x54, 0x45, 0x46, 0x20, // X
+	0x8C, 0x54, 0x42, 0x10, 0x80, // Y
+	0xF8, 0x44, 0xE4, 0x43, 0xE0, // Z
+	0x7A, 0x10, 0x84, 0x21, 0xE0, // [
+	0x04, 0x10, 0x41, 0x04, 0x00, // \
+	0x78, 0x42, 0x10, 0x85, 0xE0, // ]
+	0x22, 0xA2, 0x00, 0x00, 0x00, // ^
+	0x00, 0x00, 0x00, 0x03, 0xE0, // _
+	0x63, 0x08, 0x20, 0x00, 0x00, // `
+	0x00, 0x18, 0x27, 0x49, 0xE0, // a
+	0x84, 0x2D, 0x98, 0xE6, 0xC0, // b
+	0x00, 0x1D, 0x18, 0x45, 0xC0, // c
+	0x08, 0x5B, 0x38, 0xCD, 0xA0, // d
+	0x00, 0x1D, 0x1F, 0xC1, 0xC0, // e
+	0x11, 0x48, 0xE2, 0x10, 0x80, // f
+	0x00, 0x1F, 0x18, 0xBC, 0x2E, // g
+	0x84, 0x2D, 0x98, 0xC6, 0x20, // h
+	0x20, 0x18, 0x42, 0x11, 0xC0, // i
+	0x00, 0x80, 0x21, 0x0A, 0x4C, // j
+	0x84, 0x25, 0x4C, 0x52, 0x40, // k
+	0x61, 0x08, 0x42, 0x11, 0xC0, // l
+	0x00, 0x35, 0x5A, 0xD6, 0xA0, // m
+	0x00, 0x2D, 0x98, 0xC6, 0x20, // n
+	0x00, 0x1D, 0x18, 0xC5, 0xC0, // o
+	0x00, 0x3D, 0x18, 0xFA, 0x10, // p
+	0x00, 0x1F, 0x18, 0xBC, 0x21, // q
+	0x00, 0x2D, 0x98, 0x42, 0x00, // r
+	0x00, 0x1F, 0x07, 0x07, 0xC0, // s
+	0x21, 0x3E, 0x42, 0x14, 0x40, // t
+	0x00, 0x23, 0x18, 0xCD, 0xA0, // u
+	0x00, 0x23, 0x18, 0xA8, 0x80, // v
+	0x00, 0x23, 0x1A, 0xD5, 0x40, // w
+	0x00, 0x22, 0xA2, 0x2A, 0x20, // x
+	0x00, 0x23, 0x18, 0xBC, 0x2E, // y
+	0x00, 0x3E, 0x22, 0x23, 0xE0, // z
+	0x11, 0x08, 0x82, 0x10, 0x40, // {
+	0x21, 0x08, 0x02, 0x10, 0x80, // |
+	0x41, 0x08, 0x22, 0x11, 0x00, // }
+	0x45, 0x44, 0x00, 0x00, 0x00, // ~
+	0x64, 0x98, 0x00, 0x00, 0x00, // °
+	0xFC, 0x63, 0x18, 0xC7, 0xE0, // □
+}
+
+// font5x7Glyphs holds the metrics of Font5x7, sorted by rune.
+var font5x7Glyphs = []Glyph{
+	{Rune: ' ', Offset: 0, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '!', Offset: 5, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '"', Offset: 10, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '#', Offset: 15, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '$', Offset: 20, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '%', Offset: 25, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '&', Offset: 30, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '\'', Offset: 35, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '(', Offset: 40, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: ')', Offset: 45, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '*', Offset: 50, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '+', Offset: 55, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: ',', Offset: 60, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '-', Offset: 65, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '.', Offset: 70, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '/', Offset: 75, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '0', Offset: 80, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '1', Offset: 85, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '2', Offset: 90, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '3', Offset: 95, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '4', Offset: 100, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '5', Offset: 105, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '6', Offset: 110, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '7', Offset: 115, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '8', Offset: 120, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '9', Offset: 125, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: ':', Offset: 130, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: ';', Offset: 135, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '<', Offset: 140, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '=', Offset: 145, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '>', Offset: 150, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '?', Offset: 155, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '@', Offset: 160, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'A', Offset: 165, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'B', Offset: 170, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'C', Offset: 175, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'D', Offset: 180, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'E', Offset: 185, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'F', Offset: 190, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'G', Offset: 195, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'H', Offset: 200, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'I', Offset: 205, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'J', Offset: 210, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'K', Offset: 215, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'L', Offset: 220, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'M', Offset: 225, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'N', Offset: 230, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'O', Offset: 235, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'P', Offset: 240, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'Q', Offset: 245, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'R', Offset: 250, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'S', Offset: 255, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'T', Offset: 260, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'U', Offset: 265, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'V', Offset: 270, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'W', Offset: 275, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'X', Offset: 280, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'Y', Offset: 285, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'Z', Offset: 290, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '[', Offset: 295, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '\\', Offset: 300, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: ']', Offset: 305, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '^', Offset: 310, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '_', Offset: 315, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '`', Offset: 320, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'a', Offset: 325, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'b', Offset: 330, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'c', Offset: 335, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'd', Offset: 340, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'e', Offset: 345, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'f', Offset: 350, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'g', Offset: 355, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'h', Offset: 360, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'i', Offset: 365, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'j', Offset: 370, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'k', Offset: 375, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'l', Offset: 380, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'm', Offset: 385, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'n', Offset: 390, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'o', Offset: 395, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'p', Offset: 400, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'q', Offset: 405, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'r', Offset: 410, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 's', Offset: 415, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 't', Offset: 420, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'u', Offset: 425, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'v', Offset: 430, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'w', Offset: 435, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'x', Offset: 440, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'y', Offset: 445, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: 'z', Offset: 450, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '{', Offset: 455, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '|', Offset: 460, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '}', Offset: 465, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '~', Offset: 470, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '°', Offset: 475, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+	{Rune: '□', Offset: 480, Width: 5, Height: 8, XOffset: 0, YOffset: -7, Advance: 6},
+}
@@ -0,0 +1,188 @@
+package fonts
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/redghc/t8go"
+)
+
+// bdfGlyph holds the state of the BDF character being read.
+type bdfGlyph struct {
+	encoding int      // Unicode code point (-1 for unencoded glyphs)
+	advance  int      // DWIDTH x
+	bbx      [4]int   // Width, height, x offset and y offset of the bottom row
+	rows     [][]byte // Bitmap rows, byte-padded
+}
+
+// ParseBDF reads a font in the Glyph Bitmap Distribution Format (BDF 2.1),
+// the format of the X11 bitmap fonts (Terminus, Tamsyn, the misc-fixed
+// family and many more), and converts it to a t8go font. Glyphs without a
+// Unicode encoding are skipped; the ascent and descent come from the
+// FONT_ASCENT and FONT_DESCENT properties when present, and the fallback
+// glyph from DEFAULT_CHAR.
+func ParseBDF(r io.Reader) (*t8go.Font, error) {
+	font := &t8go.Font{}
+	var (
+		glyph            *bdfGlyph
+		fontBox          [4]int
+		ascent, descent  = -1, -1
+		defaultChar      = -1
+		family, fontName string
+		inBitmap         bool
+	)
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		syntax := fmt.Errorf("%w: line %d: %s", ErrSyntax, line, fields[0])
+
+		if inBitmap {
+			if fields[0] != "ENDCHAR" {
+				row, err := hex.DecodeString(fields[0])
+				if err != nil {
+					return nil, syntax
+				}
+				glyph.rows = append(glyph.rows, row)
+				continue
+			}
+			inBitmap = false
+		}
+
+		switch fields[0] {
+		case "FONT":
+			fontName = strings.Join(fields[1:], " ")
+		case "FAMILY_NAME":
+			family = strings.Trim(strings.Join(fields[1:], " "), `"`)
+		case "FONTBOUNDINGBOX":
+			if !parseInts(fields[1:], fontBox[:]) {
+				return nil, syntax
+			}
+		case "FONT_ASCENT", "FONT_DESCENT":
+			value, err := strconv.Atoi(fields[len(fields)-1])
+			if err != nil || len(fields) != 2 {
+				return nil, syntax
+			}
+			if fields[0] == "FONT_ASCENT" {
+				ascent = value
+			} else {
+				descent = value
+			}
+		case "DEFAULT_CHAR":
+			value, err := strconv.Atoi(fields[len(fields)-1])
+			if err != nil || len(fields) != 2 {
+				return nil, syntax
+			}
+			defaultChar = value
+		case "STARTCHAR":
+			glyph = &bdfGlyph{encoding: -1, advance: -1, bbx: fontBox}
+		case "ENCODING":
+			if glyph == nil || len(fields) < 2 {
+				return nil, syntax
+			}
+			encoding, err := strconv.Atoi(fields[1])
+			if err != nil {
+				return nil, syntax
+			}
+			glyph.encoding = encoding
+		case "DWIDTH":
+			var advance [2]int
+			if glyph == nil || !parseInts(fields[1:], advance[:]) {
+				return nil, syntax
+			}
+			glyph.advance = advance[0]
+		case "BBX":
+			if glyph == nil || !parseInts(fields[1:], glyph.bbx[:]) {
+				return nil, syntax
+			}
+		case "BITMAP":
+			if glyph == nil {
+				return nil, syntax
+			}
+			inBitmap = true
+		case "ENDCHAR":
+			if glyph == nil {
+				return nil, syntax
+			}
+			if glyph.encoding >= 0 {
+				if err := addBDFGlyph(font, glyph); err != nil {
+					return nil, fmt.Errorf("line %d: %w", line, err)
+				}
+			}
+			glyph = nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	font.Name = family
+	if font.Name == "" {
+		font.Name = fontName
+	}
+	if err := finish(font); err != nil {
+		return nil, err
+	}
+	if ascent >= 0 && descent >= 0 {
+		font.Ascent, font.Descent = int16(ascent), int16(descent)
+		font.LineHeight = font.Ascent + font.Descent
+	}
+	if _, ok := font.Glyph(rune(defaultChar)); ok {
+		font.Fallback = rune(defaultChar)
+	}
+	return font, nil
+}
+
+// addBDFGlyph packs a BDF character into font.
+func addBDFGlyph(font *t8go.Font, glyph *bdfGlyph) error {
+	width, height, xOffset, yOffset := glyph.bbx[0], glyph.bbx[1], glyph.bbx[2], glyph.bbx[3]
+	if glyph.advance < 0 {
+		glyph.advance = xOffset + width
+	}
+	top := -(yOffset + height) // Top row relative to the baseline
+	if width < 0 || width > 255 || height < 0 || height > 255 || glyph.advance < 0 || glyph.advance > 255 ||
+		xOffset < -128 || xOffset > 127 || top < -128 || top > 127 {
+		return fmt.Errorf("%w: U+%04X", ErrGlyphRange, glyph.encoding)
+	}
+	if len(glyph.rows) < height {
+		return fmt.Errorf("%w: U+%04X has %d bitmap rows, want %d", ErrSyntax, glyph.encoding, len(glyph.rows), height)
+	}
+
+	offset := len(font.Bitmap)
+	font.Bitmap = packBits(font.Bitmap, width, height, func(x, y int) bool {
+		row := glyph.rows[y]
+		return x/8 < len(row) && row[x/8]&(0x80>>(x%8)) != 0
+	})
+	font.Glyphs = append(font.Glyphs, t8go.Glyph{
+		Rune:    rune(glyph.encoding),
+		Offset:  uint32(offset),
+		Width:   uint8(width),
+		Height:  uint8(height),
+		XOffset: int8(xOffset),
+		YOffset: int8(top),
+		Advance: uint8(glyph.advance),
+	})
+	return nil
+}
+
+// parseInts parses len(values) integers from fields into values.
+func parseInts(fields []string, values []int) bool {
+	if len(fields) < len(values) {
+		return false
+	}
+	for i := range values {
+		value, err := strconv.Atoi(fields[i])
+		if err != nil {
+			return false
+		}
+		values[i] = value
+	}
+	return true
+}
@@ -0,0 +1,32 @@
+package fonts
+
+import "errors"
+
+// GFXFont mirrors the GFXfont struct of the Adafruit GFX library, so fonts
+// converted with its fontconvert tool can be pasted into Go and used with
+// FromGFX. The glyph bitmaps use the same packing as t8go.Font.
+type GFXFont struct {
+	Bitmap   []byte     // Packed glyph bitmaps
+	Glyphs   []GFXGlyph // Glyphs of the runes First to Last
+	First    uint16     // Rune of the first glyph
+	Last     uint16     // Rune of the last glyph
+	YAdvance uint8      // Distance between baselines
+}
+
+// GFXGlyph mirrors the GFXglyph struct of the Adafruit GFX library.
+type GFXGlyph struct {
+	BitmapOffset uint16 // Index of the first bitmap byte in GFXFont.Bitmap
+	Width        uint8  // Bitmap width in pixels
+	Height       uint8  // Bitmap height in pixels
+	XAdvance     uint8  // Distance the pen moves to the next glyph
+	XOffset      int8   // Left edge of the bitmap relative to the pen position
+	YOffset      int8   // Top edge of the bitmap relative to the baseline
+}
+
+// Common errors returned by the fonts package.
+var (
+	ErrSyntax     = errors.New("invalid font file")                         // BDF or GFX source cannot be parsed
+	ErrGlyphRange = errors.New("glyph metrics exceed the t8go font limits") // Glyph larger than 255 pixels or offset beyond int8
+	ErrNoGlyphs   = errors.New("font has no glyphs")                        // Source defines no usable glyph
+	ErrCharset    = errors.New("invalid character set")                     // Subset charset is empty or has a reversed range
+)
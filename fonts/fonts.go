@@ -0,0 +1,141 @@
+// Package fonts imports bitmap fonts into t8go.Font, so the fonts of other
+// ecosystems can be used with DrawText instead of hand-authoring glyphs:
+//
+//   - ParseBDF reads BDF fonts, the format of the X11 bitmap fonts;
+//   - FromGFX converts Adafruit GFX font structs pasted into Go, and ParseGFX
+//     reads the C headers produced by its fontconvert tool.
+//
+// Subset keeps only the glyphs a product draws, such as the digits of a
+// readout, for targets whose flash cannot hold a full font.
+//
+// Parsing at run time suits tools and hosts. For microcontrollers, convert
+// the font once with GoSource (or go run ./cmd/t8gofont convert) and compile
+// the generated file in, so the font lives in flash:
+//
+//	//go:generate go run github.com/redghc/t8go/cmd/t8gofont convert -name Terminus12 -o terminus12.go ter-u12n.bdf
+package fonts
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"slices"
+
+	"github.com/redghc/t8go"
+)
+
+// FromGFX converts an Adafruit GFX font. The bitmap is shared, not copied,
+// as both libraries pack glyphs the same way. The ascent and descent are
+// measured from the glyphs; the line height is the font's YAdvance.
+func FromGFX(name string, gfx GFXFont) (*t8go.Font, error) {
+	count := int(gfx.Last) - int(gfx.First) + 1
+	if count <= 0 || len(gfx.Glyphs) < count {
+		return nil, fmt.Errorf("%w: %s needs %d glyphs for U+%04X..U+%04X, has %d",
+			ErrSyntax, name, max(count, 0), gfx.First, gfx.Last, len(gfx.Glyphs))
+	}
+
+	font := &t8go.Font{Name: name, Bitmap: gfx.Bitmap, Glyphs: make([]t8go.Glyph, count)}
+	for i, glyph := range gfx.Glyphs[:count] {
+		font.Glyphs[i] = t8go.Glyph{
+			Rune:    rune(gfx.First) + rune(i),
+			Offset:  uint32(glyph.BitmapOffset),
+			Width:   glyph.Width,
+			Height:  glyph.Height,
+			XOffset: glyph.XOffset,
+			YOffset: glyph.YOffset,
+			Advance: glyph.XAdvance,
+		}
+	}
+	if err := finish(font); err != nil {
+		return nil, err
+	}
+	font.LineHeight = int16(gfx.YAdvance)
+	return font, nil
+}
+
+// GoSource returns Go source declaring font as a *t8go.Font variable named
+// name in package pkg, for compiling converted fonts into firmware.
+func GoSource(font *t8go.Font, pkg, name string) ([]byte, error) {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by t8gofont from %s; DO NOT EDIT.\n\n", font.Name)
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	fmt.Fprintf(&b, "import \"github.com/redghc/t8go\"\n\n")
+	fmt.Fprintf(&b, "// %s is the %s font, %d glyphs in %d bitmap bytes.\n", name, font.Name, len(font.Glyphs), len(font.Bitmap))
+	fmt.Fprintf(&b, "var %s = &t8go.Font{\n", name)
+	fmt.Fprintf(&b, "\tName: %q,\n\tAscent: %d,\n\tDescent: %d,\n\tLineHeight: %d,\n", font.Name, font.Ascent, font.Descent, font.LineHeight)
+	b.WriteString("\tBitmap: []byte{")
+	for i, value := range font.Bitmap {
+		if i%16 == 0 {
+			b.WriteString("\n\t\t")
+		} else {
+			b.WriteByte(' ')
+		}
+		fmt.Fprintf(&b, "0x%02X,", value)
+	}
+	b.WriteString("\n\t},\n\tGlyphs: []t8go.Glyph{\n")
+	for _, glyph := range font.Glyphs {
+		fmt.Fprintf(&b, "\t\t{Rune: %s, Offset: %d, Width: %d, Height: %d, XOffset: %d, YOffset: %d, Advance: %d},\n",
+			runeLiteral(glyph.Rune), glyph.Offset, glyph.Width, glyph.Height, glyph.XOffset, glyph.YOffset, glyph.Advance)
+	}
+	b.WriteString("\t},\n")
+	if font.Fallback != 0 {
+		fmt.Fprintf(&b, "\tFallback: %s,\n", runeLiteral(font.Fallback))
+	}
+	if len(font.Kerning) > 0 {
+		b.WriteString("\tKerning: []t8go.KernPair{\n")
+		for _, pair := range font.Kerning {
+			fmt.Fprintf(&b, "\t\t{Left: %s, Right: %s, Adjust: %d},\n", runeLiteral(pair.Left), runeLiteral(pair.Right), pair.Adjust)
+		}
+		b.WriteString("\t},\n")
+	}
+	b.WriteString("}\n")
+	return format.Source(b.Bytes())
+}
+
+// runeLiteral returns r as a Go rune literal, in hexadecimal when it is not
+// a printable ASCII character.
+func runeLiteral(r rune) string {
+	if r > ' ' && r < 0x7F {
+		return fmt.Sprintf("%q", r)
+	}
+	return fmt.Sprintf("0x%04X", r)
+}
+
+// packBits appends a width x height bitmap to dst in the t8go font packing:
+// rows one after another, most significant bit first, padded to a byte only
+// at the end.
+func packBits(dst []byte, width, height int, pixel func(x, y int) bool) []byte {
+	bit := 0
+	for y := range height {
+		for x := range width {
+			if bit%8 == 0 {
+				dst = append(dst, 0)
+			}
+			if pixel(x, y) {
+				dst[len(dst)-1] |= 0x80 >> (bit % 8)
+			}
+			bit++
+		}
+	}
+	return dst
+}
+
+// finish sorts the glyphs of font by rune, drops duplicates and measures the
+// ascent and descent, with the line height as their sum.
+func finish(font *t8go.Font) error {
+	if len(font.Glyphs) == 0 {
+		return ErrNoGlyphs
+	}
+	slices.SortStableFunc(font.Glyphs, func(a, b t8go.Glyph) int { return int(a.Rune - b.Rune) })
+	font.Glyphs = slices.CompactFunc(font.Glyphs, func(a, b t8go.Glyph) bool { return a.Rune == b.Rune })
+
+	font.Ascent, font.Descent = 0, 0
+	for _, glyph := range font.Glyphs {
+		if glyph.Height > 0 {
+			font.Ascent = max(font.Ascent, -int16(glyph.YOffset))
+			font.Descent = max(font.Descent, int16(glyph.YOffset)+int16(glyph.Height))
+		}
+	}
+	font.LineHeight = font.Ascent + font.Descent
+	return nil
+}
@@ -0,0 +1,110 @@
+package fonts
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/redghc/t8go"
+)
+
+var (
+	// gfxComment matches C comments, which fontconvert uses to label glyphs.
+	gfxComment = regexp.MustCompile(`(?s)//[^\n]*|/\*.*?\*/`)
+	// gfxNumber matches an integer literal that is not part of an identifier.
+	gfxNumber = regexp.MustCompile(`(?:^|[^\w])(-?(?:0[xX][0-9a-fA-F]+|\d+))`)
+	// gfxFontName matches the declaration of the GFXfont variable.
+	gfxFontName = regexp.MustCompile(`GFXfont\s+(\w+)`)
+)
+
+// ParseGFX reads an Adafruit GFX font header, as written by fontconvert
+// (FreeSans9pt7b.h and friends), and converts it with FromGFX. The font is
+// named after its GFXfont variable.
+func ParseGFX(src []byte) (*t8go.Font, error) {
+	text := gfxComment.ReplaceAllString(string(src), "")
+
+	bitmapValues, err := gfxInitializer(text, "uint8_t")
+	if err != nil {
+		return nil, err
+	}
+	glyphValues, err := gfxInitializer(text, "GFXglyph")
+	if err != nil {
+		return nil, err
+	}
+	fontValues, err := gfxInitializer(text, "GFXfont")
+	if err != nil {
+		return nil, err
+	}
+	if len(glyphValues)%6 != 0 || len(fontValues) < 3 {
+		return nil, fmt.Errorf("%w: malformed GFXglyph or GFXfont initializer", ErrSyntax)
+	}
+
+	gfx := GFXFont{
+		Bitmap:   make([]byte, len(bitmapValues)),
+		Glyphs:   make([]GFXGlyph, len(glyphValues)/6),
+		First:    uint16(fontValues[len(fontValues)-3]),
+		Last:     uint16(fontValues[len(fontValues)-2]),
+		YAdvance: uint8(fontValues[len(fontValues)-1]),
+	}
+	for i, value := range bitmapValues {
+		gfx.Bitmap[i] = byte(value)
+	}
+	for i := range gfx.Glyphs {
+		v := glyphValues[i*6 : i*6+6]
+		gfx.Glyphs[i] = GFXGlyph{
+			BitmapOffset: uint16(v[0]),
+			Width:        uint8(v[1]),
+			Height:       uint8(v[2]),
+			XAdvance:     uint8(v[3]),
+			XOffset:      int8(v[4]),
+			YOffset:      int8(v[5]),
+		}
+	}
+
+	name := "GFX font"
+	if match := gfxFontName.FindStringSubmatch(text); match != nil {
+		name = match[1]
+	}
+	return FromGFX(name, gfx)
+}
+
+// gfxInitializer returns the integers of the brace initializer of the first
+// variable declared with type typeName.
+func gfxInitializer(text, typeName string) ([]int64, error) {
+	start := strings.Index(text, typeName)
+	if start < 0 {
+		return nil, fmt.Errorf("%w: no %s declaration", ErrSyntax, typeName)
+	}
+	open := strings.IndexByte(text[start:], '{')
+	if open < 0 {
+		return nil, fmt.Errorf("%w: %s without initializer", ErrSyntax, typeName)
+	}
+	open += start
+
+	depth, end := 0, -1
+	for i := open; i < len(text) && end < 0; i++ {
+		switch text[i] {
+		case '{':
+			depth++
+		case '}':
+			if depth--; depth == 0 {
+				end = i
+			}
+		}
+	}
+	if end < 0 {
+		return nil, fmt.Errorf("%w: unterminated %s initializer", ErrSyntax, typeName)
+	}
+
+	matches := gfxNumber.FindAllStringSubmatch(text[open+1:end], -1)
+	values := make([]int64, len(matches))
+	for i, match := range matches {
+		value, err := strconv.ParseInt(match[1], 0, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s value %q", ErrSyntax, typeName, match[1])
+		}
+		values[i] = value
+	}
+	return values, nil
+}
@@ -0,0 +1,94 @@
+package fonts
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/redghc/t8go"
+)
+
+// Subset returns a copy of font holding only the glyphs of the runes in
+// charset, with their bitmaps repacked, for targets whose flash cannot hold
+// a full font. charset lists runes, with ranges written as "a-z"; a '-' at
+// its start or end is literal, so "0-9:.%°C" keeps the digits, ':', '.',
+// '%', '°' and 'C'. Runes missing from the font are ignored (see Missing).
+// The ascent, descent and line height are kept, so the subset lays out text
+// exactly like the full font; the Fallback glyph and kerning pairs are kept
+// only when their runes are in charset.
+func Subset(font *t8go.Font, charset string) (*t8go.Font, error) {
+	runes, err := parseCharset(charset)
+	if err != nil {
+		return nil, err
+	}
+
+	subset := &t8go.Font{
+		Name:       font.Name,
+		Ascent:     font.Ascent,
+		Descent:    font.Descent,
+		LineHeight: font.LineHeight,
+	}
+	for _, glyph := range font.Glyphs {
+		if _, ok := slices.BinarySearch(runes, glyph.Rune); !ok {
+			continue
+		}
+		start := int(glyph.Offset)
+		end := min(start+(int(glyph.Width)*int(glyph.Height)+7)/8, len(font.Bitmap))
+		glyph.Offset = uint32(len(subset.Bitmap))
+		if start < end {
+			subset.Bitmap = append(subset.Bitmap, font.Bitmap[start:end]...)
+		}
+		subset.Glyphs = append(subset.Glyphs, glyph)
+	}
+	if len(subset.Glyphs) == 0 {
+		return nil, ErrNoGlyphs
+	}
+
+	if _, ok := subset.Glyph(font.Fallback); ok {
+		subset.Fallback = font.Fallback
+	}
+	for _, pair := range font.Kerning {
+		_, left := subset.Glyph(pair.Left)
+		_, right := subset.Glyph(pair.Right)
+		if left && right {
+			subset.Kerning = append(subset.Kerning, pair)
+		}
+	}
+	return subset, nil
+}
+
+// Missing returns the runes of charset that font has no glyph for, so tools
+// can warn about characters that would be drawn as the fallback or skipped.
+func Missing(font *t8go.Font, charset string) ([]rune, error) {
+	runes, err := parseCharset(charset)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(runes, func(r rune) bool {
+		_, ok := font.Glyph(r)
+		return ok
+	}), nil
+}
+
+// parseCharset returns the sorted, distinct runes listed by charset.
+func parseCharset(charset string) ([]rune, error) {
+	list := []rune(charset)
+	var runes []rune
+	for i := 0; i < len(list); i++ {
+		first, last := list[i], list[i]
+		if i+2 < len(list) && list[i+1] == '-' {
+			last = list[i+2]
+			i += 2
+		}
+		if last < first {
+			return nil, fmt.Errorf("%w: range %c-%c", ErrCharset, first, last)
+		}
+		for r := first; r <= last; r++ {
+			runes = append(runes, r)
+		}
+	}
+	if len(runes) == 0 {
+		return nil, fmt.Errorf("%w: no characters", ErrCharset)
+	}
+	slices.Sort(runes)
+	return slices.Compact(runes), nil
+}
@@ -0,0 +1,347 @@
+// Package framebuf implements pixel and span operations on monochrome frame
+// buffers. Buffers use the SSD1306-style page layout by default: each byte
+// holds 8 vertical pixels (LSB on top) and pages of Width bytes are stacked
+// from top to bottom. Row-major and column-major layouts are supported for
+// controllers that expect them, so their drivers can send the buffer as is.
+// Drivers use it to implement the optional t8go fast paths.
+package framebuf
+
+// Layout identifies how pixels are packed into the bytes of a Buffer.
+type Layout uint8
+
+const (
+	PageMajor   Layout = iota // 8 vertical pixels per byte, LSB on top, pages of Width bytes stacked top to bottom (SSD1306)
+	RowMajor                  // 8 horizontal pixels per byte, MSB on the left, rows of (Width+7)/8 bytes stacked top to bottom (ST7920, Sharp memory LCD)
+	ColumnMajor               // 8 vertical pixels per byte, LSB on top, columns of (Height+7)/8 bytes stacked left to right
+)
+
+// Buffer describes a monochrome frame buffer.
+type Buffer struct {
+	Data   []byte // Buffer bytes, Layout.Size(Width, Height) long
+	Width  int    // Width in pixels
+	Height int    // Height in pixels
+	Layout Layout // Pixel packing of Data (zero value: PageMajor)
+}
+
+// Size returns the number of bytes needed for a width x height buffer in the
+// page layout.
+func Size(width, height int) int {
+	return width * ((height + 7) / 8)
+}
+
+// Size returns the number of bytes needed for a width x height buffer in
+// layout l.
+func (l Layout) Size(width, height int) int {
+	if l == RowMajor {
+		return (width + 7) / 8 * height
+	}
+	return Size(width, height)
+}
+
+// Stride returns the number of bytes from the start of one page (PageMajor),
+// row (RowMajor) or column (ColumnMajor) of a width x height buffer to the
+// next.
+func (l Layout) Stride(width, height int) int {
+	switch l {
+	case RowMajor:
+		return (width + 7) / 8
+	case ColumnMajor:
+		return (height + 7) / 8
+	}
+	return width
+}
+
+// Valid reports whether l is one of the supported layouts.
+func (l Layout) Valid() bool {
+	return l <= ColumnMajor
+}
+
+// locate returns the byte index and bit mask of the in-bounds pixel (x, y).
+func (b Buffer) locate(x, y int) (int, uint8) {
+	switch b.Layout {
+	case RowMajor:
+		return y*((b.Width+7)>>3) + x>>3, 0x80 >> (x & 7)
+	case ColumnMajor:
+		return x*((b.Height+7)>>3) + y>>3, 1 << (y & 7)
+	}
+	return x + (y>>3)*b.Width, 1 << (y & 7)
+}
+
+// SetPixel sets or clears the pixel at (x, y). Out-of-bounds pixels are ignored.
+func (b Buffer) SetPixel(x, y int, on bool) {
+	if x < 0 || y < 0 || x >= b.Width || y >= b.Height {
+		return
+	}
+
+	index, bitMask := b.locate(x, y)
+	if on {
+		b.Data[index] |= bitMask
+	} else {
+		b.Data[index] &^= bitMask
+	}
+}
+
+// GetPixel reports whether the pixel at (x, y) is on. Out-of-bounds pixels are off.
+func (b Buffer) GetPixel(x, y int) bool {
+	if x < 0 || y < 0 || x >= b.Width || y >= b.Height {
+		return false
+	}
+	index, bitMask := b.locate(x, y)
+	return b.Data[index]&bitMask != 0
+}
+
+// HSpan sets or clears a horizontal run of length pixels starting at (x, y).
+// Out-of-bounds parts of the span are clipped.
+func (b Buffer) HSpan(x, y, length int, on bool) {
+	if length <= 0 || y < 0 || y >= b.Height {
+		return
+	}
+
+	startX := max(x, 0)
+	endX := min(x+length, b.Width)
+	if startX >= endX {
+		return
+	}
+
+	switch b.Layout {
+	case RowMajor:
+		b.rowSpan(y, startX, endX, on)
+		return
+	case ColumnMajor:
+		for x := startX; x < endX; x++ {
+			b.SetPixel(x, y, on)
+		}
+		return
+	}
+
+	offset := (y >> 3) * b.Width
+	row := b.Data[offset+startX : offset+endX]
+	bitMask := uint8(1 << (y & 7))
+	if on {
+		for i := range row {
+			row[i] |= bitMask
+		}
+	} else {
+		for i := range row {
+			row[i] &^= bitMask
+		}
+	}
+}
+
+// rowSpan sets or clears the pixels startX..endX-1 of row y in a RowMajor
+// buffer, whole bytes at once and the partial bytes at both ends with a mask.
+func (b Buffer) rowSpan(y, startX, endX int, on bool) {
+	offset := y * ((b.Width + 7) >> 3)
+	first, last := offset+startX>>3, offset+(endX-1)>>3
+	for index := first; index <= last; index++ {
+		bitMask := uint8(0xFF)
+		if index == first {
+			bitMask >>= startX & 7
+		}
+		if index == last {
+			bitMask &= 0xFF << (7 - (endX-1)&7)
+		}
+		if on {
+			b.Data[index] |= bitMask
+		} else {
+			b.Data[index] &^= bitMask
+		}
+	}
+}
+
+// FillRect sets or clears a width x height rectangle with its top-left corner
+// at (x, y). In the vertical layouts whole pages are written byte by byte and
+// partial pages with a bit mask; in RowMajor every row is a span. Out-of-bounds
+// parts of the rectangle are clipped.
+func (b Buffer) FillRect(x, y, width, height int, on bool) {
+	if width <= 0 || height <= 0 {
+		return
+	}
+
+	startX := max(x, 0)
+	endX := min(x+width, b.Width)
+	startY := max(y, 0)
+	endY := min(y+height, b.Height)
+	if startX >= endX || startY >= endY {
+		return
+	}
+
+	if b.Layout == RowMajor {
+		for row := startY; row < endY; row++ {
+			b.rowSpan(row, startX, endX, on)
+		}
+		return
+	}
+
+	for page := startY >> 3; page <= (endY-1)>>3; page++ {
+		// Rows of this page covered by the rectangle.
+		top := max(startY-page*8, 0)
+		bottom := min(endY-page*8, 8)
+		pageMask := uint8(0xFF<<top) & uint8(0xFF>>(8-bottom))
+
+		if b.Layout == ColumnMajor {
+			stride := (b.Height + 7) >> 3
+			for x := startX; x < endX; x++ {
+				if on {
+					b.Data[x*stride+page] |= pageMask
+				} else {
+					b.Data[x*stride+page] &^= pageMask
+				}
+			}
+			continue
+		}
+
+		offset := page * b.Width
+		row := b.Data[offset+startX : offset+endX]
+		switch {
+		case pageMask == 0xFF && on:
+			for i := range row {
+				row[i] = 0xFF
+			}
+		case pageMask == 0xFF:
+			clear(row)
+		case on:
+			for i := range row {
+				row[i] |= pageMask
+			}
+		default:
+			for i := range row {
+				row[i] &^= pageMask
+			}
+		}
+	}
+}
+
+// Blit copies src into b with its top-left corner at (x, y), replacing the
+// covered pixels. Between PageMajor buffers, source pages are merged byte by
+// byte, split across two destination pages when y is not a multiple of 8;
+// other layouts are copied pixel by pixel. Out-of-bounds parts of src are
+// clipped.
+func (b Buffer) Blit(x, y int, src Buffer) {
+	startX := max(x, 0)
+	endX := min(x+src.Width, b.Width)
+	if startX >= endX || src.Height <= 0 || y >= b.Height || y+src.Height <= 0 {
+		return
+	}
+
+	if b.Layout != PageMajor || src.Layout != PageMajor {
+		for py := max(y, 0); py < min(y+src.Height, b.Height); py++ {
+			for px := startX; px < endX; px++ {
+				b.SetPixel(px, py, src.GetPixel(px-x, py-y))
+			}
+		}
+		return
+	}
+
+	shift := y & 7
+	for page := range (src.Height + 7) / 8 {
+		// Rows of this source page inside src
+		mask := uint8(0xFF)
+		if rows := src.Height - page*8; rows < 8 {
+			mask >>= 8 - rows
+		}
+
+		offset := page*src.Width - x
+		row := src.Data[offset+startX : offset+endX]
+		target := y>>3 + page
+		b.mergePage(target, startX, row, mask<<shift, shift)
+		if shift > 0 {
+			b.mergePage(target+1, startX, row, mask>>(8-shift), shift-8)
+		}
+	}
+}
+
+// mergePage replaces the bits selected by mask in page from column x with the
+// bytes of row, shifted left by shift bits (right when negative). Pages
+// outside the buffer are skipped.
+func (b Buffer) mergePage(page, x int, row []byte, mask uint8, shift int) {
+	if page < 0 || page >= (b.Height+7)/8 {
+		return
+	}
+	if rows := b.Height - page*8; rows < 8 {
+		mask &= 0xFF >> (8 - rows)
+	}
+	if mask == 0 {
+		return
+	}
+
+	offset := page*b.Width + x
+	dst := b.Data[offset : offset+len(row)]
+	for i, value := range row {
+		if shift >= 0 {
+			value <<= shift
+		} else {
+			value >>= -shift
+		}
+		dst[i] = dst[i]&^mask | value&mask
+	}
+}
+
+// Stamp draws the lit pixels of src into b with its top-left corner at
+// (x, y), turning them on, or off when on is false; the other pixels of b are
+// kept, as when drawing text. Between PageMajor buffers whole source bytes
+// are combined at once, like Blit. Out-of-bounds parts of src are clipped.
+func (b Buffer) Stamp(x, y int, src Buffer, on bool) {
+	startX := max(x, 0)
+	endX := min(x+src.Width, b.Width)
+	if startX >= endX || src.Height <= 0 || y >= b.Height || y+src.Height <= 0 {
+		return
+	}
+
+	if b.Layout != PageMajor || src.Layout != PageMajor {
+		for py := max(y, 0); py < min(y+src.Height, b.Height); py++ {
+			for px := startX; px < endX; px++ {
+				if src.GetPixel(px-x, py-y) {
+					b.SetPixel(px, py, on)
+				}
+			}
+		}
+		return
+	}
+
+	shift := y & 7
+	for page := range (src.Height + 7) / 8 {
+		mask := uint8(0xFF)
+		if rows := src.Height - page*8; rows < 8 {
+			mask >>= 8 - rows
+		}
+
+		offset := page*src.Width - x
+		row := src.Data[offset+startX : offset+endX]
+		target := y>>3 + page
+		b.stampPage(target, startX, row, mask<<shift, shift, on)
+		if shift > 0 {
+			b.stampPage(target+1, startX, row, mask>>(8-shift), shift-8, on)
+		}
+	}
+}
+
+// stampPage sets (or clears) the bits of page from column x that are lit in
+// row, shifted left by shift bits (right when negative) and selected by
+// mask. Pages outside the buffer are skipped.
+func (b Buffer) stampPage(page, x int, row []byte, mask uint8, shift int, on bool) {
+	if page < 0 || page >= (b.Height+7)/8 {
+		return
+	}
+	if rows := b.Height - page*8; rows < 8 {
+		mask &= 0xFF >> (8 - rows)
+	}
+	if mask == 0 {
+		return
+	}
+
+	offset := page*b.Width + x
+	dst := b.Data[offset : offset+len(row)]
+	for i, value := range row {
+		if shift >= 0 {
+			value <<= shift
+		} else {
+			value >>= -shift
+		}
+		if on {
+			dst[i] |= value & mask
+		} else {
+			dst[i] &^= value & mask
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package t8go
+
+import (
+	"runtime"
+	"strconv"
+	"time"
+)
+
+// FrameClock paces a render loop to a target frame rate and measures the
+// actual frame time. Call Tick once per frame, after the frame was flushed.
+type FrameClock struct {
+	target    time.Duration // Target duration of a single frame
+	lastTick  time.Time     // Time of the previous Tick
+	frameTime time.Duration // Duration of the last complete frame
+	workTime  time.Duration // Time spent rendering before the last Tick waited
+	frames    uint32        // Total number of frames ticked
+
+	windowStart  time.Time // Start of the current FPS measurement window
+	windowFrames uint16    // Frames counted in the current window
+	fps          uint16    // Frames per second measured over the last window
+}
+
+// NewFrameClock creates a frame clock targeting the given frames per second.
+// A targetFPS of zero disables pacing; the clock then only measures.
+func NewFrameClock(targetFPS uint16) *FrameClock {
+	var target time.Duration
+	if targetFPS > 0 {
+		target = time.Second / time.Duration(targetFPS)
+	}
+
+	now := time.Now()
+	return &FrameClock{
+		target:      target,
+		lastTick:    now,
+		windowStart: now,
+	}
+}
+
+// Tick waits until the current frame's deadline and starts the next frame.
+// It sleeps when there is time left, otherwise it yields to other goroutines
+// so cooperative schedulers (TinyGo) keep running.
+// Returns the duration of the frame that just ended.
+func (c *FrameClock) Tick() time.Duration {
+	now := time.Now()
+	c.workTime = now.Sub(c.lastTick)
+
+	if remaining := c.target - c.workTime; remaining > 0 {
+		time.Sleep(remaining)
+		now = time.Now()
+	} else {
+		runtime.Gosched()
+	}
+
+	c.frameTime = now.Sub(c.lastTick)
+	c.lastTick = now
+	c.frames++
+
+	// Measure FPS over one-second windows.
+	c.windowFrames++
+	if elapsed := now.Sub(c.windowStart); elapsed >= time.Second {
+		c.fps = uint16(int64(c.windowFrames) * int64(time.Second) / int64(elapsed))
+		c.windowFrames = 0
+		c.windowStart = now
+	}
+
+	return c.frameTime
+}
+
+// FPS returns the frames per second measured over the last full second.
+func (c *FrameClock) FPS() uint16 {
+	return c.fps
+}
+
+// FrameTime returns the duration of the last complete frame, including pacing.
+func (c *FrameClock) FrameTime() time.Duration {
+	return c.frameTime
+}
+
+// WorkTime returns the time spent rendering the last frame, excluding pacing.
+func (c *FrameClock) WorkTime() time.Duration {
+	return c.workTime
+}
+
+// Frame returns the number of frames ticked since the clock was created.
+func (c *FrameClock) Frame() uint32 {
+	return c.frames
+}
+
+// DrawStats overlays a compact "<fps>FPS <work>MS" readout at (x, y) on a cleared
+// background, for performance debugging. The readout is 7 pixels tall.
+func (c *FrameClock) DrawStats(d IDisplayDrawer, x, y int16) {
+	var text [24]byte
+	line := strconv.AppendUint(text[:0], uint64(c.fps), 10)
+	line = append(line, "FPS "...)
+	line = strconv.AppendUint(line, uint64(c.workTime/time.Millisecond), 10)
+	line = append(line, "MS"...)
+
+	// Clear a background box with a 1px margin around the glyphs.
+	width := int16(len(line))*4 + 1
+	for offsetY := range int16(7) {
+		for offsetX := range width {
+			d.SetPixel(x+offsetX, y+offsetY, false)
+		}
+	}
+
+	for i, char := range line {
+		drawStatsGlyph(d, x+1+int16(i)*4, y+1, char)
+	}
+}
+
+// statsDigits holds 3x5 digit glyphs for the stats readout, one 3-bit row per line
+// with the top row in the most significant bits.
+var statsDigits = [10]uint16{
+	0b111_101_101_101_111, // 0
+	0b010_110_010_010_111, // 1
+	0b111_001_111_100_111, // 2
+	0b111_001_111_001_111, // 3
+	0b101_101_111_001_001, // 4
+	0b111_100_111_001_111, // 5
+	0b111_100_111_101_111, // 6
+	0b111_001_001_001_001, // 7
+	0b111_101_111_101_111, // 8
+	0b111_101_111_001_111, // 9
+}
+
+// statsGlyph returns the 3x5 glyph bits for a stats readout character.
+// Unknown characters map to a blank glyph.
+func statsGlyph(char byte) uint16 {
+	switch {
+	case char >= '0' && char <= '9':
+		return statsDigits[char-'0']
+	case char == 'F':
+		return 0b111_100_110_100_100
+	case char == 'P':
+		return 0b110_101_110_100_100
+	case char == 'S':
+		return 0b011_100_010_001_110
+	case char == 'M':
+		return 0b101_111_111_101_101
+	default:
+		return 0
+	}
+}
+
+// drawStatsGlyph draws a single 3x5 stats glyph with its top-left corner at (x, y).
+func drawStatsGlyph(d IDisplayDrawer, x, y int16, char byte) {
+	bits := statsGlyph(char)
+	for row := range int16(5) {
+		for col := range int16(3) {
+			if bits&(1<<(14-row*3-col)) != 0 {
+				d.SetPixel(x+col, y+row, true)
+			}
+		}
+	}
+}
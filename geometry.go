@@ -0,0 +1,174 @@
+package t8go
+
+import "github.com/redghc/t8go/helpers"
+
+// Pt is shorthand for Point{X: x, Y: y}.
+func Pt(x, y int16) Point {
+	return Point{X: x, Y: y}
+}
+
+// Add returns the point translated by offset.
+// The result saturates at the int16 limits instead of wrapping.
+func (p Point) Add(offset Point) Point {
+	return Point{
+		X: helpers.ClampInt16(int32(p.X) + int32(offset.X)),
+		Y: helpers.ClampInt16(int32(p.Y) + int32(offset.Y)),
+	}
+}
+
+// Sub returns the point translated by -offset.
+// The result saturates at the int16 limits instead of wrapping.
+func (p Point) Sub(offset Point) Point {
+	return Point{
+		X: helpers.ClampInt16(int32(p.X) - int32(offset.X)),
+		Y: helpers.ClampInt16(int32(p.Y) - int32(offset.Y)),
+	}
+}
+
+// In reports whether the point lies inside r.
+func (p Point) In(r Rect) bool {
+	return r.Contains(p)
+}
+
+// ----------
+
+// RectFromCoords returns the rect spanning the corners (startX, startY) and
+// (endX, endY), inclusive, in any order (like DrawBoxCoords).
+func RectFromCoords(startX, startY, endX, endY int16) Rect {
+	return rectFromEdges(
+		min(int32(startX), int32(endX)), min(int32(startY), int32(endY)),
+		max(int32(startX), int32(endX))+1, max(int32(startY), int32(endY))+1,
+	)
+}
+
+// rectFromEdges builds a rect from its edges, with right and bottom exclusive.
+// The width and height saturate at the int16 limits.
+func rectFromEdges(left, top, right, bottom int32) Rect {
+	return Rect{
+		X:      int16(left),
+		Y:      int16(top),
+		Width:  helpers.ClampInt16(right - left),
+		Height: helpers.ClampInt16(bottom - top),
+	}
+}
+
+// edges returns the left, top, right and bottom edges of the rect, with right
+// and bottom exclusive. int32 keeps the sums from overflowing.
+func (r Rect) edges() (left, top, right, bottom int32) {
+	return int32(r.X), int32(r.Y), int32(r.X) + int32(r.Width), int32(r.Y) + int32(r.Height)
+}
+
+// Empty reports whether the rect contains no pixels.
+func (r Rect) Empty() bool {
+	return r.Width <= 0 || r.Height <= 0
+}
+
+// Min returns the top-left corner of the rect.
+func (r Rect) Min() Point {
+	return Point{X: r.X, Y: r.Y}
+}
+
+// Max returns the bottom-right pixel of the rect (inclusive).
+// The result is meaningless for empty rects.
+func (r Rect) Max() Point {
+	_, _, right, bottom := r.edges()
+	return Point{X: helpers.ClampInt16(right - 1), Y: helpers.ClampInt16(bottom - 1)}
+}
+
+// Contains reports whether the pixel p lies inside the rect.
+func (r Rect) Contains(p Point) bool {
+	left, top, right, bottom := r.edges()
+	x, y := int32(p.X), int32(p.Y)
+	return x >= left && x < right && y >= top && y < bottom
+}
+
+// ContainsRect reports whether every pixel of other lies inside the rect.
+// An empty rect is contained in every rect.
+func (r Rect) ContainsRect(other Rect) bool {
+	if other.Empty() {
+		return true
+	}
+	left, top, right, bottom := r.edges()
+	otherLeft, otherTop, otherRight, otherBottom := other.edges()
+	return otherLeft >= left && otherTop >= top && otherRight <= right && otherBottom <= bottom
+}
+
+// Overlaps reports whether the rect and other share at least one pixel.
+func (r Rect) Overlaps(other Rect) bool {
+	return !r.Intersect(other).Empty()
+}
+
+// Intersect returns the largest rect contained in both the rect and other.
+// If they do not overlap, the result is empty.
+func (r Rect) Intersect(other Rect) Rect {
+	left, top, right, bottom := r.edges()
+	otherLeft, otherTop, otherRight, otherBottom := other.edges()
+
+	left, top = max(left, otherLeft), max(top, otherTop)
+	right, bottom = min(right, otherRight), min(bottom, otherBottom)
+	if left >= right || top >= bottom {
+		return Rect{}
+	}
+	return rectFromEdges(left, top, right, bottom)
+}
+
+// Union returns the smallest rect containing both the rect and other.
+// Empty rects are ignored, so merging dirty regions can start from Rect{}.
+func (r Rect) Union(other Rect) Rect {
+	if r.Empty() {
+		return other
+	}
+	if other.Empty() {
+		return r
+	}
+
+	left, top, right, bottom := r.edges()
+	otherLeft, otherTop, otherRight, otherBottom := other.edges()
+	return rectFromEdges(
+		min(left, otherLeft), min(top, otherTop),
+		max(right, otherRight), max(bottom, otherBottom),
+	)
+}
+
+// Add returns the rect translated by offset.
+func (r Rect) Add(offset Point) Rect {
+	origin := r.Min().Add(offset)
+	r.X, r.Y = origin.X, origin.Y
+	return r
+}
+
+// Inset returns the rect shrunk by n pixels on every side (grown when n is
+// negative). The result is empty when the rect is too small.
+func (r Rect) Inset(n int16) Rect {
+	left, top, right, bottom := r.edges()
+	inset := int32(n)
+	left, top, right, bottom = left+inset, top+inset, right-inset, bottom-inset
+	if left >= right || top >= bottom {
+		return Rect{}
+	}
+	return rectFromEdges(int32(helpers.ClampInt16(left)), int32(helpers.ClampInt16(top)), right, bottom)
+}
+
+// Clamp returns the point inside the rect closest to p.
+// The result is meaningless for empty rects.
+func (r Rect) Clamp(p Point) Point {
+	maxPoint := r.Max()
+	return Point{
+		X: min(max(p.X, r.X), maxPoint.X),
+		Y: min(max(p.Y, r.Y), maxPoint.Y),
+	}
+}
+
+// * ----- Polar coordinates -----
+
+// PolarToScreen returns the pixel at radius pixels from the center (cx, cy)
+// in the direction of angle, in the 0..255 units of the drawing methods
+// (0 = right, 64 = up, counter-clockwise), using the sine lookup table.
+// A compass bearing b (0 = north, clockwise) is the angle 64-b. The result
+// saturates at the int16 limits.
+func PolarToScreen(cx, cy, radius int16, angle uint8) Point {
+	return Point{
+		X: helpers.ClampInt16(int32(cx) + int32(helpers.MulTrig(radius, helpers.Cos256(angle)))),
+		Y: helpers.ClampInt16(int32(cy) - int32(helpers.MulTrig(radius, helpers.Sin256(angle)))),
+	}
+}
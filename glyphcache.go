@@ -0,0 +1,121 @@
+package t8go
+
+import "github.com/redghc/t8go/framebuf"
+
+// glyphCache keeps the rendered bitmaps of the most recently drawn scaled or
+// rotated glyphs (see T8Go.SetGlyphCache).
+type glyphCache struct {
+	entries []glyphEntry // Cached glyphs (empty when disabled)
+	tick    uint32       // Use counter, advanced by every lookup
+}
+
+// glyphEntry is a glyph rendered at a scale and rotation.
+type glyphEntry struct {
+	font     *Font           // Font of the glyph
+	glyph    *Glyph          // Glyph in font.Glyphs
+	scale    int32           // Text scale the bitmap was rendered at
+	rotation TextRotation    // Text rotation the bitmap was rendered at
+	used     uint32          // Tick of the last use (0 = empty slot)
+	bitmap   framebuf.Buffer // Rendered glyph box, lit where the glyph is drawn
+}
+
+// SetGlyphCache keeps the rendered bitmaps of up to entries glyphs drawn
+// scaled or rotated, so hot glyphs, such as the digits of a big clock, are
+// copied into the display buffer a byte at a time instead of being
+// rasterized again. The least recently used glyph is dropped when the cache
+// is full. Each entry holds one glyph box of bitmap, allocated when first
+// used; 0 (the default) disables the cache. Cached glyphs are drawn only
+// when the driver exposes its buffer, no pixel filter or clip circle is set,
+// the draw mode is not ModeXOR, no color display takes the draw color and
+// the glyph is not cut by a clip edge; other glyphs are drawn as usual.
+// Setting the cache again empties it, which is needed after changing the
+// bitmaps of a font in place.
+func (t *T8Go) SetGlyphCache(entries uint8) {
+	t.glyphs = glyphCache{entries: make([]glyphEntry, entries)}
+}
+
+// cachedGlyph draws glyph at the pen from the glyph cache, rendering it into
+// the cache first when missing, and reports whether it did. (minX, minY) -
+// (maxX, maxY) is the display box of the glyph.
+func (t *T8Go) cachedGlyph(pen *textPen, font *Font, glyph *Glyph, minX, minY, maxX, maxY int32) bool {
+	ink, uniform := t.ink()
+	if len(t.glyphs.entries) == 0 || pen.mapped || pen.scale == 1 && pen.rotation == Rotate0 ||
+		!uniform || t.tinted() || t.direct.Data == nil || t.filtered() || t.clipped() {
+		return false
+	}
+	clip := &pen.clip
+	if minX < clip.minX || minY < clip.minY || maxX > clip.maxX || maxY > clip.maxY {
+		return false
+	}
+
+	entry, ok := t.glyphs.lookup(font, glyph, pen.scale, pen.rotation)
+	if !ok {
+		entry.render()
+	}
+	t.countWrites(minX, minY, maxX-minX+1, maxY-minY+1)
+	t.direct.Stamp(int(minX), int(minY), entry.bitmap, pen.on == ink)
+	return true
+}
+
+// lookup returns the entry of glyph at scale and rotation, or false with the
+// least recently used entry taken over for it, still to be rendered.
+func (c *glyphCache) lookup(font *Font, glyph *Glyph, scale int32, rotation TextRotation) (*glyphEntry, bool) {
+	c.tick++
+	if c.tick == 0 {
+		// The counter wrapped around: start over with an empty cache
+		for i := range c.entries {
+			c.entries[i].used = 0
+		}
+		c.tick = 1
+	}
+
+	oldest := &c.entries[0]
+	for i := range c.entries {
+		entry := &c.entries[i]
+		if entry.used != 0 && entry.glyph == glyph && entry.font == font &&
+			entry.scale == scale && entry.rotation == rotation {
+			entry.used = c.tick
+			return entry, true
+		}
+		if entry.used < oldest.used {
+			oldest = entry
+		}
+	}
+
+	oldest.font, oldest.glyph, oldest.scale, oldest.rotation = font, glyph, scale, rotation
+	oldest.used = c.tick
+	return oldest, false
+}
+
+// render draws the glyph of entry into its bitmap, the glyph box turned by
+// the rotation of the entry, reusing the bitmap memory when large enough.
+func (entry *glyphEntry) render() {
+	glyph, scale := entry.glyph, entry.scale
+	width, height := int32(glyph.Width), int32(glyph.Height)
+	left, top := int32(glyph.XOffset)*scale, int32(glyph.YOffset)*scale
+
+	frame := textPen{scale: scale, rotation: entry.rotation}
+	boxMinX, boxMinY, boxMaxX, boxMaxY := frame.box(left, top, left+width*scale-1, top+height*scale-1)
+	bitmap := framebuf.Buffer{Width: int(boxMaxX - boxMinX + 1), Height: int(boxMaxY - boxMinY + 1)}
+	size := framebuf.Size(bitmap.Width, bitmap.Height)
+	if cap(entry.bitmap.Data) >= size {
+		bitmap.Data = entry.bitmap.Data[:size]
+		clear(bitmap.Data)
+	} else {
+		bitmap.Data = make([]byte, size)
+	}
+
+	font := entry.font
+	bit := int(glyph.Offset) * 8
+	for row := range height {
+		for col := range width {
+			if bit>>3 < len(font.Bitmap) && font.Bitmap[bit>>3]&(0x80>>(bit&7)) != 0 {
+				along, across := left+col*scale, top+row*scale
+				minX, minY, maxX, maxY := frame.box(along, across, along+scale-1, across+scale-1)
+				bitmap.FillRect(int(minX-boxMinX), int(minY-boxMinY), int(maxX-minX+1), int(maxY-minY+1), true)
+			}
+			bit++
+		}
+	}
+	entry.bitmap = bitmap
+}
@@ -0,0 +1,154 @@
+package t8go
+
+import "github.com/redghc/t8go/framebuf"
+
+// GrayLevel is a perceived brightness of pixels tagged with Grayscale.Tag,
+// from GrayOff to the number of levels minus one (full brightness).
+type GrayLevel uint8
+
+// Gray levels of a four-level Grayscale. With three levels, GrayDark is half
+// brightness and GrayLight is already full brightness.
+const (
+	GrayOff   GrayLevel = iota // Never lit
+	GrayDark                   // Lit one frame of three (one of two with three levels)
+	GrayLight                  // Lit two frames of three
+	GrayFull                   // Always lit
+)
+
+// Grayscale emulates gray levels on monochrome panels by frame modulation:
+// the lit pixels of tagged regions are only shown in some frames, and the
+// eye averages them to an intermediate brightness. It suits OLEDs flushed at
+// 50 frames per second or more; slower panels flicker.
+//
+// Draw the frame as usual, tag the regions to show in gray and call Display
+// once per frame (RunLoop does with LoopConfig.Gray). The buffer keeps the
+// drawn frame: pixels are only hidden while flushing. Neighboring pixels are
+// lit in different frames, which keeps the flicker of large areas low.
+type Grayscale struct {
+	ctx     IDisplayDrawer // Drawing context to flush
+	clock   *FrameClock    // Frame clock giving the modulation phase (nil: count Display calls)
+	cycle   uint8          // Frames per modulation cycle (levels - 1)
+	frames  uint32         // Display calls, the phase without a clock
+	regions []grayRegion   // Tagged regions
+}
+
+// grayRegion is a region tagged with a gray level.
+type grayRegion struct {
+	area  Rect            // Tagged pixels
+	level GrayLevel       // Brightness of the lit pixels
+	saved framebuf.Buffer // Lit pixels of the area while flushing
+}
+
+// NewGrayscale creates a frame modulator for ctx with 3 or 4 levels, including
+// off and full brightness (other values are clamped). Four levels need a
+// three-frame cycle and flicker more than three. The phase follows
+// clock.Frame when clock is not nil, so it stays in step with the frames
+// paced by the clock.
+func NewGrayscale(ctx IDisplayDrawer, clock *FrameClock, levels uint8) *Grayscale {
+	return &Grayscale{ctx: ctx, clock: clock, cycle: min(max(levels, 3), 4) - 1}
+}
+
+// Tag shows the lit pixels of region at level from the next Display on.
+// Tagging a region again changes its level; overlapping regions should not
+// be tagged. Levels above full brightness are shown fully lit.
+func (g *Grayscale) Tag(region Rect, level GrayLevel) {
+	region = region.Intersect(g.ctx.Bounds())
+	if region.Empty() {
+		return
+	}
+	for i := range g.regions {
+		if g.regions[i].area == region {
+			g.regions[i].level = level
+			return
+		}
+	}
+
+	width, height := int(region.Width), int(region.Height)
+	saved := framebuf.Buffer{Data: make([]byte, framebuf.Size(width, height)), Width: width, Height: height}
+	g.regions = append(g.regions, grayRegion{area: region, level: level, saved: saved})
+}
+
+// Untag shows region at full brightness again.
+func (g *Grayscale) Untag(region Rect) {
+	region = region.Intersect(g.ctx.Bounds())
+	for i := range g.regions {
+		if g.regions[i].area == region {
+			g.regions = append(g.regions[:i], g.regions[i+1:]...)
+			return
+		}
+	}
+}
+
+// Clear removes every tag.
+func (g *Grayscale) Clear() {
+	g.regions = g.regions[:0]
+}
+
+// Display flushes the whole buffer with the tagged regions modulated for the
+// current frame.
+func (g *Grayscale) Display() error {
+	g.hide()
+	err := g.ctx.Display()
+	g.restore()
+	return err
+}
+
+// Update flushes only the tagged regions, for frames in which nothing else
+// changed. It falls back to Display on drivers without region flushes.
+func (g *Grayscale) Update() error {
+	var storage [8]Rect
+	areas := storage[:0]
+	for i := range g.regions {
+		areas = append(areas, g.regions[i].area)
+	}
+
+	g.hide()
+	err := g.ctx.FlushRegions(areas...)
+	g.restore()
+	return err
+}
+
+// hide saves the tagged regions and turns off their pixels that are dark in
+// the current frame.
+func (g *Grayscale) hide() {
+	phase := g.frames
+	if g.clock != nil {
+		phase = g.clock.Frame()
+	}
+	g.frames++
+
+	display := g.ctx.GetDisplay()
+	cycle := uint32(g.cycle)
+	for i := range g.regions {
+		region := &g.regions[i]
+		area := region.area
+		level := uint32(region.level)
+		for y := range area.Height {
+			for x := range area.Width {
+				px, py := area.X+x, area.Y+y
+				on := display.GetPixel(px, py)
+				region.saved.SetPixel(int(x), int(y), on)
+				// Neighbors are out of step, so large areas do not pulse
+				if on && (phase+uint32(px)+uint32(py))%cycle >= level {
+					display.SetPixel(px, py, false)
+				}
+			}
+		}
+	}
+}
+
+// restore puts back the pixels hidden by hide.
+func (g *Grayscale) restore() {
+	display := g.ctx.GetDisplay()
+	for i := range g.regions {
+		region := &g.regions[i]
+		area := region.area
+		for y := range area.Height {
+			for x := range area.Width {
+				if region.saved.GetPixel(int(x), int(y)) {
+					display.SetPixel(area.X+x, area.Y+y, true)
+				}
+			}
+		}
+	}
+}
@@ -4,6 +4,8 @@
 // and coordinate transformations optimized for embedded systems.
 package helpers
 
+import "math"
+
 // Abs returns the absolute value of a signed integer (keeps original type).
 func Abs[T ~int | ~int16 | ~int32 | ~int64](value T) T {
 	if value < 0 {
@@ -34,6 +36,11 @@ func Direction[T ~int | ~int16 | ~int32 | ~int64](length T) int16 {
 	}
 }
 
+// ClampInt16 converts value to int16, saturating at the int16 limits instead of wrapping.
+func ClampInt16(value int32) int16 {
+	return int16(min(max(value, math.MinInt16), math.MaxInt16))
+}
+
 // NormalizeRect returns the top-left origin and the positive width/height
 // for a rectangle defined by two corners (x0,y0)-(x1,y1), inclusive.
 func NormalizeRect(x0, y0, x1, y1 int16) (originX, originY, width, height int16) {
@@ -96,69 +103,21 @@ func ArcAngleDistance(a, b uint8) uint8 {
 
 // AngleEndpoint returns the endpoint (endX, endY) of a line that starts at (originX, originY),
 // has the given length (pixels, origin included), and angle in 0..255 units (64=90°, 128=180°, 192=270°).
+// The endpoint lies length-1 pixels from the origin (Euclidean distance, rounded), so lines
+// of equal length have the same extent at every angle. Y grows downward on screen, so 64 points up.
 // Negative length flips the direction by +180° and uses its absolute value.
 func AngleEndpoint(originX, originY, length int16, angle uint8) (endX, endY int16) {
-	if length == 0 {
-		return originX, originY
-	}
-
 	// Normalize negative length by flipping the direction.
 	if length < 0 {
 		length = -length
 		angle += 128
 	}
 
-	// Steps along the major axis (origin counts as the first pixel).
-	chebyshev := length - 1
-	if chebyshev <= 0 {
+	// Distance from the origin (origin counts as the first pixel).
+	distance := length - 1
+	if distance <= 0 {
 		return originX, originY
 	}
 
-	// Axis-aligned fast paths (exact endpoints, zero error).
-	if angle%64 == 0 {
-		switch angle {
-		case 0:
-			return originX + chebyshev, originY
-		case 64:
-			return originX, originY - chebyshev
-		case 128:
-			return originX - chebyshev, originY
-		case 192:
-			return originX, originY + chebyshev
-		default:
-			// e.g., 255≈360° → treat as 0°
-			return originX + chebyshev, originY
-		}
-	}
-
-	// Octant decomposition (32 units per octant).
-	octant := int(angle / 32)  // 0..7
-	intra := int16(angle % 32) // 0..31
-
-	// Major axis per octant.
-	majorIsX := (octant == 0 || octant == 3 || octant == 4 || octant == 7)
-
-	// Integer minor component with rounding.
-	var absDX, absDY int16
-	if majorIsX {
-		absDX = chebyshev
-		absDY = int16((int32(chebyshev)*int32(intra) + 16) / 32) // ≈ round(chebyshev*intra/32)
-	} else {
-		absDY = chebyshev
-		absDX = int16((int32(chebyshev)*int32(32-intra) + 16) / 32) // ≈ round(chebyshev*(32-intra)/32)
-	}
-
-	// Signs in screen coordinates (Y grows downward).
-	switch octant {
-	case 0, 1: // right/up-ish and up/right-ish
-		absDX, absDY = +absDX, -absDY
-	case 2, 3: // up/left-ish and left/up-ish
-		absDX, absDY = -absDX, -absDY
-	case 4, 5: // left/down-ish and down/left-ish
-		absDX, absDY = -absDX, +absDY
-	default: // 6, 7: down/right-ish and right/down-ish
-		absDX, absDY = +absDX, +absDY
-	}
-
-	return originX + absDX, originY + absDY
+	return originX + MulTrig(distance, Cos256(angle)), originY - MulTrig(distance, Sin256(angle))
 }
@@ -0,0 +1,48 @@
+package helpers
+
+// TrigShift is the number of fractional bits of the values returned by
+// Sin256 and Cos256 (1.0 == 1<<TrigShift == 16384).
+const TrigShift = 14
+
+// quarterSine holds round(sin(i * 90° / 64) * 16384) for i in 0..64.
+var quarterSine = [65]int16{
+	0, 402, 804, 1205, 1606, 2006, 2404, 2801,
+	3196, 3590, 3981, 4370, 4756, 5139, 5520, 5897,
+	6270, 6639, 7005, 7366, 7723, 8076, 8423, 8765,
+	9102, 9434, 9760, 10080, 10394, 10702, 11003, 11297,
+	11585, 11866, 12140, 12406, 12665, 12916, 13160, 13395,
+	13623, 13842, 14053, 14256, 14449, 14635, 14811, 14978,
+	15137, 15286, 15426, 15557, 15679, 15791, 15893, 15986,
+	16069, 16143, 16207, 16261, 16305, 16340, 16364, 16379,
+	16384,
+}
+
+// Sin256 returns the sine of angle (0..255 units, 64=90°) scaled by 1<<TrigShift.
+// The result is in -16384..16384 and uses a quarter-wave lookup table.
+func Sin256(angle uint8) int16 {
+	switch quarter, index := angle>>6, angle&63; quarter {
+	case 0:
+		return quarterSine[index]
+	case 1:
+		return quarterSine[64-index]
+	case 2:
+		return -quarterSine[index]
+	default:
+		return -quarterSine[64-index]
+	}
+}
+
+// Cos256 returns the cosine of angle (0..255 units, 64=90°) scaled by 1<<TrigShift.
+func Cos256(angle uint8) int16 {
+	return Sin256(angle + 64)
+}
+
+// MulTrig multiplies value by a Sin256/Cos256 result and rounds to the nearest
+// integer (halves away from zero), e.g. MulTrig(radius, Cos256(angle)).
+func MulTrig(value, trig int16) int16 {
+	product := int32(value) * int32(trig)
+	if product < 0 {
+		return -int16((-product + 1<<(TrigShift-1)) >> TrigShift)
+	}
+	return int16((product + 1<<(TrigShift-1)) >> TrigShift)
+}
@@ -0,0 +1,47 @@
+package weather
+
+// Condition is a weather condition, the common ground of the codes reported
+// by weather services.
+type Condition uint8
+
+const (
+	Unknown      Condition = iota // Code not recognized
+	Clear                         // Clear sky
+	PartlyCloudy                  // Few or scattered clouds
+	Cloudy                        // Broken clouds
+	Overcast                      // Sky covered by clouds
+	Fog                           // Fog, mist, haze, smoke or dust
+	Drizzle                       // Drizzle
+	Rain                          // Rain and showers
+	Snow                          // Snow, snow grains and snow showers
+	Sleet                         // Freezing rain, rain and snow mixed, ice pellets
+	Storm                         // Thunderstorm, squalls or tornado
+)
+
+// Icon identifies a bitmap of the weather icon set.
+type Icon uint8
+
+const (
+	IconClear Icon = iota
+	IconClearNight
+	IconPartlyCloudy
+	IconPartlyCloudyNight
+	IconCloudy
+	IconOvercast
+	IconFog
+	IconDrizzle
+	IconRain
+	IconSnow
+	IconSleet
+	IconStorm
+	IconMoonNew
+	IconMoonWaxingCrescent
+	IconMoonFirstQuarter
+	IconMoonWaxingGibbous
+	IconMoonFull
+	IconMoonWaningGibbous
+	IconMoonLastQuarter
+	IconMoonWaningCrescent
+
+	iconCount
+)
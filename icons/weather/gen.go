@@ -0,0 +1,370 @@
+//go:build ignore
+
+// gen draws the weather icons with t8go primitives and writes them to
+// icons.go. Run it with go generate after changing a drawing; -preview also
+// prints every icon as text.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"math"
+	"os"
+
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/drivers/memory"
+)
+
+// mask is a square bitmap used to compose icons from filled shapes.
+type mask struct {
+	size   int
+	pixels []bool
+}
+
+// newMask returns an empty mask of size x size pixels.
+func newMask(size int) *mask {
+	return &mask{size: size, pixels: make([]bool, size*size)}
+}
+
+func (m *mask) get(x, y int) bool {
+	return x >= 0 && y >= 0 && x < m.size && y < m.size && m.pixels[y*m.size+x]
+}
+
+func (m *mask) set(x, y int, on bool) {
+	if x >= 0 && y >= 0 && x < m.size && y < m.size {
+		m.pixels[y*m.size+x] = on
+	}
+}
+
+// draw rasterizes shape with t8go and sets (or clears, when on is false) the
+// pixels it lights.
+func (m *mask) draw(on bool, shape func(ctx t8go.IDisplayDrawer)) {
+	display, err := memory.New(memory.Config{Width: uint16(m.size), Height: uint16(m.size)})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx := t8go.New(display)
+	shape(ctx)
+	for y := range m.size {
+		for x := range m.size {
+			if ctx.GetPixel(int16(x), int16(y)) {
+				m.set(x, y, on)
+			}
+		}
+	}
+}
+
+// outline returns the pixels of m that have an unset 4-neighbor.
+func (m *mask) outline() *mask {
+	out := newMask(m.size)
+	for y := range m.size {
+		for x := range m.size {
+			if m.get(x, y) && (!m.get(x-1, y) || !m.get(x+1, y) || !m.get(x, y-1) || !m.get(x, y+1)) {
+				out.set(x, y, true)
+			}
+		}
+	}
+	return out
+}
+
+// grow returns m expanded by one pixel in every direction.
+func (m *mask) grow() *mask {
+	out := newMask(m.size)
+	for y := range m.size {
+		for x := range m.size {
+			if m.get(x, y) || m.get(x-1, y) || m.get(x+1, y) || m.get(x, y-1) || m.get(x, y+1) {
+				out.set(x, y, true)
+			}
+		}
+	}
+	return out
+}
+
+// paint sets the pixels of other in m; cut clears them.
+func (m *mask) paint(other *mask) {
+	for i, on := range other.pixels {
+		m.pixels[i] = m.pixels[i] || on
+	}
+}
+
+func (m *mask) cut(other *mask) {
+	for i, on := range other.pixels {
+		m.pixels[i] = m.pixels[i] && !on
+	}
+}
+
+// bytes packs m in the page layout.
+func (m *mask) bytes() []byte {
+	data := make([]byte, m.size*((m.size+7)/8))
+	for y := range m.size {
+		for x := range m.size {
+			if m.get(x, y) {
+				data[x+(y/8)*m.size] |= 1 << (y % 8)
+			}
+		}
+	}
+	return data
+}
+
+// * ----- Shapes -----
+
+// v scales a coordinate given for a 32 pixel icon to size.
+func v(size int, value float64) int16 {
+	return int16(math.Round(value * float64(size) / 32))
+}
+
+// sun draws a sun with rays centered at (cx, cy) for a 32 pixel icon.
+func sun(size int, cx, cy, radius float64) *mask {
+	m := newMask(size)
+	m.draw(true, func(ctx t8go.IDisplayDrawer) {
+		r := v(size, radius)
+		ctx.DrawCircle(v(size, cx), v(size, cy), r, t8go.DrawAll)
+		if size >= 32 {
+			ctx.DrawCircle(v(size, cx), v(size, cy), r-1, t8go.DrawAll)
+		}
+		for i := range 8 {
+			angle := float64(i) * math.Pi / 4
+			inner, outer := radius+3, radius+6
+			if size < 32 {
+				inner, outer = radius+4, radius+7
+			}
+			ctx.DrawLine(
+				v(size, cx+inner*math.Cos(angle)), v(size, cy-inner*math.Sin(angle)),
+				v(size, cx+outer*math.Cos(angle)), v(size, cy-outer*math.Sin(angle)))
+		}
+	})
+	return m
+}
+
+// crescent draws a crescent moon centered at (cx, cy) for a 32 pixel icon.
+func crescent(size int, cx, cy, radius float64) *mask {
+	m := newMask(size)
+	m.draw(true, func(ctx t8go.IDisplayDrawer) {
+		ctx.DrawCircleFill(v(size, cx), v(size, cy), v(size, radius), t8go.DrawAll)
+	})
+	m.draw(false, func(ctx t8go.IDisplayDrawer) {
+		ctx.DrawCircleFill(v(size, cx+radius*0.6), v(size, cy-radius*0.45), v(size, radius*0.85), t8go.DrawAll)
+	})
+	return m.outline()
+}
+
+// cloudShape returns the filled outline of a cloud whose base spans x0..x1
+// at y (coordinates of a 32 pixel icon).
+func cloudShape(size int, x0, x1, y float64) *mask {
+	m := newMask(size)
+	w := x1 - x0
+	m.draw(true, func(ctx t8go.IDisplayDrawer) {
+		ctx.DrawCircleFill(v(size, x0+w*0.26), v(size, y-w*0.2), v(size, w*0.2), t8go.DrawAll)
+		ctx.DrawCircleFill(v(size, x0+w*0.55), v(size, y-w*0.3), v(size, w*0.28), t8go.DrawAll)
+		ctx.DrawCircleFill(v(size, x0+w*0.8), v(size, y-w*0.18), v(size, w*0.18), t8go.DrawAll)
+		ctx.DrawBoxFillCoords(v(size, x0+w*0.22), v(size, y-w*0.2), v(size, x0+w*0.82), v(size, y))
+	})
+	return m
+}
+
+// cloud draws a cloud over base, clearing a gap around it.
+func cloud(base *mask, shape *mask) {
+	base.cut(shape.grow())
+	base.paint(shape.outline())
+}
+
+// drops draws short slanted lines below a cloud for rain and sleet.
+func drops(size int, m *mask, xs []float64, y float64, length float64) {
+	m.draw(true, func(ctx t8go.IDisplayDrawer) {
+		for _, x := range xs {
+			ctx.DrawLine(v(size, x), v(size, y), v(size, x-length/2), v(size, y+length))
+		}
+	})
+}
+
+// flakes draws snowflakes (small crosses, or dots at 16 pixels).
+func flakes(size int, m *mask, points [][2]float64) {
+	m.draw(true, func(ctx t8go.IDisplayDrawer) {
+		for _, p := range points {
+			x, y := v(size, p[0]), v(size, p[1])
+			if size < 32 {
+				ctx.DrawPixel(x, y)
+				continue
+			}
+			ctx.DrawPixel(x, y)
+			ctx.DrawPixel(x-1, y)
+			ctx.DrawPixel(x+1, y)
+			ctx.DrawPixel(x, y-1)
+			ctx.DrawPixel(x, y+1)
+		}
+	})
+}
+
+// moon draws the moon at phase (0 new, 0.5 full) as a disk lit on the
+// right while waxing and on the left while waning.
+func moon(size int, phase float64) *mask {
+	c, r := int16(size/2), int16(size/2-1)
+	disk := newMask(size)
+	disk.draw(true, func(ctx t8go.IDisplayDrawer) { ctx.DrawCircleFill(c, c, r, t8go.DrawAll) })
+
+	m := disk.outline()
+	for y := range size {
+		left, right := -1, -1
+		for x := range size {
+			if disk.get(x, y) {
+				if left < 0 {
+					left = x
+				}
+				right = x
+			}
+		}
+		if left < 0 {
+			continue
+		}
+		center, half := float64(left+right)/2, float64(right-left)/2
+		edge := half * math.Cos(2*math.Pi*phase)
+		for x := left; x <= right; x++ {
+			dx := float64(x) - center
+			if (phase <= 0.5 && dx >= edge) || (phase > 0.5 && dx <= -edge) {
+				m.set(x, y, true)
+			}
+		}
+	}
+	return m
+}
+
+// * ----- Icons -----
+
+// icon is a named drawing of a weather icon.
+type icon struct {
+	name string
+	draw func(size int) *mask
+}
+
+var icons = []icon{
+	{"Clear", func(size int) *mask { return sun(size, 16, 16, 7) }},
+	{"ClearNight", func(size int) *mask { return crescent(size, 16, 16, 11) }},
+	{"PartlyCloudy", func(size int) *mask {
+		m := sun(size, 12, 11, 5)
+		cloud(m, cloudShape(size, 8, 31, 28))
+		return m
+	}},
+	{"PartlyCloudyNight", func(size int) *mask {
+		m := crescent(size, 12, 12, 8)
+		cloud(m, cloudShape(size, 8, 31, 28))
+		return m
+	}},
+	{"Cloudy", func(size int) *mask {
+		m := newMask(size)
+		cloud(m, cloudShape(size, 1, 31, 26))
+		return m
+	}},
+	{"Overcast", func(size int) *mask {
+		m := newMask(size)
+		cloud(m, cloudShape(size, 10, 31, 20))
+		cloud(m, cloudShape(size, 1, 26, 28))
+		return m
+	}},
+	{"Fog", func(size int) *mask {
+		m := newMask(size)
+		cloud(m, cloudShape(size, 3, 29, 18))
+		m.draw(true, func(ctx t8go.IDisplayDrawer) {
+			ctx.DrawHLine(v(size, 2), v(size, 22), v(size, 26))
+			ctx.DrawHLine(v(size, 6), v(size, 26), v(size, 24))
+			ctx.DrawHLine(v(size, 3), v(size, 30), v(size, 20))
+		})
+		return m
+	}},
+	{"Drizzle", func(size int) *mask {
+		m := newMask(size)
+		cloud(m, cloudShape(size, 1, 31, 20))
+		drops(size, m, []float64{10, 18, 26}, 23, 2)
+		drops(size, m, []float64{14, 22}, 28, 2)
+		return m
+	}},
+	{"Rain", func(size int) *mask {
+		m := newMask(size)
+		cloud(m, cloudShape(size, 1, 31, 20))
+		drops(size, m, []float64{10, 17, 24}, 23, 7)
+		return m
+	}},
+	{"Snow", func(size int) *mask {
+		m := newMask(size)
+		cloud(m, cloudShape(size, 1, 31, 20))
+		flakes(size, m, [][2]float64{{8, 25}, {16, 28}, {24, 25}, {12, 30}, {21, 31}})
+		return m
+	}},
+	{"Sleet", func(size int) *mask {
+		m := newMask(size)
+		cloud(m, cloudShape(size, 1, 31, 20))
+		drops(size, m, []float64{11, 23}, 23, 7)
+		flakes(size, m, [][2]float64{{16, 26}, {27, 29}})
+		return m
+	}},
+	{"Storm", func(size int) *mask {
+		m := newMask(size)
+		cloud(m, cloudShape(size, 1, 31, 20))
+		m.draw(true, func(ctx t8go.IDisplayDrawer) {
+			ctx.DrawTriangleFill(v(size, 17), v(size, 21), v(size, 11), v(size, 27), v(size, 16), v(size, 27))
+			ctx.DrawTriangleFill(v(size, 16), v(size, 25), v(size, 20), v(size, 25), v(size, 13), v(size, 32))
+		})
+		return m
+	}},
+}
+
+func main() {
+	for i := range 8 {
+		phase := float64(i) / 8
+		icons = append(icons, icon{"Moon" + moonNames[i], func(size int) *mask { return moon(size, phase) }})
+	}
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by gen.go; DO NOT EDIT.\n\npackage weather\n\n")
+	for _, size := range []int{16, 32} {
+		fmt.Fprintf(&b, "// icons%d holds the %dx%d icons in the page layout, indexed by Icon.\n", size, size, size)
+		fmt.Fprintf(&b, "var icons%d = [iconCount][%d]byte{\n", size, size*size/8)
+		for _, ic := range icons {
+			fmt.Fprintf(&b, "\tIcon%s: {", ic.name)
+			for i, value := range ic.draw(size).bytes() {
+				if i%16 == 0 {
+					b.WriteString("\n\t\t")
+				} else {
+					b.WriteByte(' ')
+				}
+				fmt.Fprintf(&b, "0x%02X,", value)
+			}
+			b.WriteString("\n\t},\n")
+		}
+		b.WriteString("}\n\n")
+	}
+
+	source, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("icons.go", source, 0o644); err != nil {
+		log.Fatal(err)
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "-preview" {
+		for _, ic := range icons {
+			for _, size := range []int{16, 32} {
+				m := ic.draw(size)
+				fmt.Println(ic.name, size)
+				for y := range size {
+					for x := range size {
+						if m.get(x, y) {
+							fmt.Print("#")
+						} else {
+							fmt.Print(".")
+						}
+					}
+					fmt.Println()
+				}
+			}
+		}
+	}
+}
+
+// moonNames names the moon phases, new moon first.
+var moonNames = [8]string{
+	"New", "WaxingCrescent", "FirstQuarter", "WaxingGibbous",
+	"Full", "WaningGibbous", "LastQuarter", "WaningCrescent",
+}
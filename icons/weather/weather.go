@@ -0,0 +1,178 @@
+// Package weather is a set of weather icons, at 16x16 and 32x32 pixels, with
+// mappers from the condition codes of common weather services.
+//
+// Conditions come from FromWMO, for the WMO present weather codes used by
+// Open-Meteo and many stations, or FromOpenWeatherMap. Condition.Icon picks
+// the icon to show, and the icon bitmaps are drawn with DrawBuffer:
+//
+//	icon := weather.FromWMO(code).Icon(night)
+//	ctx.DrawBuffer(x, y, icon.Bitmap32())
+//
+// The moon phase icons, from MoonPhase, suit clear nights and almanac pages.
+package weather
+
+//go:generate go run gen.go
+
+import (
+	"math"
+	"time"
+
+	"github.com/redghc/t8go/framebuf"
+)
+
+// synodicMonth is the mean time from new moon to new moon.
+const synodicMonth = 29.530588853 * 24 * float64(time.Hour)
+
+// newMoon is a reference new moon.
+var newMoon = time.Date(2000, time.January, 6, 18, 14, 0, 0, time.UTC)
+
+// Bitmap16 returns the 16x16 bitmap of i, or an empty buffer for an unknown
+// icon. The bitmap shares the icon data and must not be modified.
+func (i Icon) Bitmap16() framebuf.Buffer {
+	if i >= iconCount {
+		return framebuf.Buffer{}
+	}
+	return framebuf.Buffer{Data: icons16[i][:], Width: 16, Height: 16}
+}
+
+// Bitmap32 returns the 32x32 bitmap of i, or an empty buffer for an unknown
+// icon. The bitmap shares the icon data and must not be modified.
+func (i Icon) Bitmap32() framebuf.Buffer {
+	if i >= iconCount {
+		return framebuf.Buffer{}
+	}
+	return framebuf.Buffer{Data: icons32[i][:], Width: 32, Height: 32}
+}
+
+// Icon returns the icon showing c, with the moon in place of the sun at
+// night. Unknown conditions show as cloudy.
+func (c Condition) Icon(night bool) Icon {
+	switch c {
+	case Clear:
+		if night {
+			return IconClearNight
+		}
+		return IconClear
+	case PartlyCloudy:
+		if night {
+			return IconPartlyCloudyNight
+		}
+		return IconPartlyCloudy
+	case Overcast:
+		return IconOvercast
+	case Fog:
+		return IconFog
+	case Drizzle:
+		return IconDrizzle
+	case Rain:
+		return IconRain
+	case Snow:
+		return IconSnow
+	case Sleet:
+		return IconSleet
+	case Storm:
+		return IconStorm
+	default:
+		return IconCloudy
+	}
+}
+
+// MoonPhase returns the moon phase icon for t, from the mean lunar month.
+// It can be off by a few hours near a phase change, which is a fraction of
+// the 3.7 days each icon stands for.
+func MoonPhase(t time.Time) Icon {
+	age := math.Mod(float64(t.Sub(newMoon))/synodicMonth, 1)
+	if age < 0 {
+		age++
+	}
+	return IconMoonNew + Icon(int(age*8+0.5)%8)
+}
+
+// * ----- Condition Codes -----
+
+// FromWMO maps a WMO present weather code (table 4677, 0-99) to a condition.
+// The subset reported as weather_code by Open-Meteo maps as documented
+// there: 0 is a clear sky, 1 and 2 partly cloudy and 3 overcast.
+func FromWMO(code int) Condition {
+	switch {
+	case code < 0 || code > 99:
+		return Unknown
+	case code == 0:
+		return Clear
+	case code <= 2:
+		return PartlyCloudy
+	case code == 3:
+		return Overcast
+	case code <= 12:
+		return Fog // Haze, smoke, dust, mist and shallow fog
+	case code == 13 || (code >= 17 && code <= 19):
+		return Storm // Lightning, thunder, squalls and funnel clouds
+	case code <= 16:
+		return Rain // Precipitation within sight
+	case code <= 29:
+		return recent[code-20]
+	case code <= 35:
+		return Fog // Dust and sand storms
+	case code <= 39:
+		return Snow // Drifting and blowing snow
+	case code <= 49:
+		return Fog
+	case code <= 55:
+		return Drizzle
+	case code <= 57:
+		return Sleet // Freezing drizzle
+	case code <= 65:
+		return Rain // Drizzle and rain (58, 59) and rain
+	case code <= 69:
+		return Sleet // Freezing rain, rain and snow
+	case code <= 78:
+		return Snow
+	case code == 79:
+		return Sleet // Ice pellets
+	case code <= 82:
+		return Rain // Rain showers
+	case code <= 84:
+		return Sleet // Rain and snow showers
+	case code <= 86:
+		return Snow // Snow showers
+	case code <= 90:
+		return Sleet // Snow pellets and hail
+	default:
+		return Storm
+	}
+}
+
+// recent maps the WMO codes 20-29, weather of the preceding hour.
+var recent = [10]Condition{Drizzle, Rain, Snow, Sleet, Sleet, Rain, Snow, Sleet, Fog, Storm}
+
+// FromOpenWeatherMap maps an OpenWeatherMap condition id to a condition.
+func FromOpenWeatherMap(id int) Condition {
+	switch {
+	case id >= 200 && id < 300:
+		return Storm
+	case id >= 300 && id < 400:
+		return Drizzle
+	case id == 511:
+		return Sleet // Freezing rain
+	case id >= 500 && id < 600:
+		return Rain
+	case id >= 611 && id <= 616:
+		return Sleet // Sleet, rain and snow
+	case id >= 600 && id < 700:
+		return Snow
+	case id == 771 || id == 781:
+		return Storm // Squalls and tornado
+	case id >= 700 && id < 800:
+		return Fog // Mist, smoke, haze, dust, fog, sand and ash
+	case id == 800:
+		return Clear
+	case id == 801 || id == 802:
+		return PartlyCloudy
+	case id == 803:
+		return Cloudy
+	case id == 804:
+		return Overcast
+	default:
+		return Unknown
+	}
+}
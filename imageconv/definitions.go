@@ -0,0 +1,42 @@
+package imageconv
+
+import "errors"
+
+// Dither selects how gray levels are turned into on and off pixels.
+type Dither uint8
+
+const (
+	DitherFloydSteinberg Dither = iota // Error diffusion: smooth gradients for photos (default)
+	DitherBayer                        // 4x4 ordered pattern: stable, regular texture for UI art and animation
+	DitherThreshold                    // Hard threshold: crisp edges for icons and line art
+	DitherStucki                       // Wide error diffusion that stops at edges: fine, unbanded texture for e-paper photos
+	DitherBlueNoise                    // 16x16 blue-noise pattern: ordered but without the grid of Bayer, for e-paper
+)
+
+// Options tunes the conversion of an image to a monochrome buffer. The zero
+// value converts at the source size with Floyd-Steinberg dithering and no
+// adjustment. Adjustments are applied in order: equalization, contrast,
+// brightness, gamma and inversion. EPaper returns options tuned for e-paper.
+type Options struct {
+	Width  int // Output width in pixels (0 = source width, or scaled with Height)
+	Height int // Output height in pixels (0 = source height, or scaled with Width)
+
+	Dither    Dither // Dithering method
+	Threshold uint8  // Gray level from which pixels turn on (0 = 128)
+
+	// EdgeThreshold is the gray difference between two pixels from which
+	// DitherStucki stops spreading error from one to the other, which keeps
+	// edges crisp (0 = 96; 255 spreads error everywhere).
+	EdgeThreshold uint8
+
+	Equalize   bool   // Spread the gray levels evenly over the full range (histogram equalization), for flat or dark photos
+	Brightness int16  // Added to every gray level, -255..255 (0 = unchanged)
+	Contrast   int16  // Contrast change in percent around mid gray, -100 (flat) .. (0 = unchanged)
+	Gamma      uint16 // Gamma in hundredths: above 100 lifts the mid tones, below 100 darkens them (0 = 100)
+	Invert     bool   // Turn dark areas on instead of light ones, for dark-on-light artwork
+}
+
+// Common errors returned by the imageconv package.
+var (
+	ErrInvalidDimensions = errors.New("invalid image dimensions") // The source or output size is zero
+)
@@ -0,0 +1,329 @@
+// Package imageconv converts images to monochrome page buffers for t8go. A
+// conversion resamples the image to gray levels, adjusts their contrast,
+// brightness and gamma, and dithers them to on and off pixels, so imported
+// photos and icons can be tuned to the response of an OLED or e-paper panel
+// without an external editor. Light areas turn pixels on, as on an OLED;
+// Options.Invert turns dark areas on instead.
+package imageconv
+
+import (
+	"image"
+	"math"
+
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/framebuf"
+)
+
+// bayer4x4 is the ordered dither matrix used by DitherBayer (values 0..15).
+var bayer4x4 = [4][4]uint8{
+	{0, 8, 2, 10},
+	{12, 4, 14, 6},
+	{3, 11, 1, 9},
+	{15, 7, 13, 5},
+}
+
+// blueNoise16 is the threshold pattern used by DitherBlueNoise: the ranks
+// 0..255 of a 16x16 void-and-cluster blue-noise mask, which tiles without
+// visible seams.
+var blueNoise16 = [16][16]uint8{
+	{39, 135, 227, 150, 61, 246, 170, 92, 143, 163, 127, 190, 171, 145, 30, 219},
+	{100, 249, 5, 201, 104, 220, 79, 116, 51, 254, 106, 59, 45, 242, 75, 183},
+	{55, 175, 69, 128, 34, 18, 179, 210, 3, 196, 23, 226, 94, 17, 114, 208},
+	{123, 24, 194, 91, 241, 156, 136, 66, 230, 148, 76, 177, 133, 200, 152, 235},
+	{83, 142, 161, 214, 53, 111, 189, 41, 97, 122, 35, 160, 248, 65, 1, 37},
+	{224, 103, 46, 233, 10, 74, 251, 27, 166, 239, 204, 9, 109, 89, 185, 169},
+	{255, 14, 182, 120, 147, 199, 87, 221, 140, 58, 84, 216, 48, 232, 126, 54},
+	{77, 197, 67, 32, 173, 131, 6, 108, 180, 15, 117, 174, 139, 28, 212, 149},
+	{113, 134, 218, 98, 245, 56, 211, 154, 47, 195, 247, 72, 157, 192, 96, 21},
+	{167, 238, 2, 158, 82, 25, 236, 70, 95, 225, 31, 105, 7, 63, 244, 40},
+	{88, 57, 187, 43, 202, 138, 115, 188, 20, 146, 129, 205, 234, 121, 178, 206},
+	{228, 151, 125, 107, 222, 176, 38, 164, 252, 60, 86, 168, 42, 80, 141, 13},
+	{33, 73, 19, 250, 64, 8, 78, 124, 209, 0, 229, 186, 22, 217, 162, 102},
+	{191, 207, 172, 144, 93, 231, 193, 101, 49, 153, 112, 137, 99, 52, 253, 130},
+	{237, 110, 50, 215, 29, 159, 132, 240, 68, 181, 36, 243, 71, 198, 4, 62},
+	{165, 16, 81, 184, 118, 44, 12, 203, 26, 223, 85, 11, 213, 119, 155, 90},
+}
+
+// stucki lists the Stucki error diffusion weights (out of 42) by row offset
+// and column offset in the scan direction.
+var stucki = [...]struct {
+	dy, dx int
+	weight int32
+}{
+	{0, 1, 8}, {0, 2, 4},
+	{1, -2, 2}, {1, -1, 4}, {1, 0, 8}, {1, 1, 4}, {1, 2, 2},
+	{2, -2, 1}, {2, -1, 2}, {2, 0, 4}, {2, 1, 2}, {2, 2, 1},
+}
+
+// EPaper returns options tuned for photos on e-paper, whose reflective
+// contrast shows the regular texture of ordered dithering as bands: histogram
+// equalization and edge-preserving Stucki dithering at width x height.
+func EPaper(width, height int) Options {
+	return Options{Width: width, Height: height, Dither: DitherStucki, Equalize: true}
+}
+
+// Convert resamples img to the size in opts and converts it to a monochrome
+// buffer in the page layout used by t8go displays.
+func Convert(img image.Image, opts Options) (framebuf.Buffer, error) {
+	width, height := opts.size(img.Bounds().Dx(), img.Bounds().Dy())
+	if width <= 0 || height <= 0 {
+		return framebuf.Buffer{}, ErrInvalidDimensions
+	}
+	return ConvertGray(Gray(img, width, height), opts), nil
+}
+
+// ConvertGray adjusts and dithers gray, already at its final size, to a
+// monochrome buffer. The gray image is modified by the adjustments.
+func ConvertGray(gray *image.Gray, opts Options) framebuf.Buffer {
+	width, height := gray.Rect.Dx(), gray.Rect.Dy()
+	if opts.Equalize {
+		equalize(gray)
+	}
+	levels := opts.Levels()
+	for y := range height {
+		row := gray.Pix[y*gray.Stride : y*gray.Stride+width]
+		for x, value := range row {
+			row[x] = levels[value]
+		}
+	}
+
+	threshold := int16(opts.Threshold)
+	if threshold == 0 {
+		threshold = 128
+	}
+
+	out := framebuf.Buffer{
+		Data:   make([]byte, framebuf.Size(width, height)),
+		Width:  width,
+		Height: height,
+	}
+	switch opts.Dither {
+	case DitherBayer:
+		ditherBayer(gray, out, threshold)
+	case DitherBlueNoise:
+		for y := range height {
+			for x := range width {
+				limit := threshold - 128 + int16(blueNoise16[y&15][x&15])
+				out.SetPixel(x, y, int16(gray.Pix[y*gray.Stride+x]) > limit)
+			}
+		}
+	case DitherStucki:
+		edge := int16(opts.EdgeThreshold)
+		if edge == 0 {
+			edge = 96
+		}
+		ditherStucki(gray, out, threshold, edge)
+	case DitherThreshold:
+		for y := range height {
+			for x := range width {
+				out.SetPixel(x, y, int16(gray.Pix[y*gray.Stride+x]) >= threshold)
+			}
+		}
+	default:
+		ditherFloydSteinberg(gray, out, threshold)
+	}
+	return out
+}
+
+// equalize remaps the gray levels of gray so that every level is about
+// equally common (histogram equalization). Images of a single level are left
+// unchanged.
+func equalize(gray *image.Gray) {
+	width, height := gray.Rect.Dx(), gray.Rect.Dy()
+	var histogram [256]int
+	for y := range height {
+		for _, value := range gray.Pix[y*gray.Stride : y*gray.Stride+width] {
+			histogram[value]++
+		}
+	}
+
+	// Cumulative counts, starting from the darkest level in use
+	total, lowest := width*height, 0
+	for _, count := range histogram {
+		if count > 0 {
+			lowest = count
+			break
+		}
+	}
+	if total <= lowest {
+		return
+	}
+
+	var levels [256]uint8
+	cumulative := 0
+	for level, count := range histogram {
+		cumulative += count
+		levels[level] = uint8(max(cumulative-lowest, 0) * 255 / (total - lowest))
+	}
+	for y := range height {
+		row := gray.Pix[y*gray.Stride : y*gray.Stride+width]
+		for x, value := range row {
+			row[x] = levels[value]
+		}
+	}
+}
+
+// Levels returns the adjustment curve of opts: the output gray level of every
+// input gray level after contrast, brightness, gamma and inversion.
+func (o Options) Levels() [256]uint8 {
+	gamma := float64(o.Gamma) / 100
+	if gamma <= 0 {
+		gamma = 1
+	}
+
+	var levels [256]uint8
+	for input := range levels {
+		value := (int32(input)-128)*(100+int32(o.Contrast))/100 + 128 + int32(o.Brightness)
+		value = max(min(value, 255), 0)
+		if gamma != 1 {
+			value = int32(math.Round(255 * math.Pow(float64(value)/255, 1/gamma)))
+		}
+		if o.Invert {
+			value = 255 - value
+		}
+		levels[input] = uint8(value)
+	}
+	return levels
+}
+
+// size returns the output size for a width x height source, keeping the
+// aspect ratio when only one of Width and Height is set.
+func (o Options) size(width, height int) (int, int) {
+	switch {
+	case o.Width > 0 && o.Height > 0:
+		return o.Width, o.Height
+	case o.Width > 0 && width > 0:
+		return o.Width, max((height*o.Width+width/2)/width, 1)
+	case o.Height > 0 && height > 0:
+		return max((width*o.Height+height/2)/height, 1), o.Height
+	}
+	return width, height
+}
+
+// Gray converts img to gray levels at width x height, averaging the source
+// pixels covered by each output pixel. Transparent pixels count as black.
+func Gray(img image.Image, width, height int) *image.Gray {
+	bounds := img.Bounds()
+	gray := image.NewGray(image.Rect(0, 0, width, height))
+	if bounds.Empty() {
+		return gray
+	}
+
+	for y := range height {
+		top := bounds.Min.Y + y*bounds.Dy()/height
+		bottom := max(bounds.Min.Y+(y+1)*bounds.Dy()/height, top+1)
+		for x := range width {
+			left := bounds.Min.X + x*bounds.Dx()/width
+			right := max(bounds.Min.X+(x+1)*bounds.Dx()/width, left+1)
+
+			var sum, count uint64
+			for sy := top; sy < bottom; sy++ {
+				for sx := left; sx < right; sx++ {
+					// Premultiplied colors blend transparent pixels with black
+					r, g, b, _ := img.At(sx, sy).RGBA()
+					sum += (19595*uint64(r) + 38470*uint64(g) + 7471*uint64(b)) >> 16
+					count++
+				}
+			}
+			gray.Pix[y*gray.Stride+x] = uint8(sum / count >> 8)
+		}
+	}
+	return gray
+}
+
+// Draw draws the lit pixels of img with its top-left corner at (x, y); off
+// pixels leave the display unchanged.
+func Draw(ctx t8go.IDisplayDrawer, x, y int16, img framebuf.Buffer) {
+	for py := range img.Height {
+		for px := range img.Width {
+			if img.GetPixel(px, py) {
+				ctx.DrawPixel(x+int16(px), y+int16(py))
+			}
+		}
+	}
+}
+
+// ditherBayer sets the pixels of out whose gray level exceeds the ordered
+// dither pattern, centered on threshold.
+func ditherBayer(gray *image.Gray, out framebuf.Buffer, threshold int16) {
+	for y := range out.Height {
+		for x := range out.Width {
+			limit := threshold - 120 + int16(bayer4x4[y&3][x&3])*16
+			out.SetPixel(x, y, int16(gray.Pix[y*gray.Stride+x]) >= limit)
+		}
+	}
+}
+
+// ditherFloydSteinberg thresholds every pixel and spreads the error to its
+// unvisited neighbors, 7/16 right and 3/16, 5/16 and 1/16 on the next row.
+// Rows alternate direction to avoid drifting artifacts.
+func ditherFloydSteinberg(gray *image.Gray, out framebuf.Buffer, threshold int16) {
+	width := out.Width
+	// Error rows with a guard column on each side
+	current := make([]int16, width+2)
+	next := make([]int16, width+2)
+
+	for y := range out.Height {
+		clear(next)
+		step, start, end := 1, 0, width
+		if y&1 == 1 {
+			step, start, end = -1, width-1, -1
+		}
+
+		for x := start; x != end; x += step {
+			value := int16(gray.Pix[y*gray.Stride+x]) + current[x+1]/16
+			on := value >= threshold
+			out.SetPixel(x, y, on)
+			if on {
+				value -= 255
+			}
+
+			current[x+1+step] += value * 7
+			next[x+1-step] += value * 3
+			next[x+1] += value * 5
+			next[x+1+step] += value
+		}
+		current, next = next, current
+	}
+}
+
+// ditherStucki diffuses the error of every pixel over the next two rows with
+// the Stucki weights, serpentine like ditherFloydSteinberg, but not into
+// neighbors whose gray level differs by more than edge, so the error of one
+// side of an edge does not blur the other.
+func ditherStucki(gray *image.Gray, out framebuf.Buffer, threshold, edge int16) {
+	width, height := out.Width, out.Height
+	// Weighted errors of the current and the next two rows
+	rows := [3][]int32{make([]int32, width), make([]int32, width), make([]int32, width)}
+
+	for y := range height {
+		step, start, end := 1, 0, width
+		if y&1 == 1 {
+			step, start, end = -1, width-1, -1
+		}
+
+		for x := start; x != end; x += step {
+			source := int16(gray.Pix[y*gray.Stride+x])
+			value := int32(source) + rows[0][x]/42
+			on := value >= int32(threshold)
+			out.SetPixel(x, y, on)
+			if on {
+				value -= 255
+			}
+
+			for _, w := range stucki {
+				nx, ny := x+w.dx*step, y+w.dy
+				if nx < 0 || nx >= width || ny >= height {
+					continue
+				}
+				if diff := int16(gray.Pix[ny*gray.Stride+nx]) - source; diff > edge || -diff > edge {
+					continue
+				}
+				rows[w.dy][nx] += value * w.weight
+			}
+		}
+
+		rows[0], rows[1], rows[2] = rows[1], rows[2], rows[0]
+		clear(rows[2])
+	}
+}
@@ -0,0 +1,64 @@
+package input
+
+import "sync/atomic"
+
+// IHandler is implemented by widgets and screens that react to input events.
+type IHandler interface {
+	HandleInput(event Event) bool // HandleInput processes event and reports whether it was consumed
+}
+
+// Kind identifies the type of an input event.
+type Kind uint8
+
+const (
+	KindNone    Kind = iota // Empty event
+	KindRotate              // Encoder turned by Delta detents
+	KindPress               // Key pressed
+	KindRelease             // Key released
+	KindText                // Character typed, from a keyboard or an on-screen keyboard
+)
+
+// Key identifies a navigation key or button.
+type Key uint8
+
+const (
+	KeyNone      Key = iota // No key
+	KeyUp                   // Up, or previous item
+	KeyDown                 // Down, or next item
+	KeyLeft                 // Left
+	KeyRight                // Right
+	KeyEnter                // Confirm; also the push button of most encoders
+	KeyBack                 // Cancel or go back
+	KeyBackspace            // Delete the character before the cursor
+	KeyDelete               // Delete the character after the cursor
+	KeyHome                 // Move to the start
+	KeyEnd                  // Move to the end
+)
+
+// Event is a single input event, small enough to be queued from interrupts.
+type Event struct {
+	Kind  Kind  // Type of event
+	Key   Key   // Key of KindPress and KindRelease events
+	Delta int16 // Detents turned by KindRotate events (positive is clockwise)
+	Rune  rune  // Character of KindText events
+}
+
+// Queue buffers events between the code reading the hardware and the render
+// loop handling them. It holds a fixed number of events, so pushing never
+// allocates, and one producer (such as a pin interrupt) and one consumer may
+// use it concurrently.
+type Queue struct {
+	events []Event       // Ring buffer of events
+	head   atomic.Uint32 // Number of events popped
+	tail   atomic.Uint32 // Number of events pushed
+}
+
+// Encoder decodes the two quadrature signals of a rotary encoder into
+// KindRotate events. Update it with the pin levels on every change (from a
+// pin interrupt) or by polling often enough to see every transition.
+type Encoder struct {
+	StepsPerDetent uint8 // Quadrature transitions per detent (0 selects 4, the most common)
+
+	state uint8 // Last pin levels, A in bit 1 and B in bit 0
+	steps int8  // Transitions since the last detent, positive clockwise
+}
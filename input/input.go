@@ -0,0 +1,117 @@
+// Package input provides a small abstraction for buttons and rotary encoders:
+// events, a fixed-size queue that interrupt handlers can push to, and a
+// quadrature decoder. Widgets that accept input implement IHandler.
+package input
+
+// quadrature maps the previous and current pin levels (previous<<2 | current)
+// to a transition: +1 clockwise, -1 counterclockwise, 0 for no change or a
+// skipped (invalid) transition.
+var quadrature = [16]int8{
+	0, -1, 1, 0,
+	1, 0, 0, -1,
+	-1, 0, 0, 1,
+	0, 1, -1, 0,
+}
+
+// * ----- Queue -----
+
+// NewQueue creates a queue holding up to size events (at least one).
+func NewQueue(size int) *Queue {
+	return &Queue{events: make([]Event, max(size, 1))}
+}
+
+// Push appends event and reports whether it fit; events are dropped while
+// the queue is full.
+func (q *Queue) Push(event Event) bool {
+	tail := q.tail.Load()
+	if tail-q.head.Load() >= uint32(len(q.events)) {
+		return false
+	}
+	q.events[tail%uint32(len(q.events))] = event
+	q.tail.Store(tail + 1)
+	return true
+}
+
+// Pop removes and returns the oldest event; ok is false when the queue is empty.
+func (q *Queue) Pop() (event Event, ok bool) {
+	head := q.head.Load()
+	if head == q.tail.Load() {
+		return Event{}, false
+	}
+	event = q.events[head%uint32(len(q.events))]
+	q.head.Store(head + 1)
+	return event, true
+}
+
+// Len returns the number of queued events.
+func (q *Queue) Len() int {
+	return int(q.tail.Load() - q.head.Load())
+}
+
+// Dispatch pops every queued event and passes it to handler, and returns
+// the number of events the handler consumed.
+func (q *Queue) Dispatch(handler IHandler) int {
+	consumed := 0
+	for {
+		event, ok := q.Pop()
+		if !ok {
+			return consumed
+		}
+		if handler.HandleInput(event) {
+			consumed++
+		}
+	}
+}
+
+// * ----- Encoder -----
+
+// Update takes the current levels of the A and B pins and returns the
+// detents turned since the previous call: +1 clockwise, -1 counterclockwise
+// or 0 while between detents. Clockwise means A changes before B; swap the
+// pins if the encoder turns the other way.
+func (e *Encoder) Update(a, b bool) int16 {
+	var current uint8
+	if a {
+		current |= 2
+	}
+	if b {
+		current |= 1
+	}
+
+	e.steps += quadrature[e.state<<2|current]
+	e.state = current
+
+	perDetent := int8(e.StepsPerDetent)
+	if perDetent == 0 {
+		perDetent = 4
+	}
+	switch {
+	case e.steps >= perDetent:
+		e.steps = 0
+		return 1
+	case e.steps <= -perDetent:
+		e.steps = 0
+		return -1
+	}
+	return 0
+}
+
+// Rotate returns a KindRotate event for delta detents, for pushing to a Queue.
+func Rotate(delta int16) Event {
+	return Event{Kind: KindRotate, Delta: delta}
+}
+
+// Press returns a KindPress event for key.
+func Press(key Key) Event {
+	return Event{Kind: KindPress, Key: key}
+}
+
+// Text returns a KindText event for r.
+func Text(r rune) Event {
+	return Event{Kind: KindText, Rune: r}
+}
+
+// Release returns a KindRelease event for key.
+func Release(key Key) Event {
+	return Event{Kind: KindRelease, Key: key}
+}
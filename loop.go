@@ -0,0 +1,79 @@
+package t8go
+
+import "time"
+
+// LoopConfig configures a fixed-timestep loop started with RunLoop.
+type LoopConfig struct {
+	Step     time.Duration // Fixed update step (default: 1/60 s)
+	MaxSteps uint8         // Maximum updates per rendered frame before time is dropped (default: 5)
+	Clock    *FrameClock   // Frame clock used for pacing and measuring (default: 30 FPS clock)
+	Async    bool          // Flush frames with DisplayAsync instead of Display
+	Gray     *Grayscale    // Flush frames with Gray.Display to show gray regions (Async is ignored)
+}
+
+// RunLoop runs a fixed-timestep loop: update is called with a constant step as
+// many times as needed to catch up with real time, then draw renders the frame
+// and the buffer is flushed. The alpha argument of draw (0..255) is the fraction
+// of a step elapsed since the last update, for interpolating positions.
+//
+// The loop runs until update returns false or a flush fails, in which case the
+// flush error is returned.
+func RunLoop(ctx IDisplayDrawer, config LoopConfig, update func(dt time.Duration) bool, draw func(ctx IDisplayDrawer, alpha uint8)) error {
+	if config.Step <= 0 {
+		config.Step = time.Second / 60
+	}
+	if config.MaxSteps == 0 {
+		config.MaxSteps = 5
+	}
+	if config.Clock == nil {
+		config.Clock = NewFrameClock(30)
+	}
+
+	// Async flushes report their result from another goroutine.
+	flushErr := make(chan error, 1)
+	onFlushed := func(err error) {
+		if err != nil {
+			select {
+			case flushErr <- err:
+			default:
+			}
+		}
+	}
+
+	accumulator := config.Step // Run one update before the first frame
+	for {
+		steps := uint8(0)
+		for accumulator >= config.Step {
+			if steps == config.MaxSteps {
+				// Too far behind: drop the backlog instead of spiralling.
+				accumulator %= config.Step
+				break
+			}
+			if !update(config.Step) {
+				return nil
+			}
+			accumulator -= config.Step
+			steps++
+		}
+
+		draw(ctx, uint8(accumulator*256/config.Step))
+
+		if config.Gray != nil {
+			if err := config.Gray.Display(); err != nil {
+				return err
+			}
+		} else if config.Async {
+			ctx.DisplayAsync(onFlushed)
+		} else if err := ctx.Display(); err != nil {
+			return err
+		}
+
+		select {
+		case err := <-flushErr:
+			return err
+		default:
+		}
+
+		accumulator += config.Clock.Tick()
+	}
+}
@@ -0,0 +1,66 @@
+//go:build t8go_minimal
+
+package t8go
+
+// The t8go_minimal build tag compiles out the ellipse and arc rasterizers,
+// the tracing hooks, the pixel filter and the Font5x7 tables for
+// flash-constrained targets. The methods stay on IDisplayDrawer so code keeps
+// building, but draw nothing and record ErrUnsupported (see Err).
+
+const traceEnabled = false // SetTracer records ErrUnsupported and never calls the tracer
+
+const filterEnabled = false // SetPixelFilter records ErrUnsupported and never calls the filter
+
+// defaultFont has no glyphs, so text records ErrUnsupported and draws
+// nothing until SetFont selects a font.
+var defaultFont = &Font{Name: "none"}
+
+// DrawEllipse records ErrUnsupported in t8go_minimal builds.
+func (t *T8Go) DrawEllipse(centerX, centerY, radiusX, radiusY int16, mask DrawQuadrants) {
+	t.drawEllipse(centerX, centerY, radiusX, radiusY, mask)
+}
+
+// drawEllipse records ErrUnsupported in t8go_minimal builds.
+func (t *T8Go) drawEllipse(centerX, centerY, radiusX, radiusY int16, mask DrawQuadrants) {
+	t.setErr(ErrUnsupported)
+}
+
+// DrawEllipseFill records ErrUnsupported in t8go_minimal builds.
+func (t *T8Go) DrawEllipseFill(centerX, centerY, radiusX, radiusY int16, mask DrawQuadrants) {
+	t.drawEllipseFill(centerX, centerY, radiusX, radiusY, mask)
+}
+
+// drawEllipseFill records ErrUnsupported in t8go_minimal builds.
+func (t *T8Go) drawEllipseFill(centerX, centerY, radiusX, radiusY int16, mask DrawQuadrants) {
+	t.setErr(ErrUnsupported)
+}
+
+// DrawArc records ErrUnsupported in t8go_minimal builds.
+func (t *T8Go) DrawArc(centerX, centerY, radius int16, angleStart, angleEnd uint8) {
+	t.drawArc(centerX, centerY, radius, angleStart, angleEnd)
+}
+
+// drawArc records ErrUnsupported in t8go_minimal builds.
+func (t *T8Go) drawArc(centerX, centerY, radius int16, angleStart, angleEnd uint8) {
+	t.setErr(ErrUnsupported)
+}
+
+// DrawArcThick records ErrUnsupported in t8go_minimal builds.
+func (t *T8Go) DrawArcThick(centerX, centerY, radius, thickness int16, angleStart, angleEnd uint8) {
+	t.drawArcThick(centerX, centerY, radius, thickness, angleStart, angleEnd)
+}
+
+// drawArcThick records ErrUnsupported in t8go_minimal builds.
+func (t *T8Go) drawArcThick(centerX, centerY, radius, thickness int16, angleStart, angleEnd uint8) {
+	t.setErr(ErrUnsupported)
+}
+
+// DrawArcFill records ErrUnsupported in t8go_minimal builds.
+func (t *T8Go) DrawArcFill(centerX, centerY, radius int16, angleStart, angleEnd uint8) {
+	t.drawArcFill(centerX, centerY, radius, angleStart, angleEnd)
+}
+
+// drawArcFill records ErrUnsupported in t8go_minimal builds.
+func (t *T8Go) drawArcFill(centerX, centerY, radius int16, angleStart, angleEnd uint8) {
+	t.setErr(ErrUnsupported)
+}
@@ -0,0 +1,75 @@
+package t8go
+
+// SetDrawMode selects how the drawing primitives change the pixels they
+// cover: ModeSet sets them to the draw color (on unless changed with
+// SetDrawColor), ModeClear turns them off and ModeXOR flips them, so
+// drawing a cursor or a rubber-band selection twice restores what was below
+// it. The mode applies to every Draw* call, including the lit pixels of
+// DrawBuffer and DrawNinePatch, and to the glyphs of text; SetPixel and
+// ClearRegion write the pixels they are given as they are. Every call draws
+// each of its pixels once, so shapes keep their seams and corners in
+// ModeXOR. XOR reads every pixel back, so it bypasses the span fast paths.
+// The mode is part of the graphics state saved by PushState.
+func (t *T8Go) SetDrawMode(mode DrawMode) {
+	t.state.mode = mode
+}
+
+// GetDrawMode returns the current draw mode.
+func (t *T8Go) GetDrawMode() DrawMode {
+	return t.state.mode
+}
+
+// SetDrawColor selects the color of the drawing primitives in ModeSet:
+// ColorOn (the default) draws shapes and ColorOff erases them, so a moving
+// sprite is removed by drawing it again in ColorOff instead of clearing the
+// screen. Color displays (IColorDisplay) draw in the color itself, pixel by
+// pixel; monochrome displays draw the pixels on or off per Color.IsOn.
+// Inverted text swaps the colors of its box and glyphs, and ModeClear and
+// ModeXOR ignore the color. The color is part of the graphics state saved by
+// PushState.
+func (t *T8Go) SetDrawColor(color Color) {
+	t.state.color = color
+}
+
+// GetDrawColor returns the current draw color.
+func (t *T8Go) GetDrawColor() Color {
+	return t.state.color
+}
+
+// ink returns the state the drawing primitives give to the pixels they
+// cover, and false in ModeXOR, where it depends on each pixel. On color
+// displays every color but ColorOff draws, in the draw color (see tinted).
+func (t *T8Go) ink() (on bool, uniform bool) {
+	switch t.state.mode {
+	case ModeClear:
+		return false, true
+	case ModeXOR:
+		return false, false
+	}
+	if t.colors != nil {
+		return t.state.color != ColorOff, true
+	}
+	return t.state.color.IsOn(), true
+}
+
+// tinted reports whether the pixels the primitives turn on take the draw
+// color, which only color displays show. The span fast paths only know on
+// and off, so tinted drawing goes pixel by pixel.
+func (t *T8Go) tinted() bool {
+	color := t.state.color
+	return t.colors != nil && t.state.mode == ModeSet && color != ColorOn && color != ColorOff
+}
+
+// paint draws the pixel at (x, y) in the current draw mode and color.
+func (t *T8Go) paint(x, y int16) {
+	on, uniform := t.ink()
+	if !uniform {
+		on = !t.GetPixel(x, y)
+	}
+	t.countWrites(int32(x), int32(y), 1, 1)
+	if on && t.tinted() {
+		t.writeColor(x, y, t.state.color)
+		return
+	}
+	t.writePixel(x, y, on)
+}
@@ -0,0 +1,74 @@
+package t8go
+
+import (
+	"unsafe"
+
+	"github.com/redghc/t8go/fixed"
+	"github.com/redghc/t8go/helpers"
+)
+
+// maxDecimals is the largest number of decimals DrawFixed draws, about the
+// precision of the 16 fractional bits of Q16.16.
+const maxDecimals = 4
+
+// DrawInt draws value in decimal like DrawText. The digits are written into
+// a buffer kept by the context, so counters and readouts can be drawn every
+// frame without allocating.
+func (t *T8Go) DrawInt(x, y int16, value int32) {
+	if t.traced() {
+		t.tracer("DrawInt", x, y, helpers.ClampInt16(value))
+	}
+
+	magnitude := uint64(helpers.Abs(int64(value)))
+	t.drawText(x, y, t.formatDecimal(value < 0, magnitude, 0))
+}
+
+// DrawFixed draws value in decimal with the given number of decimals (at
+// most 4), rounded to the nearest, like DrawText and without allocating, for
+// readouts such as 21.5° or 3.30 V.
+func (t *T8Go) DrawFixed(x, y int16, value fixed.Q16, decimals uint8) {
+	if t.traced() {
+		t.tracer("DrawFixed", x, y, value.Round(), int16(decimals))
+	}
+
+	decimals = min(decimals, maxDecimals)
+	scale := uint64(1)
+	for range decimals {
+		scale *= 10
+	}
+
+	// Round the scaled magnitude, half away from zero
+	half := uint64(1) << (fixed.Q16Shift - 1)
+	magnitude := (uint64(helpers.Abs(int64(value)))*scale + half) >> fixed.Q16Shift
+	t.drawText(x, y, t.formatDecimal(value < 0 && magnitude > 0, magnitude, int(decimals)))
+}
+
+// formatDecimal writes magnitude into t.digits with a decimal point before
+// its last decimals digits, and a minus sign when negative. The returned
+// string shares the buffer and is only valid until the next call.
+func (t *T8Go) formatDecimal(negative bool, magnitude uint64, decimals int) string {
+	buf := &t.digits
+	i := len(buf)
+	for range decimals {
+		i--
+		buf[i] = byte('0' + magnitude%10)
+		magnitude /= 10
+	}
+	if decimals > 0 {
+		i--
+		buf[i] = '.'
+	}
+	for {
+		i--
+		buf[i] = byte('0' + magnitude%10)
+		magnitude /= 10
+		if magnitude == 0 {
+			break
+		}
+	}
+	if negative {
+		i--
+		buf[i] = '-'
+	}
+	return unsafe.String(&buf[i], len(buf)-i)
+}
@@ -0,0 +1,66 @@
+package panel
+
+import "errors"
+
+// COMPins selects how the COM outputs of the controller are wired to the rows
+// of the panel (command 0xDA).
+type COMPins string
+
+const (
+	COMPinsAuto        COMPins = ""            // Sequential for 32-row panels, alternative for the others
+	COMPinsSequential  COMPins = "sequential"  // Rows follow the COM outputs in order (most 128x32 modules)
+	COMPinsAlternative COMPins = "alternative" // Rows alternate between both sides of the die (most 128x64 modules)
+)
+
+// Description describes the geometry and electrical setup of a panel driven
+// by an SSD1306-compatible controller. It is the input format of
+// cmd/t8gopanel, written as JSON with the tag names below; zero values select
+// the settings of common 128x64 modules.
+type Description struct {
+	Name    string `json:"name"`              // Go identifier of the generated table
+	Comment string `json:"comment,omitempty"` // Description of the panel for the generated doc comment
+
+	Width         uint8 `json:"width"`                    // Visible columns (1-128)
+	Height        uint8 `json:"height"`                   // Visible rows, a multiple of 8 (8-64)
+	ColumnOffset  uint8 `json:"column_offset,omitempty"`  // First controller column wired to the panel
+	DisplayOffset uint8 `json:"display_offset,omitempty"` // Vertical shift of the COM outputs (0-63)
+	StartLine     uint8 `json:"start_line,omitempty"`     // RAM row shown on the top row (0-63)
+
+	MirrorX      bool    `json:"mirror_x,omitempty"`       // Map column 0 to SEG0 instead of SEG127, mirroring the image horizontally
+	MirrorY      bool    `json:"mirror_y,omitempty"`       // Scan from COM0 instead of COM[N-1], mirroring the image vertically
+	COMPins      COMPins `json:"com_pins,omitempty"`       // COM wiring (default: chosen from Height)
+	COMLeftRight bool    `json:"com_left_right,omitempty"` // Swap the left and right COM outputs
+
+	ExternalVCC bool  `json:"external_vcc,omitempty"` // Supply the panel externally instead of through the charge pump
+	Contrast    uint8 `json:"contrast,omitempty"`     // Initial contrast (default: 0xCF, 0x9F with ExternalVCC)
+	PreCharge   uint8 `json:"precharge,omitempty"`    // Pre-charge periods (default: 0xF1, 0x22 with ExternalVCC)
+	VCOMH       uint8 `json:"vcomh,omitempty"`        // VCOMH deselect level (default: 0x20)
+	Clock       uint8 `json:"clock,omitempty"`        // Clock divide ratio and oscillator frequency (default: 0x80)
+}
+
+// Command is one controller command of an initialization sequence, with its
+// parameters.
+type Command struct {
+	Bytes   []byte // Command byte followed by its parameters
+	Comment string // What the command configures
+}
+
+// Table is the compiled form of a Description, as generated by cmd/t8gopanel,
+// so drivers get the sequence without parsing anything at run time. Init
+// configures geometry and timing with the display off; drivers then set the
+// charge pump and contrast and turn the display on, so they can keep the
+// brightness and sleep state when initializing again.
+type Table struct {
+	Width        uint8  // Visible columns
+	Height       uint8  // Visible rows
+	ColumnOffset uint8  // First controller column wired to the panel
+	ChargePump   bool   // Whether the internal charge pump supplies the panel
+	Contrast     uint8  // Initial contrast
+	Init         []byte // Initialization commands, sent as one command stream
+}
+
+// Common errors returned by the panel package.
+var (
+	ErrInvalidGeometry = errors.New("invalid panel geometry") // Width, Height, offsets or start line out of range
+	ErrInvalidCOMPins  = errors.New("unknown COM pin wiring") // COMPins is not one of the COMPins constants
+)
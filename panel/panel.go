@@ -0,0 +1,114 @@
+// Package panel describes OLED panels driven by SSD1306-compatible
+// controllers and builds their initialization sequences: multiplex ratio,
+// offsets, segment and COM remapping, COM wiring and charge pump. Panels with
+// odd geometries are described once, compiled to a Table by cmd/t8gopanel and
+// passed to the driver, without forking it.
+package panel
+
+// Controller commands used by the initialization sequence.
+const (
+	cmdDisplayOff     = 0xAE
+	cmdClock          = 0xD5
+	cmdMultiplex      = 0xA8
+	cmdDisplayOffset  = 0xD3
+	cmdStartLine      = 0x40
+	cmdAddressingMode = 0x20
+	cmdSegmentRemap   = 0xA0
+	cmdCOMScanInc     = 0xC0
+	cmdCOMScanDec     = 0xC8
+	cmdCOMPins        = 0xDA
+	cmdPreCharge      = 0xD9
+	cmdVCOMH          = 0xDB
+	cmdResume         = 0xA4
+	cmdNormal         = 0xA6
+	cmdScrollOff      = 0x2E
+)
+
+// Validate checks that d describes a panel the controller can drive.
+func (d Description) Validate() error {
+	if d.Width == 0 || d.Width > 128 || int(d.ColumnOffset)+int(d.Width) > 128 ||
+		d.Height < 8 || d.Height > 64 || d.Height%8 != 0 ||
+		d.DisplayOffset > 63 || d.StartLine > 63 {
+		return ErrInvalidGeometry
+	}
+	switch d.COMPins {
+	case COMPinsAuto, COMPinsSequential, COMPinsAlternative:
+		return nil
+	}
+	return ErrInvalidCOMPins
+}
+
+// Commands returns the initialization commands of d, without the charge
+// pump, contrast and display on commands that drivers send afterwards.
+func (d Description) Commands() []Command {
+	segmentRemap, segmentComment := byte(cmdSegmentRemap|0x01), "Column 0 on SEG127"
+	if d.MirrorX {
+		segmentRemap, segmentComment = cmdSegmentRemap, "Column 0 on SEG0"
+	}
+	comScan, comScanComment := byte(cmdCOMScanDec), "Scan from COM[N-1] to COM0"
+	if d.MirrorY {
+		comScan, comScanComment = cmdCOMScanInc, "Scan from COM0 to COM[N-1]"
+	}
+
+	pins, pinsComment := byte(0x02), "Sequential COM pins"
+	if d.COMPins == COMPinsAlternative || d.COMPins == COMPinsAuto && d.Height != 32 {
+		pins, pinsComment = 0x12, "Alternative COM pins"
+	}
+	if d.COMLeftRight {
+		pins, pinsComment = pins|0x20, pinsComment+", left/right swapped"
+	}
+
+	return []Command{
+		{Bytes: []byte{cmdDisplayOff}, Comment: "Display off"},
+		{Bytes: []byte{cmdClock, orDefault(d.Clock, 0x80)}, Comment: "Clock divide ratio and oscillator frequency"},
+		{Bytes: []byte{cmdMultiplex, d.Height - 1}, Comment: "Multiplex ratio (rows - 1)"},
+		{Bytes: []byte{cmdDisplayOffset, d.DisplayOffset}, Comment: "Display offset"},
+		{Bytes: []byte{cmdStartLine | d.StartLine}, Comment: "Start line"},
+		{Bytes: []byte{cmdAddressingMode, 0x00}, Comment: "Horizontal addressing"},
+		{Bytes: []byte{segmentRemap}, Comment: segmentComment},
+		{Bytes: []byte{comScan}, Comment: comScanComment},
+		{Bytes: []byte{cmdCOMPins, pins}, Comment: pinsComment},
+		{Bytes: []byte{cmdPreCharge, orDefault(d.PreCharge, d.vcc(0xF1, 0x22))}, Comment: "Pre-charge periods"},
+		{Bytes: []byte{cmdVCOMH, orDefault(d.VCOMH, 0x20)}, Comment: "VCOMH deselect level"},
+		{Bytes: []byte{cmdResume}, Comment: "Show the RAM contents"},
+		{Bytes: []byte{cmdNormal}, Comment: "Normal (not inverted) display"},
+		{Bytes: []byte{cmdScrollOff}, Comment: "Stop scrolling"},
+	}
+}
+
+// Table compiles d into a Table, after checking it with Validate.
+func (d Description) Table() (Table, error) {
+	if err := d.Validate(); err != nil {
+		return Table{}, err
+	}
+
+	var init []byte
+	for _, command := range d.Commands() {
+		init = append(init, command.Bytes...)
+	}
+	return Table{
+		Width:        d.Width,
+		Height:       d.Height,
+		ColumnOffset: d.ColumnOffset,
+		ChargePump:   !d.ExternalVCC,
+		Contrast:     orDefault(d.Contrast, d.vcc(0xCF, 0x9F)),
+		Init:         init,
+	}, nil
+}
+
+// vcc returns pump for panels supplied by the charge pump and external for
+// externally supplied ones.
+func (d Description) vcc(pump, external uint8) uint8 {
+	if d.ExternalVCC {
+		return external
+	}
+	return pump
+}
+
+// orDefault returns value, or fallback when value is zero.
+func orDefault(value, fallback uint8) uint8 {
+	if value == 0 {
+		return fallback
+	}
+	return value
+}
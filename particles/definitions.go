@@ -0,0 +1,46 @@
+package particles
+
+import (
+	"time"
+
+	"github.com/redghc/t8go/fixed"
+)
+
+// Particle is a single point with a fixed-point position and velocity.
+// Positions are in pixels and velocities in pixels per second, both Q16.16.
+type Particle struct {
+	X, Y   fixed.Q16     // Position in pixels
+	VX, VY fixed.Q16     // Velocity in pixels/second
+	Life   time.Duration // Remaining lifetime
+}
+
+// Sprite is a small packed monochrome image drawn for each particle.
+// Rows are stored MSB-first, each row padded to a whole byte.
+type Sprite struct {
+	Width  uint8  // Sprite width in pixels
+	Height uint8  // Sprite height in pixels
+	Data   []byte // Packed rows, (Width+7)/8 bytes per row
+}
+
+// Emitter describes how new particles are spawned.
+// Random spreads are applied symmetrically around the base values.
+type Emitter struct {
+	VX, VY         int16         // Base velocity in pixels/second
+	SpreadX        int16         // Random horizontal velocity spread (±) in pixels/second
+	SpreadY        int16         // Random vertical velocity spread (±) in pixels/second
+	Life           time.Duration // Base lifetime
+	LifeSpread     time.Duration // Random lifetime spread (±)
+	PositionJitter int16         // Random spawn position offset (±) in pixels
+}
+
+// System owns a fixed pool of particles and updates them without allocating.
+type System struct {
+	particles []Particle // Particle pool; active particles are kept at the front
+	active    int        // Number of active particles
+
+	GravityX int16   // Horizontal acceleration in pixels/second²
+	GravityY int16   // Vertical acceleration in pixels/second²
+	Sprite   *Sprite // Optional sprite drawn centered on each particle (nil draws pixels)
+
+	rng uint32 // Xorshift random state
+}
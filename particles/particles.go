@@ -0,0 +1,137 @@
+// Package particles provides a lightweight particle engine for monochrome
+// effects such as snow, sparks and confetti. Particles use fixed-point
+// positions and velocities and live in a pool allocated once, so updating
+// and drawing a system never allocates.
+package particles
+
+import (
+	"time"
+
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/fixed"
+)
+
+// New creates a particle system with room for capacity particles.
+func New(capacity int) *System {
+	return &System{
+		particles: make([]Particle, capacity),
+		rng:       0x9E3779B9,
+	}
+}
+
+// Seed sets the state of the random generator used by Emit.
+// A zero seed is replaced by a fixed non-zero value.
+func (s *System) Seed(seed uint32) {
+	if seed == 0 {
+		seed = 0x9E3779B9
+	}
+	s.rng = seed
+}
+
+// Emit spawns up to count particles at (x, y) using the emitter settings.
+// Returns the number of particles actually spawned, which is lower than
+// count when the pool is full or some particles draw a lifetime of zero or less.
+func (s *System) Emit(x, y int16, count int, emitter Emitter) int {
+	spawned := 0
+	for i := 0; i < count && s.active < len(s.particles); i++ {
+		life := emitter.Life + time.Duration(s.spread(int32(emitter.LifeSpread/time.Millisecond)))*time.Millisecond
+		if life <= 0 {
+			continue
+		}
+
+		s.particles[s.active] = Particle{
+			X:    fixed.Q16FromInt(x + int16(s.spread(int32(emitter.PositionJitter)))),
+			Y:    fixed.Q16FromInt(y + int16(s.spread(int32(emitter.PositionJitter)))),
+			VX:   fixed.Q16FromInt(emitter.VX + int16(s.spread(int32(emitter.SpreadX)))),
+			VY:   fixed.Q16FromInt(emitter.VY + int16(s.spread(int32(emitter.SpreadY)))),
+			Life: life,
+		}
+		s.active++
+		spawned++
+	}
+	return spawned
+}
+
+// Update advances all particles by dt, applying gravity and removing the
+// particles whose lifetime has expired.
+func (s *System) Update(dt time.Duration) {
+	seconds := fixed.Q16FromRatio(int32(dt/time.Microsecond), 1_000_000)
+	gravityX := fixed.Q16FromInt(s.GravityX).Mul(seconds)
+	gravityY := fixed.Q16FromInt(s.GravityY).Mul(seconds)
+
+	for i := 0; i < s.active; {
+		p := &s.particles[i]
+
+		p.Life -= dt
+		if p.Life <= 0 {
+			// Swap-remove keeps active particles packed at the front.
+			s.active--
+			s.particles[i] = s.particles[s.active]
+			continue
+		}
+
+		p.VX += gravityX
+		p.VY += gravityY
+		p.X += p.VX.Mul(seconds)
+		p.Y += p.VY.Mul(seconds)
+		i++
+	}
+}
+
+// Draw renders all active particles as pixels, or as the configured sprite.
+func (s *System) Draw(d t8go.IDisplayDrawer) {
+	for i := range s.active {
+		p := &s.particles[i]
+		x := p.X.Int()
+		y := p.Y.Int()
+
+		if s.Sprite == nil {
+			d.DrawPixel(x, y)
+			continue
+		}
+		s.Sprite.draw(d, x-int16(s.Sprite.Width/2), y-int16(s.Sprite.Height/2))
+	}
+}
+
+// Len returns the number of active particles.
+func (s *System) Len() int {
+	return s.active
+}
+
+// Particles returns the active particles for custom updates or rendering.
+// The slice aliases the pool and is only valid until the next Emit or Update.
+func (s *System) Particles() []Particle {
+	return s.particles[:s.active]
+}
+
+// Clear removes all particles.
+func (s *System) Clear() {
+	s.active = 0
+}
+
+// spread returns a random value in [-amount, amount].
+func (s *System) spread(amount int32) int32 {
+	if amount <= 0 {
+		return 0
+	}
+
+	// Xorshift32
+	s.rng ^= s.rng << 13
+	s.rng ^= s.rng >> 17
+	s.rng ^= s.rng << 5
+
+	return int32(s.rng%uint32(2*amount+1)) - amount
+}
+
+// draw blits the set pixels of the sprite with its top-left corner at (x, y).
+func (sp *Sprite) draw(d t8go.IDisplayDrawer, x, y int16) {
+	stride := (int(sp.Width) + 7) / 8
+	for row := range int(sp.Height) {
+		for col := range int(sp.Width) {
+			index := row*stride + col/8
+			if index < len(sp.Data) && sp.Data[index]&(0x80>>(col&7)) != 0 {
+				d.DrawPixel(x+int16(col), y+int16(row))
+			}
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package t8go
+
+import "time"
+
+// Brightness source tuning (see SetBrightnessSource).
+const (
+	BrightnessInterval = 100 * time.Millisecond // Minimum time between two readings of the source
+	BrightnessStep     = 4                      // Largest brightness change applied per reading
+)
+
+// Sleep turns the panel off to save power, keeping the buffer contents.
+// Returns ErrUnsupported if the driver does not implement IPowerManager.
+func (t *T8Go) Sleep() error {
+	power, ok := t.display.(IPowerManager)
+	if !ok {
+		return ErrUnsupported
+	}
+	err := power.Sleep()
+	t.setErr(err)
+	return err
+}
+
+// Wake turns the panel back on after Sleep.
+// Returns ErrUnsupported if the driver does not implement IPowerManager.
+func (t *T8Go) Wake() error {
+	power, ok := t.display.(IPowerManager)
+	if !ok {
+		return ErrUnsupported
+	}
+	err := power.Wake()
+	t.setErr(err)
+	return err
+}
+
+// SetBrightness sets the panel brightness, from 0 (dimmest) to 255 (brightest).
+// Returns ErrUnsupported if the driver does not implement IPowerManager.
+func (t *T8Go) SetBrightness(level uint8) error {
+	power, ok := t.display.(IPowerManager)
+	if !ok {
+		return ErrUnsupported
+	}
+	err := power.SetBrightness(level)
+	t.setErr(err)
+	if err == nil {
+		t.brightness.level, t.brightness.known = level, true
+	}
+	return err
+}
+
+// SetBrightnessSource adjusts the panel brightness automatically from source,
+// typically an ambient light sensor reading mapped to 0..255. The source is
+// read at most every BrightnessInterval, whenever the buffer is flushed or
+// UpdateBrightness is called. Readings are averaged to ignore sensor noise and
+// the brightness moves towards the average by at most BrightnessStep per
+// reading, so changes fade without visible steps. A nil source stops the
+// adjustments and keeps the current brightness.
+// Returns ErrUnsupported if the driver does not implement IPowerManager.
+func (t *T8Go) SetBrightnessSource(source func() uint8) error {
+	if _, ok := t.display.(IPowerManager); !ok && source != nil {
+		return ErrUnsupported
+	}
+	t.brightness.source = source
+	t.brightness.primed = false
+	return nil
+}
+
+// UpdateBrightness reads the brightness source and steps the panel brightness
+// towards it, if a source is set and BrightnessInterval has passed since the
+// last reading. Flushes call it, so only screens that are rarely redrawn need
+// to call it themselves. Errors are also recorded (see Err).
+func (t *T8Go) UpdateBrightness() error {
+	control := &t.brightness
+	if control.source == nil {
+		return nil
+	}
+	power, ok := t.display.(IPowerManager)
+	if !ok {
+		return nil
+	}
+
+	now := time.Now()
+	if control.primed && now.Sub(control.sampled) < BrightnessInterval {
+		return nil
+	}
+	control.sampled = now
+
+	// Exponential average over about four readings
+	reading := int32(control.source()) << 8
+	if !control.primed {
+		control.average, control.primed = reading, true
+	} else {
+		control.average += (reading - control.average) / 4
+	}
+	target := uint8((control.average + 1<<7) >> 8)
+
+	// Without a known starting point, jump straight to the first reading
+	level := target
+	if control.known {
+		level = control.level
+		switch {
+		case target > level:
+			level += min(target-level, BrightnessStep)
+		case target < level:
+			level -= min(level-target, BrightnessStep)
+		default:
+			return nil
+		}
+	}
+
+	err := power.SetBrightness(level)
+	t.setErr(err)
+	if err == nil {
+		control.level, control.known = level, true
+	}
+	return err
+}
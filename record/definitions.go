@@ -0,0 +1,71 @@
+package record
+
+import "errors"
+
+// Op identifies a recorded drawing call.
+type Op uint8
+
+const (
+	OpPixel Op = iota
+	OpLine
+	OpVLine
+	OpHLine
+	OpLineAngle
+	OpBox
+	OpBoxCoords
+	OpRoundBox
+	OpBoxFill
+	OpBoxFillCoords
+	OpRoundBoxFill
+	OpTriangle
+	OpTriangleFill
+	OpCircle
+	OpCircleFill
+	OpEllipse
+	OpEllipseFill
+	OpArc
+	OpArcFill
+	OpClearRegion
+
+	opCount // Number of defined operations
+)
+
+// Command is a single recorded drawing call. Args holds the arguments of the
+// matching t8go.IDisplayDrawer method in declaration order; angles and
+// quadrant masks are widened to int16 and unused entries are zero.
+type Command struct {
+	Op   Op       // Drawing call
+	Args [6]int16 // Call arguments
+}
+
+// Recording is a display list: a sequence of drawing calls that can be
+// replayed onto any t8go.IDisplayDrawer, optionally moved and scaled.
+// Its drawing methods mirror t8go.IDisplayDrawer and only record the call.
+// The zero value is an empty recording ready to use.
+type Recording struct {
+	commands []Command // Recorded calls, in order
+}
+
+// argKind tells how an argument is transformed on replay.
+type argKind uint8
+
+const (
+	argX      argKind = iota + 1 // Horizontal coordinate: scaled, then offset
+	argY                         // Vertical coordinate: scaled, then offset
+	argLength                    // Length, size or radius: scaled
+	argRaw                       // Angle or mask: unchanged
+)
+
+// opInfo describes the arguments of an Op.
+type opInfo struct {
+	name string    // Name of the matching IDisplayDrawer method
+	args []argKind // Kind of every argument, in order
+}
+
+// Common errors returned by the record package.
+var (
+	ErrUnknownOp = errors.New("unknown recording operation")      // Encoded data or a replayed command contains an undefined Op
+	ErrTruncated = errors.New("recording data is truncated")      // Encoded data ends inside a command
+	ErrFormat    = errors.New("data is not an encoded recording") // Encoded data has a wrong header
+	ErrSyntax    = errors.New("invalid drawing script")           // Script line has wrong or malformed arguments
+)
@@ -0,0 +1,103 @@
+package record
+
+import "github.com/redghc/t8go"
+
+// DrawPixel records a t8go.IDisplayDrawer.DrawPixel call.
+func (r *Recording) DrawPixel(x, y int16) {
+	r.add(OpPixel, x, y)
+}
+
+// DrawLine records a t8go.IDisplayDrawer.DrawLine call.
+func (r *Recording) DrawLine(startX, startY, endX, endY int16) {
+	r.add(OpLine, startX, startY, endX, endY)
+}
+
+// DrawVLine records a t8go.IDisplayDrawer.DrawVLine call.
+func (r *Recording) DrawVLine(originX, originY, length int16) {
+	r.add(OpVLine, originX, originY, length)
+}
+
+// DrawHLine records a t8go.IDisplayDrawer.DrawHLine call.
+func (r *Recording) DrawHLine(originX, originY, length int16) {
+	r.add(OpHLine, originX, originY, length)
+}
+
+// DrawLineAngle records a t8go.IDisplayDrawer.DrawLineAngle call.
+func (r *Recording) DrawLineAngle(originX, originY, length int16, angle uint8) {
+	r.add(OpLineAngle, originX, originY, length, int16(angle))
+}
+
+// DrawBox records a t8go.IDisplayDrawer.DrawBox call.
+func (r *Recording) DrawBox(originX, originY, width, height int16) {
+	r.add(OpBox, originX, originY, width, height)
+}
+
+// DrawBoxCoords records a t8go.IDisplayDrawer.DrawBoxCoords call.
+func (r *Recording) DrawBoxCoords(startX, startY, endX, endY int16) {
+	r.add(OpBoxCoords, startX, startY, endX, endY)
+}
+
+// DrawRoundBox records a t8go.IDisplayDrawer.DrawRoundBox call.
+func (r *Recording) DrawRoundBox(originX, originY, width, height, cornerRadius int16) {
+	r.add(OpRoundBox, originX, originY, width, height, cornerRadius)
+}
+
+// DrawBoxFill records a t8go.IDisplayDrawer.DrawBoxFill call.
+func (r *Recording) DrawBoxFill(originX, originY, width, height int16) {
+	r.add(OpBoxFill, originX, originY, width, height)
+}
+
+// DrawBoxFillCoords records a t8go.IDisplayDrawer.DrawBoxFillCoords call.
+func (r *Recording) DrawBoxFillCoords(startX, startY, endX, endY int16) {
+	r.add(OpBoxFillCoords, startX, startY, endX, endY)
+}
+
+// DrawRoundBoxFill records a t8go.IDisplayDrawer.DrawRoundBoxFill call.
+func (r *Recording) DrawRoundBoxFill(originX, originY, width, height, cornerRadius int16) {
+	r.add(OpRoundBoxFill, originX, originY, width, height, cornerRadius)
+}
+
+// DrawTriangle records a t8go.IDisplayDrawer.DrawTriangle call.
+func (r *Recording) DrawTriangle(x1, y1, x2, y2, x3, y3 int16) {
+	r.add(OpTriangle, x1, y1, x2, y2, x3, y3)
+}
+
+// DrawTriangleFill records a t8go.IDisplayDrawer.DrawTriangleFill call.
+func (r *Recording) DrawTriangleFill(x1, y1, x2, y2, x3, y3 int16) {
+	r.add(OpTriangleFill, x1, y1, x2, y2, x3, y3)
+}
+
+// DrawCircle records a t8go.IDisplayDrawer.DrawCircle call.
+func (r *Recording) DrawCircle(centerX, centerY, radius int16, mask t8go.DrawQuadrants) {
+	r.add(OpCircle, centerX, centerY, radius, int16(mask))
+}
+
+// DrawCircleFill records a t8go.IDisplayDrawer.DrawCircleFill call.
+func (r *Recording) DrawCircleFill(centerX, centerY, radius int16, mask t8go.DrawQuadrants) {
+	r.add(OpCircleFill, centerX, centerY, radius, int16(mask))
+}
+
+// DrawEllipse records a t8go.IDisplayDrawer.DrawEllipse call.
+func (r *Recording) DrawEllipse(centerX, centerY, radiusX, radiusY int16, mask t8go.DrawQuadrants) {
+	r.add(OpEllipse, centerX, centerY, radiusX, radiusY, int16(mask))
+}
+
+// DrawEllipseFill records a t8go.IDisplayDrawer.DrawEllipseFill call.
+func (r *Recording) DrawEllipseFill(centerX, centerY, radiusX, radiusY int16, mask t8go.DrawQuadrants) {
+	r.add(OpEllipseFill, centerX, centerY, radiusX, radiusY, int16(mask))
+}
+
+// DrawArc records a t8go.IDisplayDrawer.DrawArc call.
+func (r *Recording) DrawArc(centerX, centerY, radius int16, angleStart, angleEnd uint8) {
+	r.add(OpArc, centerX, centerY, radius, int16(angleStart), int16(angleEnd))
+}
+
+// DrawArcFill records a t8go.IDisplayDrawer.DrawArcFill call.
+func (r *Recording) DrawArcFill(centerX, centerY, radius int16, angleStart, angleEnd uint8) {
+	r.add(OpArcFill, centerX, centerY, radius, int16(angleStart), int16(angleEnd))
+}
+
+// ClearRegion records a t8go.IDisplayDrawer.ClearRegion call.
+func (r *Recording) ClearRegion(x, y, width, height int16) {
+	r.add(OpClearRegion, x, y, width, height)
+}
@@ -0,0 +1,253 @@
+// Package record provides display lists for t8go: a Recording captures a
+// sequence of drawing calls and replays them onto any t8go.IDisplayDrawer,
+// optionally at an offset and scale. Recordings can cache complex static
+// scenes, be sent over a wire with MarshalBinary, and be compared in tests.
+package record
+
+import (
+	"encoding/binary"
+
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/fixed"
+	"github.com/redghc/t8go/helpers"
+)
+
+// * ----- Definitions -----
+
+// encodingMagic starts every encoded recording, followed by the format version.
+var encodingMagic = [4]byte{'T', '8', 'R', 1}
+
+// ops describes every Op, indexed by Op.
+var ops = [opCount]opInfo{
+	OpPixel:         {"DrawPixel", []argKind{argX, argY}},
+	OpLine:          {"DrawLine", []argKind{argX, argY, argX, argY}},
+	OpVLine:         {"DrawVLine", []argKind{argX, argY, argLength}},
+	OpHLine:         {"DrawHLine", []argKind{argX, argY, argLength}},
+	OpLineAngle:     {"DrawLineAngle", []argKind{argX, argY, argLength, argRaw}},
+	OpBox:           {"DrawBox", []argKind{argX, argY, argLength, argLength}},
+	OpBoxCoords:     {"DrawBoxCoords", []argKind{argX, argY, argX, argY}},
+	OpRoundBox:      {"DrawRoundBox", []argKind{argX, argY, argLength, argLength, argLength}},
+	OpBoxFill:       {"DrawBoxFill", []argKind{argX, argY, argLength, argLength}},
+	OpBoxFillCoords: {"DrawBoxFillCoords", []argKind{argX, argY, argX, argY}},
+	OpRoundBoxFill:  {"DrawRoundBoxFill", []argKind{argX, argY, argLength, argLength, argLength}},
+	OpTriangle:      {"DrawTriangle", []argKind{argX, argY, argX, argY, argX, argY}},
+	OpTriangleFill:  {"DrawTriangleFill", []argKind{argX, argY, argX, argY, argX, argY}},
+	OpCircle:        {"DrawCircle", []argKind{argX, argY, argLength, argRaw}},
+	OpCircleFill:    {"DrawCircleFill", []argKind{argX, argY, argLength, argRaw}},
+	OpEllipse:       {"DrawEllipse", []argKind{argX, argY, argLength, argLength, argRaw}},
+	OpEllipseFill:   {"DrawEllipseFill", []argKind{argX, argY, argLength, argLength, argRaw}},
+	OpArc:           {"DrawArc", []argKind{argX, argY, argLength, argRaw, argRaw}},
+	OpArcFill:       {"DrawArcFill", []argKind{argX, argY, argLength, argRaw, argRaw}},
+	OpClearRegion:   {"ClearRegion", []argKind{argX, argY, argLength, argLength}},
+}
+
+// String returns the name of the t8go.IDisplayDrawer method recorded by op,
+// matching the op names reported by t8go.Tracer.
+func (op Op) String() string {
+	if op >= opCount {
+		return "Unknown"
+	}
+	return ops[op].name
+}
+
+// ArgCount returns the number of arguments of op (0 for unknown ops).
+func (op Op) ArgCount() int {
+	if op >= opCount {
+		return 0
+	}
+	return len(ops[op].args)
+}
+
+// * ----- Constructors -----
+
+// New creates an empty recording with room for capacity commands.
+// Recording more commands grows it as needed.
+func New(capacity int) *Recording {
+	return &Recording{commands: make([]Command, 0, max(capacity, 0))}
+}
+
+// * ----- Recording methods -----
+
+// Len returns the number of recorded commands.
+func (r *Recording) Len() int {
+	return len(r.commands)
+}
+
+// Commands returns the recorded commands in order. The slice is shared with
+// the recording and is only valid until the next recorded call or Reset.
+func (r *Recording) Commands() []Command {
+	return r.commands
+}
+
+// Reset removes all commands, keeping the allocated capacity.
+func (r *Recording) Reset() {
+	r.commands = r.commands[:0]
+}
+
+// Equal reports whether both recordings contain the same commands.
+func (r *Recording) Equal(other *Recording) bool {
+	if len(r.commands) != len(other.commands) {
+		return false
+	}
+	for i := range r.commands {
+		if r.commands[i] != other.commands[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// add appends a command with the given arguments.
+func (r *Recording) add(op Op, args ...int16) {
+	command := Command{Op: op}
+	copy(command.Args[:], args)
+	r.commands = append(r.commands, command)
+}
+
+// * ----- Replay -----
+
+// Replay draws every recorded command onto ctx, unchanged. Like ReplayAt, it
+// stops at the first command with an undefined Op and returns ErrUnknownOp.
+func (r *Recording) Replay(ctx t8go.IDisplayDrawer) error {
+	for i := range r.commands {
+		if r.commands[i].Op >= opCount {
+			return ErrUnknownOp
+		}
+		replay(ctx, r.commands[i])
+	}
+	return nil
+}
+
+// ReplayAt draws every recorded command onto ctx, scaled by scale (fixed.Q8One
+// keeps the original size) and then moved by offset. Coordinates, sizes and
+// radii are scaled; angles and quadrant masks are kept. Results saturate at
+// the int16 limits. Replay stops at the first command with an undefined Op
+// and returns ErrUnknownOp.
+func (r *Recording) ReplayAt(ctx t8go.IDisplayDrawer, offset t8go.Point, scale fixed.Q8) error {
+	factor := scale.Q16()
+	for i := range r.commands {
+		command := r.commands[i]
+		if command.Op >= opCount {
+			return ErrUnknownOp
+		}
+		for arg, kind := range ops[command.Op].args {
+			value := &command.Args[arg]
+			switch kind {
+			case argX:
+				*value = helpers.ClampInt16(int32(scaleValue(*value, factor)) + int32(offset.X))
+			case argY:
+				*value = helpers.ClampInt16(int32(scaleValue(*value, factor)) + int32(offset.Y))
+			case argLength:
+				*value = scaleValue(*value, factor)
+			}
+		}
+		replay(ctx, command)
+	}
+	return nil
+}
+
+// scaleValue multiplies value by factor, rounding to the nearest integer.
+func scaleValue(value int16, factor fixed.Q16) int16 {
+	if factor == fixed.Q16One {
+		return value
+	}
+	return factor.MulInt(int32(value)).Round()
+}
+
+// replay issues a single command on ctx.
+func replay(ctx t8go.IDisplayDrawer, command Command) {
+	a := command.Args
+	switch command.Op {
+	case OpPixel:
+		ctx.DrawPixel(a[0], a[1])
+	case OpLine:
+		ctx.DrawLine(a[0], a[1], a[2], a[3])
+	case OpVLine:
+		ctx.DrawVLine(a[0], a[1], a[2])
+	case OpHLine:
+		ctx.DrawHLine(a[0], a[1], a[2])
+	case OpLineAngle:
+		ctx.DrawLineAngle(a[0], a[1], a[2], uint8(a[3]))
+	case OpBox:
+		ctx.DrawBox(a[0], a[1], a[2], a[3])
+	case OpBoxCoords:
+		ctx.DrawBoxCoords(a[0], a[1], a[2], a[3])
+	case OpRoundBox:
+		ctx.DrawRoundBox(a[0], a[1], a[2], a[3], a[4])
+	case OpBoxFill:
+		ctx.DrawBoxFill(a[0], a[1], a[2], a[3])
+	case OpBoxFillCoords:
+		ctx.DrawBoxFillCoords(a[0], a[1], a[2], a[3])
+	case OpRoundBoxFill:
+		ctx.DrawRoundBoxFill(a[0], a[1], a[2], a[3], a[4])
+	case OpTriangle:
+		ctx.DrawTriangle(a[0], a[1], a[2], a[3], a[4], a[5])
+	case OpTriangleFill:
+		ctx.DrawTriangleFill(a[0], a[1], a[2], a[3], a[4], a[5])
+	case OpCircle:
+		ctx.DrawCircle(a[0], a[1], a[2], t8go.DrawQuadrants(a[3]))
+	case OpCircleFill:
+		ctx.DrawCircleFill(a[0], a[1], a[2], t8go.DrawQuadrants(a[3]))
+	case OpEllipse:
+		ctx.DrawEllipse(a[0], a[1], a[2], a[3], t8go.DrawQuadrants(a[4]))
+	case OpEllipseFill:
+		ctx.DrawEllipseFill(a[0], a[1], a[2], a[3], t8go.DrawQuadrants(a[4]))
+	case OpArc:
+		ctx.DrawArc(a[0], a[1], a[2], uint8(a[3]), uint8(a[4]))
+	case OpArcFill:
+		ctx.DrawArcFill(a[0], a[1], a[2], uint8(a[3]), uint8(a[4]))
+	case OpClearRegion:
+		ctx.ClearRegion(a[0], a[1], a[2], a[3])
+	}
+}
+
+// * ----- Encoding -----
+
+// MarshalBinary encodes the recording as a compact byte stream: a 4-byte
+// header followed by every command as its Op byte and its arguments as
+// little-endian int16 values.
+func (r *Recording) MarshalBinary() ([]byte, error) {
+	size := len(encodingMagic)
+	for i := range r.commands {
+		size += 1 + 2*r.commands[i].Op.ArgCount()
+	}
+
+	data := make([]byte, 0, size)
+	data = append(data, encodingMagic[:]...)
+	for i := range r.commands {
+		command := r.commands[i]
+		data = append(data, byte(command.Op))
+		for _, value := range command.Args[:command.Op.ArgCount()] {
+			data = binary.LittleEndian.AppendUint16(data, uint16(value))
+		}
+	}
+	return data, nil
+}
+
+// UnmarshalBinary replaces the commands of the recording with the ones
+// encoded in data by MarshalBinary.
+func (r *Recording) UnmarshalBinary(data []byte) error {
+	if len(data) < len(encodingMagic) || [4]byte(data[:len(encodingMagic)]) != encodingMagic {
+		return ErrFormat
+	}
+
+	r.Reset()
+	for offset := len(encodingMagic); offset < len(data); {
+		command := Command{Op: Op(data[offset])}
+		if command.Op >= opCount {
+			return ErrUnknownOp
+		}
+		offset++
+
+		count := command.Op.ArgCount()
+		if offset+2*count > len(data) {
+			return ErrTruncated
+		}
+		for arg := range count {
+			command.Args[arg] = int16(binary.LittleEndian.Uint16(data[offset:]))
+			offset += 2
+		}
+		r.commands = append(r.commands, command)
+	}
+	return nil
+}
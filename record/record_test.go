@@ -0,0 +1,47 @@
+package record_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/drivers/memory"
+	"github.com/redghc/t8go/fixed"
+	"github.com/redghc/t8go/record"
+)
+
+func TestReplayRejectsUnknownOp(t *testing.T) {
+	tests := []struct {
+		name   string
+		replay func(*record.Recording, t8go.IDisplayDrawer) error
+	}{
+		{"Replay", func(r *record.Recording, ctx t8go.IDisplayDrawer) error {
+			return r.Replay(ctx)
+		}},
+		{"ReplayAt", func(r *record.Recording, ctx t8go.IDisplayDrawer) error {
+			return r.ReplayAt(ctx, t8go.Pt(0, 0), fixed.Q8One)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			display, err := memory.New(memory.Config{Width: 32, Height: 32})
+			if err != nil {
+				t.Fatal(err)
+			}
+			ctx := t8go.New(display)
+
+			r := record.New(2)
+			r.DrawLine(0, 0, 31, 31)
+			if err := tt.replay(r, ctx); err != nil {
+				t.Fatalf("valid recording: %v", err)
+			}
+
+			r.DrawPixel(1, 1)
+			r.Commands()[1].Op = 0xff
+			if err := tt.replay(r, ctx); !errors.Is(err, record.ErrUnknownOp) {
+				t.Fatalf("got %v, want ErrUnknownOp", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package record
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// * ----- Text format -----
+
+// MarshalText encodes the recording as a drawing script: one command per line,
+// written as the method name followed by its arguments, for example
+//
+//	DrawBox 0 0 128 64
+//	DrawCircleFill 64 32 10 0
+func (r *Recording) MarshalText() ([]byte, error) {
+	var buf bytes.Buffer
+	for i := range r.commands {
+		command := r.commands[i]
+		buf.WriteString(command.Op.String())
+		for _, value := range command.Args[:command.Op.ArgCount()] {
+			buf.WriteByte(' ')
+			buf.WriteString(strconv.Itoa(int(value)))
+		}
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalText replaces the commands of the recording with the ones in a
+// drawing script written in the MarshalText format. Method names are case
+// insensitive and may omit the "Draw" prefix; arguments are separated by
+// spaces or commas. Blank lines and text after '#' are ignored.
+func (r *Recording) UnmarshalText(text []byte) error {
+	r.Reset()
+
+	scanner := bufio.NewScanner(bytes.NewReader(text))
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.FieldsFunc(stripComment(scanner.Text()), func(c rune) bool {
+			return c == ' ' || c == '\t' || c == ','
+		})
+		if len(fields) == 0 {
+			continue
+		}
+
+		op, ok := lookupOp(fields[0])
+		if !ok {
+			return fmt.Errorf("line %d: %w: %q", line, ErrUnknownOp, fields[0])
+		}
+		if len(fields)-1 != op.ArgCount() {
+			return fmt.Errorf("line %d: %w: %s takes %d arguments, got %d", line, ErrSyntax, op, op.ArgCount(), len(fields)-1)
+		}
+
+		command := Command{Op: op}
+		for arg, field := range fields[1:] {
+			value, err := strconv.ParseInt(field, 0, 16)
+			if err != nil {
+				return fmt.Errorf("line %d: %w: argument %q", line, ErrSyntax, field)
+			}
+			command.Args[arg] = int16(value)
+		}
+		r.commands = append(r.commands, command)
+	}
+	return scanner.Err()
+}
+
+// stripComment removes a trailing '#' comment from line.
+func stripComment(line string) string {
+	if i := strings.IndexByte(line, '#'); i >= 0 {
+		return line[:i]
+	}
+	return line
+}
+
+// lookupOp returns the Op recorded by the named method, with or without the
+// "Draw" prefix and ignoring case.
+func lookupOp(name string) (Op, bool) {
+	for op := range opCount {
+		full := ops[op].name
+		if strings.EqualFold(name, full) || strings.EqualFold(name, strings.TrimPrefix(full, "Draw")) {
+			return op, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,105 @@
+package t8go
+
+import "time"
+
+// RefreshPolicy configures how a RefreshManager batches and schedules refreshes.
+type RefreshPolicy struct {
+	FullRefreshEvery uint16        // Force a full refresh after this many partial refreshes (0 disables)
+	MinInterval      time.Duration // Minimum time between refreshes; changes in between are batched
+}
+
+// RefreshManager batches drawing changes and decides between partial and full
+// refreshes according to a RefreshPolicy, so e-paper applications do not have
+// to track ghosting themselves. Displays that do not implement
+// IRefreshModeDisplay are refreshed with Display.
+type RefreshManager struct {
+	ctx         IDisplayDrawer // Drawing context to refresh
+	policy      RefreshPolicy  // Refresh scheduling policy
+	dirty       bool           // Whether there are changes waiting to be shown
+	forceFull   bool           // Whether the next refresh must be a full refresh
+	partials    uint16         // Partial refreshes since the last full refresh
+	lastRefresh time.Time      // Time of the last refresh
+}
+
+// NewRefreshManager creates a refresh manager for ctx using the given policy.
+// The first refresh is always a full refresh.
+func NewRefreshManager(ctx IDisplayDrawer, policy RefreshPolicy) *RefreshManager {
+	return &RefreshManager{
+		ctx:       ctx,
+		policy:    policy,
+		forceFull: true,
+	}
+}
+
+// Invalidate marks the buffer as changed so the next Update refreshes the display.
+func (m *RefreshManager) Invalidate() {
+	m.dirty = true
+}
+
+// RequestFull makes the next refresh a full refresh, for example after a
+// large part of the screen changed.
+func (m *RefreshManager) RequestFull() {
+	m.dirty = true
+	m.forceFull = true
+}
+
+// Update refreshes the display if there are pending changes and the minimum
+// interval since the last refresh has elapsed.
+// Returns true if a refresh was performed; a failed refresh returns false
+// with its error and stays pending.
+func (m *RefreshManager) Update() (bool, error) {
+	if !m.dirty {
+		return false, nil
+	}
+	if m.policy.MinInterval > 0 && !m.lastRefresh.IsZero() && time.Since(m.lastRefresh) < m.policy.MinInterval {
+		return false, nil
+	}
+	if err := m.refresh(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Flush refreshes the display immediately if there are pending changes,
+// ignoring the minimum interval.
+func (m *RefreshManager) Flush() error {
+	if !m.dirty {
+		return nil
+	}
+	return m.refresh()
+}
+
+// PartialCount returns the number of partial refreshes since the last full refresh.
+func (m *RefreshManager) PartialCount() uint16 {
+	return m.partials
+}
+
+// refresh performs a partial or full refresh according to the policy.
+func (m *RefreshManager) refresh() error {
+	full := m.forceFull ||
+		(m.policy.FullRefreshEvery > 0 && m.partials >= m.policy.FullRefreshEvery)
+
+	var err error
+	if modes, ok := m.ctx.GetDisplay().(IRefreshModeDisplay); ok {
+		if full {
+			err = modes.DisplayFull()
+		} else {
+			err = modes.DisplayPartial()
+		}
+	} else {
+		err = m.ctx.Display()
+	}
+	if err != nil {
+		return err
+	}
+
+	if full {
+		m.partials = 0
+	} else {
+		m.partials++
+	}
+	m.dirty = false
+	m.forceFull = false
+	m.lastRefresh = time.Now()
+	return nil
+}
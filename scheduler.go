@@ -0,0 +1,234 @@
+package t8go
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// RenderFunc draws a frame for a Scheduler at time now and returns the area
+// it changed. An empty rect means nothing changed beyond the requested area.
+type RenderFunc func(ctx IDisplayDrawer, now time.Time) Rect
+
+// SchedulerConfig configures a Scheduler.
+type SchedulerConfig struct {
+	Policy   RefreshPolicy // Full refresh cadence and minimum time between refreshes
+	Interval time.Duration // Redraw at every multiple of this period, e.g. time.Minute for a clock (0 = on request only)
+	FullArea uint8         // Changed area, in percent of the screen, from which a refresh is full (0 = not by area)
+	Sleep    bool          // Put the panel to sleep between refreshes (needs IPowerManager)
+}
+
+// Scheduler is the refresh policy of battery-powered screens, typically
+// e-paper and memory LCDs: it coalesces redraw requests, renders only at
+// configured intervals or when requested, and refreshes only what changed.
+//
+// A refresh is full when requested, when the policy's FullRefreshEvery
+// partial refreshes have passed or when the changed area reaches FullArea;
+// otherwise drivers with Capabilities.PartialUpdate flush the changed region
+// and IRefreshModeDisplay drivers refresh partially. Between refreshes the
+// panel can sleep and Run blocks, so the MCU can idle.
+//
+// Request and RequestFull may be called from any goroutine. Update and
+// NextWake drive the scheduler from an existing main loop; Run is a complete
+// loop.
+type Scheduler struct {
+	ctx    IDisplayDrawer  // Drawing context to refresh
+	config SchedulerConfig // Scheduling policy
+	render RenderFunc      // Draws the frames
+	wake   chan struct{}   // Signals Run that a request arrived
+
+	mutex     sync.Mutex // Guards the request state below
+	pending   bool       // Whether a redraw was requested
+	requested Rect       // Union of the requested areas
+	forceFull bool       // Whether the next refresh must be full
+
+	due         time.Time // Time of the next periodic redraw (zero before the first)
+	partials    uint16    // Partial refreshes since the last full refresh
+	lastRefresh time.Time // Time of the last refresh
+	asleep      bool      // Whether the scheduler put the panel to sleep
+}
+
+// NewScheduler creates a scheduler that refreshes ctx with the frames drawn
+// by render. The first Update renders the whole screen with a full refresh.
+func NewScheduler(ctx IDisplayDrawer, config SchedulerConfig, render RenderFunc) *Scheduler {
+	return &Scheduler{
+		ctx:       ctx,
+		config:    config,
+		render:    render,
+		wake:      make(chan struct{}, 1),
+		pending:   true,
+		requested: ctx.Bounds(),
+		forceFull: true,
+	}
+}
+
+// Request asks for a redraw, marking region as changed. Requests are merged
+// until the policy's MinInterval has passed since the last refresh.
+func (s *Scheduler) Request(region Rect) {
+	s.mutex.Lock()
+	s.pending = true
+	s.requested = s.requested.Union(region)
+	s.mutex.Unlock()
+	s.signal()
+}
+
+// RequestFull asks for a redraw of the whole screen with a full refresh, for
+// example after a screen change or to clear accumulated ghosting.
+func (s *Scheduler) RequestFull() {
+	s.mutex.Lock()
+	s.pending = true
+	s.requested = s.ctx.Bounds()
+	s.forceFull = true
+	s.mutex.Unlock()
+	s.signal()
+}
+
+// PartialCount returns the number of partial refreshes since the last full refresh.
+func (s *Scheduler) PartialCount() uint16 {
+	return s.partials
+}
+
+// NextWake returns the time of the next redraw: a waiting request once
+// MinInterval has passed, or the next periodic redraw. It returns the zero
+// time when nothing is scheduled, so only a request can cause a redraw.
+func (s *Scheduler) NextWake(now time.Time) time.Time {
+	var next time.Time
+	if s.config.Interval > 0 {
+		next = s.due
+		if next.IsZero() {
+			next = now
+		}
+	}
+
+	s.mutex.Lock()
+	pending := s.pending
+	s.mutex.Unlock()
+	if pending {
+		ready := now
+		if s.config.Policy.MinInterval > 0 && !s.lastRefresh.IsZero() {
+			ready = s.lastRefresh.Add(s.config.Policy.MinInterval)
+		}
+		if next.IsZero() || ready.Before(next) {
+			next = ready
+		}
+	}
+	return next
+}
+
+// Update renders and refreshes the screen if a redraw is due at now.
+// Returns true if the display was refreshed.
+func (s *Scheduler) Update(now time.Time) (bool, error) {
+	next := s.NextWake(now)
+	if next.IsZero() || now.Before(next) {
+		return false, nil
+	}
+	if s.config.Interval > 0 && !now.Before(s.due) {
+		s.due = now.Truncate(s.config.Interval).Add(s.config.Interval)
+	}
+
+	// Requests arriving while rendering wait for the next redraw
+	s.mutex.Lock()
+	region, full := s.requested, s.forceFull
+	s.pending, s.requested, s.forceFull = false, Rect{}, false
+	s.mutex.Unlock()
+
+	region = region.Union(s.render(s.ctx, now)).Intersect(s.ctx.Bounds())
+	if region.Empty() && !full {
+		return false, nil
+	}
+	if s.asleep {
+		if err := s.ctx.Wake(); err != nil {
+			return false, err
+		}
+		s.asleep = false
+	}
+	if err := s.refresh(region, full, now); err != nil {
+		return false, err
+	}
+	return true, s.sleep()
+}
+
+// Run drives the scheduler until stop is closed, sleeping between redraws.
+// It returns the first refresh error.
+func (s *Scheduler) Run(stop <-chan struct{}) error {
+	timer := time.NewTimer(time.Hour)
+	timer.Stop()
+	for {
+		if _, err := s.Update(time.Now()); err != nil {
+			return err
+		}
+
+		var expired <-chan time.Time
+		if next := s.NextWake(time.Now()); !next.IsZero() {
+			timer.Reset(max(time.Until(next), 0))
+			expired = timer.C
+		}
+
+		select {
+		case <-stop:
+			timer.Stop()
+			return nil
+		case <-s.wake:
+			timer.Stop()
+		case <-expired:
+		}
+	}
+}
+
+// signal wakes Run without blocking.
+func (s *Scheduler) signal() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// refresh shows the changed region with a full or partial refresh.
+func (s *Scheduler) refresh(region Rect, full bool, now time.Time) error {
+	policy := s.config.Policy
+	full = full || (policy.FullRefreshEvery > 0 && s.partials >= policy.FullRefreshEvery)
+	if !full && s.config.FullArea > 0 {
+		width, height := s.ctx.Size()
+		full = int32(region.Width)*int32(region.Height)*100 >= int32(width)*int32(height)*int32(s.config.FullArea)
+	}
+
+	modes, hasModes := s.ctx.GetDisplay().(IRefreshModeDisplay)
+	var err error
+	switch {
+	case full && hasModes:
+		err = modes.DisplayFull()
+	case full:
+		err = s.ctx.Display()
+	case s.ctx.Capabilities().PartialUpdate:
+		err = s.ctx.FlushRegion(region)
+	case hasModes:
+		err = modes.DisplayPartial()
+	default:
+		err = s.ctx.Display()
+	}
+	if err != nil {
+		return err
+	}
+
+	if full {
+		s.partials = 0
+	} else {
+		s.partials++
+	}
+	s.lastRefresh = now
+	return nil
+}
+
+// sleep puts the panel to sleep after a redraw if configured. Drivers
+// without IPowerManager stay awake.
+func (s *Scheduler) sleep() error {
+	if !s.config.Sleep || s.asleep {
+		return nil
+	}
+	err := s.ctx.Sleep()
+	if errors.Is(err, ErrUnsupported) {
+		return nil
+	}
+	s.asleep = err == nil
+	return err
+}
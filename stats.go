@@ -0,0 +1,89 @@
+package t8go
+
+import "github.com/redghc/t8go/framebuf"
+
+// EnableStats turns the drawing statistics on or off (see Stats). While on,
+// every pixel write is checked against a bitmap of the pixels already
+// written in the frame, which costs one bit per pixel of memory and slows
+// drawing down; leave it off in production. Enabling again resets the counters.
+func (t *T8Go) EnableStats(on bool) {
+	if !on {
+		t.stats = nil
+		return
+	}
+
+	width, height := t.display.Size()
+	written := framebuf.Buffer{Width: int(width), Height: int(height)}
+	if t.stats != nil && len(t.stats.written.Data) == framebuf.Size(written.Width, written.Height) {
+		written.Data = t.stats.written.Data
+		clear(written.Data)
+	} else {
+		written.Data = make([]byte, framebuf.Size(written.Width, written.Height))
+	}
+	t.stats = &frameStats{written: written}
+}
+
+// Stats returns the counters of the last frame, which ends at every Display,
+// DisplayAsync, FlushRegion or FlushRegions call. Pixel writes count drawing
+// and ClearRegion, but not ClearBuffer, so a frame that starts with a cleared
+// buffer reports the overdraw of its own drawing only. The result is zero
+// while statistics are disabled.
+func (t *T8Go) Stats() Stats {
+	if t.stats == nil {
+		return Stats{}
+	}
+	return t.stats.last
+}
+
+// countWrites records writes to the width x height rectangle at (x, y),
+// clipped to the display. Nothing is done while statistics are disabled.
+func (t *T8Go) countWrites(x, y, width, height int32) {
+	stats := t.stats
+	if stats == nil {
+		return
+	}
+
+	written := stats.written
+	startX, startY := max(x, 0), max(y, 0)
+	endX := min(x+width, int32(written.Width))
+	endY := min(y+height, int32(written.Height))
+	for py := startY; py < endY; py++ {
+		for px := startX; px < endX; px++ {
+			stats.current.Writes++
+			if written.GetPixel(int(px), int(py)) {
+				stats.current.Overdraw++
+				continue
+			}
+			written.SetPixel(int(px), int(py), true)
+			stats.current.Pixels++
+		}
+	}
+}
+
+// endFrame closes the current frame after flushed bytes were sent to the
+// display and starts counting the next one.
+func (t *T8Go) endFrame(flushed int) {
+	stats := t.stats
+	if stats == nil {
+		return
+	}
+
+	frame := stats.current
+	frame.Frames = stats.last.Frames + 1
+	frame.Flushed = uint32(flushed)
+	frame.TotalFlushed = stats.last.TotalFlushed + uint64(flushed)
+	stats.last = frame
+	stats.current = Stats{}
+	clear(stats.written.Data)
+}
+
+// regionBytes returns the number of buffer bytes covering region, which is
+// what a driver sends to flush it: whole pages (or columns) of the region's
+// width, or whole row bytes for row-major buffers.
+func (t *T8Go) regionBytes(region Rect) int {
+	corner := region.Max()
+	if t.direct.Data != nil && t.direct.Layout == framebuf.RowMajor {
+		return int(region.Height) * (int(corner.X)>>3 - int(region.X)>>3 + 1)
+	}
+	return int(region.Width) * (int(corner.Y)>>3 - int(region.Y)>>3 + 1)
+}
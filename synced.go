@@ -0,0 +1,681 @@
+package t8go
+
+import (
+	"io"
+
+	"github.com/redghc/t8go/fixed"
+	"github.com/redghc/t8go/framebuf"
+)
+
+// NewSynced creates a graphics context like New whose methods are serialized by
+// a mutex, so several goroutines can draw and flush concurrently without
+// corrupting the buffer. Each call is atomic on its own; wrap sequences that
+// must not interleave (such as draw-then-Display) in higher-level locking.
+func NewSynced(display IDisplay) IDisplayDrawer {
+	return &synced{ctx: New(display).(*T8Go)}
+}
+
+// GetDisplay returns the underlying display; calls on it bypass the lock
+func (s *synced) GetDisplay() IDisplay {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.GetDisplay()
+}
+
+// Size returns the display dimensions
+func (s *synced) Size() (width, height uint16) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.Size()
+}
+
+// BufferSize returns the size in bytes of the display buffer
+func (s *synced) BufferSize() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.BufferSize()
+}
+
+// Buffer returns the display buffer; reading or writing it bypasses the lock
+func (s *synced) Buffer() []byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.Buffer()
+}
+
+// ClearBuffer clears the display buffer
+func (s *synced) ClearBuffer() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.ClearBuffer()
+}
+
+// ClearDisplay clears the buffer and the physical display
+func (s *synced) ClearDisplay() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.ClearDisplay()
+}
+
+// SetDisplay replaces the display of the context
+func (s *synced) SetDisplay(display IDisplay) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.SetDisplay(display)
+}
+
+// Reinit initializes the display again and resends the buffer
+func (s *synced) Reinit() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.Reinit()
+}
+
+// SetTracer installs a tracer; it is called with the lock held, so it must not call back into the context
+func (s *synced) SetTracer(tracer Tracer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.SetTracer(tracer)
+}
+
+// SetPixelFilter installs a pixel filter; it is called with the lock held, so it must not call back into the context
+func (s *synced) SetPixelFilter(filter PixelFilter) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.SetPixelFilter(filter)
+}
+
+// SetGlyphCache sets the number of cached scaled and rotated glyphs
+func (s *synced) SetGlyphCache(entries uint8) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.SetGlyphCache(entries)
+}
+
+// SetClipRect restricts drawing to a rectangle
+func (s *synced) SetClipRect(x, y, width, height int16) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.SetClipRect(x, y, width, height)
+}
+
+// SetClipCircle restricts drawing to a disc
+func (s *synced) SetClipCircle(centerX, centerY, radius int16) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.SetClipCircle(centerX, centerY, radius)
+}
+
+// ClearClip removes the clip rect and circle
+func (s *synced) ClearClip() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.ClearClip()
+}
+
+// EnableStats turns the drawing statistics on or off
+func (s *synced) EnableStats(on bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.EnableStats(on)
+}
+
+// Stats returns the counters of the last frame
+func (s *synced) Stats() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.Stats()
+}
+
+// DumpASCII writes the buffer as text
+func (s *synced) DumpASCII(w io.Writer) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.DumpASCII(w)
+}
+
+// DumpBraille writes the buffer as braille characters
+func (s *synced) DumpBraille(w io.Writer) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.DumpBraille(w)
+}
+
+// ExportRegion writes part of the buffer in the given format
+func (s *synced) ExportRegion(region Rect, w io.Writer, format ExportFormat) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.ExportRegion(region, w, format)
+}
+
+// Capabilities describes the features of the display driver
+func (s *synced) Capabilities() Capabilities {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.Capabilities()
+}
+
+// FlushRegion sends part of the buffer to the physical display
+func (s *synced) FlushRegion(region Rect) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.FlushRegion(region)
+}
+
+// FlushRegions sends several parts of the buffer in one batch
+func (s *synced) FlushRegions(regions ...Rect) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.FlushRegions(regions...)
+}
+
+// Begin starts a batch of driver commands and updates
+func (s *synced) Begin() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.Begin()
+}
+
+// End sends the commands and updates queued since Begin
+func (s *synced) End() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.End()
+}
+
+// Sleep turns the panel off
+func (s *synced) Sleep() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.Sleep()
+}
+
+// Wake turns the panel back on
+func (s *synced) Wake() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.Wake()
+}
+
+// SetBrightness sets the panel brightness
+func (s *synced) SetBrightness(level uint8) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.SetBrightness(level)
+}
+
+// SetBrightnessSource sets the automatic brightness source
+func (s *synced) SetBrightnessSource(source func() uint8) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.SetBrightnessSource(source)
+}
+
+// UpdateBrightness steps the brightness towards the source reading
+func (s *synced) UpdateBrightness() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.UpdateBrightness()
+}
+
+// Units returns the physical unit converter of the display
+func (s *synced) Units() Units {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.Units()
+}
+
+// Bounds returns the drawable area of the display
+func (s *synced) Bounds() Rect {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.Bounds()
+}
+
+// ClearRegion turns off every pixel of a rectangle
+func (s *synced) ClearRegion(x, y, width, height int16) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.ClearRegion(x, y, width, height)
+}
+
+// Command sends a command byte to the display
+func (s *synced) Command(cmd byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.Command(cmd)
+}
+
+// Display sends the buffer contents to the physical display
+func (s *synced) Display() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.Display()
+}
+
+// DisplayAsync starts a flush; done is never called with the lock held
+func (s *synced) DisplayAsync(done func(error)) {
+	s.mu.Lock()
+	if _, ok := s.ctx.display.(IAsyncDisplay); ok || done == nil {
+		s.ctx.DisplayAsync(done)
+		s.mu.Unlock()
+		return
+	}
+
+	// Synchronous fallback: release the lock before reporting, so done may draw
+	s.ctx.UpdateBrightness()
+	err := s.ctx.display.Display()
+	s.mu.Unlock()
+	done(err)
+}
+
+// Err returns the first display error since the last Display call
+func (s *synced) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.Err()
+}
+
+// PushState saves the current graphics state
+func (s *synced) PushState() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.PushState()
+}
+
+// PopState restores the last saved graphics state
+func (s *synced) PopState() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.PopState()
+}
+
+// PushTransform saves the transform and combines it with m
+func (s *synced) PushTransform(m Transform) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.PushTransform(m)
+}
+
+// PopTransform restores the last saved transform
+func (s *synced) PopTransform() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.PopTransform()
+}
+
+// GetTransform returns the current transform
+func (s *synced) GetTransform() Transform {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.GetTransform()
+}
+
+// SetFont selects the font used by DrawText
+func (s *synced) SetFont(font *Font) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.SetFont(font)
+}
+
+// GetFont returns the font used by DrawText
+func (s *synced) GetFont() *Font {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.GetFont()
+}
+
+// SetTextScale sets the pixel scale of text
+func (s *synced) SetTextScale(scale uint8) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.SetTextScale(scale)
+}
+
+// GetTextScale returns the pixel scale of text
+func (s *synced) GetTextScale() uint8 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.GetTextScale()
+}
+
+// SetTextRotation sets the direction of text
+func (s *synced) SetTextRotation(rotation TextRotation) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.SetTextRotation(rotation)
+}
+
+// GetTextRotation returns the direction of text
+func (s *synced) GetTextRotation() TextRotation {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.GetTextRotation()
+}
+
+// SetDrawMode sets how primitives change the pixels they cover
+func (s *synced) SetDrawMode(mode DrawMode) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.SetDrawMode(mode)
+}
+
+// GetDrawMode returns how primitives change the pixels they cover
+func (s *synced) GetDrawMode() DrawMode {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.GetDrawMode()
+}
+
+// SetDrawColor sets the color of the drawing primitives
+func (s *synced) SetDrawColor(color Color) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.SetDrawColor(color)
+}
+
+// GetDrawColor returns the color of the drawing primitives
+func (s *synced) GetDrawColor() Color {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.GetDrawColor()
+}
+
+// SetBitmapMode sets how DrawBitmap draws clear bits
+func (s *synced) SetBitmapMode(mode BitmapMode) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.SetBitmapMode(mode)
+}
+
+// GetBitmapMode returns how DrawBitmap draws clear bits
+func (s *synced) GetBitmapMode() BitmapMode {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.GetBitmapMode()
+}
+
+// SetTextInvert sets whether text is drawn highlighted
+func (s *synced) SetTextInvert(invert bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.SetTextInvert(invert)
+}
+
+// GetTextInvert reports whether text is drawn highlighted
+func (s *synced) GetTextInvert() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.GetTextInvert()
+}
+
+// At starts a drawing chain whose calls go through the lock
+func (s *synced) At(x, y int16) Chain {
+	return Chain{ctx: s, x: x, y: y}
+}
+
+// SetPixel sets or clears a pixel
+func (s *synced) SetPixel(x, y int16, on bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.SetPixel(x, y, on)
+}
+
+// SetPixelColor sets a pixel to a color
+func (s *synced) SetPixelColor(x, y int16, color Color) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.SetPixelColor(x, y, color)
+}
+
+// GetPixel returns the state of a pixel
+func (s *synced) GetPixel(x, y int16) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.GetPixel(x, y)
+}
+
+// DrawPixel sets a pixel
+func (s *synced) DrawPixel(x, y int16) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawPixel(x, y)
+}
+
+// DrawLine draws a line between two points
+func (s *synced) DrawLine(startX, startY, endX, endY int16) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawLine(startX, startY, endX, endY)
+}
+
+// DrawVLine draws a vertical line
+func (s *synced) DrawVLine(originX, originY, length int16) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawVLine(originX, originY, length)
+}
+
+// DrawHLine draws a horizontal line
+func (s *synced) DrawHLine(originX, originY, length int16) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawHLine(originX, originY, length)
+}
+
+// DrawLineAngle draws a line with the given length and angle
+func (s *synced) DrawLineAngle(originX, originY, length int16, angle uint8) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawLineAngle(originX, originY, length, angle)
+}
+
+// DrawLineThick draws a line of the given width
+func (s *synced) DrawLineThick(startX, startY, endX, endY, width int16) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawLineThick(startX, startY, endX, endY, width)
+}
+
+// DrawBox draws a rectangle outline
+func (s *synced) DrawBox(originX, originY, width, height int16) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawBox(originX, originY, width, height)
+}
+
+// DrawBoxCoords draws a rectangle outline between two corners
+func (s *synced) DrawBoxCoords(startX, startY, endX, endY int16) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawBoxCoords(startX, startY, endX, endY)
+}
+
+// DrawBoxThick draws a rectangle outline with thick edges
+func (s *synced) DrawBoxThick(originX, originY, width, height, thickness int16) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawBoxThick(originX, originY, width, height, thickness)
+}
+
+// DrawRoundBox draws a rounded rectangle outline
+func (s *synced) DrawRoundBox(originX, originY, width, height, cornerRadius int16) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawRoundBox(originX, originY, width, height, cornerRadius)
+}
+
+// DrawBoxFill draws a filled rectangle
+func (s *synced) DrawBoxFill(originX, originY, width, height int16) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawBoxFill(originX, originY, width, height)
+}
+
+// DrawBoxFillCoords draws a filled rectangle between two corners
+func (s *synced) DrawBoxFillCoords(startX, startY, endX, endY int16) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawBoxFillCoords(startX, startY, endX, endY)
+}
+
+// DrawRoundBoxFill draws a filled rounded rectangle
+func (s *synced) DrawRoundBoxFill(originX, originY, width, height, cornerRadius int16) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawRoundBoxFill(originX, originY, width, height, cornerRadius)
+}
+
+// DrawTriangle draws a triangle outline
+func (s *synced) DrawTriangle(x1, y1, x2, y2, x3, y3 int16) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawTriangle(x1, y1, x2, y2, x3, y3)
+}
+
+// DrawTriangleFill draws a filled triangle
+func (s *synced) DrawTriangleFill(x1, y1, x2, y2, x3, y3 int16) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawTriangleFill(x1, y1, x2, y2, x3, y3)
+}
+
+// DrawCircle draws a circle outline
+func (s *synced) DrawCircle(centerX, centerY, radius int16, mask DrawQuadrants) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawCircle(centerX, centerY, radius, mask)
+}
+
+// DrawCircleThick draws a ring of the given thickness
+func (s *synced) DrawCircleThick(centerX, centerY, radius, thickness int16, mask DrawQuadrants) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawCircleThick(centerX, centerY, radius, thickness, mask)
+}
+
+// DrawCircleFill draws a filled circle
+func (s *synced) DrawCircleFill(centerX, centerY, radius int16, mask DrawQuadrants) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawCircleFill(centerX, centerY, radius, mask)
+}
+
+// DrawEllipse draws an ellipse outline
+func (s *synced) DrawEllipse(centerX, centerY, radiusX, radiusY int16, mask DrawQuadrants) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawEllipse(centerX, centerY, radiusX, radiusY, mask)
+}
+
+// DrawEllipseFill draws a filled ellipse
+func (s *synced) DrawEllipseFill(centerX, centerY, radiusX, radiusY int16, mask DrawQuadrants) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawEllipseFill(centerX, centerY, radiusX, radiusY, mask)
+}
+
+// DrawArc draws an arc outline
+func (s *synced) DrawArc(centerX, centerY, radius int16, angleStart, angleEnd uint8) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawArc(centerX, centerY, radius, angleStart, angleEnd)
+}
+
+// DrawArcThick draws an arc of the given thickness
+func (s *synced) DrawArcThick(centerX, centerY, radius, thickness int16, angleStart, angleEnd uint8) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawArcThick(centerX, centerY, radius, thickness, angleStart, angleEnd)
+}
+
+// DrawArcFill draws a filled sector
+func (s *synced) DrawArcFill(centerX, centerY, radius int16, angleStart, angleEnd uint8) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawArcFill(centerX, centerY, radius, angleStart, angleEnd)
+}
+
+// DrawText draws text with the current font
+func (s *synced) DrawText(x, y int16, text string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawText(x, y, text)
+}
+
+// DrawTextClipped draws the part of text inside a clip rect
+func (s *synced) DrawTextClipped(x, y int16, text string, clip Rect) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawTextClipped(x, y, text, clip)
+}
+
+// DrawInt draws an integer in decimal
+func (s *synced) DrawInt(x, y int16, value int32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawInt(x, y, value)
+}
+
+// DrawFixed draws a fixed-point number in decimal
+func (s *synced) DrawFixed(x, y int16, value fixed.Q16, decimals uint8) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawFixed(x, y, value, decimals)
+}
+
+// DrawSpans draws rich text segments on a shared baseline
+func (s *synced) DrawSpans(x, y int16, spans ...TextSpan) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawSpans(x, y, spans...)
+}
+
+// MeasureText returns the size of a line of text in the current font
+func (s *synced) MeasureText(text string) (width, height int16) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.MeasureText(text)
+}
+
+// GlyphAdvance returns the pen advance of a rune in the current font
+func (s *synced) GlyphAdvance(r rune) int16 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.GlyphAdvance(r)
+}
+
+// DrawTextBox draws word-wrapped, aligned text in a box
+func (s *synced) DrawTextBox(x, y, width, height int16, text string, align TextAlign) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawTextBox(x, y, width, height, text, align)
+}
+
+// MeasureSpans returns the width, ascent and descent of rich text segments
+func (s *synced) MeasureSpans(spans ...TextSpan) (width, ascent, descent int16) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ctx.MeasureSpans(spans...)
+}
+
+// DrawBitmap draws a packed 1-bit bitmap with MSB-first rows
+func (s *synced) DrawBitmap(x, y, width, height int16, data []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawBitmap(x, y, width, height, data)
+}
+
+// DrawBuffer copies a page-layout buffer to the display
+func (s *synced) DrawBuffer(x, y int16, src framebuf.Buffer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawBuffer(x, y, src)
+}
+
+// DrawNinePatch stretches a border bitmap over a rectangle
+func (s *synced) DrawNinePatch(x, y, width, height int16, patch NinePatch) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx.DrawNinePatch(x, y, width, height, patch)
+}
@@ -5,16 +5,130 @@
 // circles, ellipses, arcs, and triangles.
 package t8go
 
+import "github.com/redghc/t8go/framebuf"
+
+const stateStackSize = 8 // Initial capacity of the PushState stack
+
 // New creates a new T8Go graphics context with the specified display.
 // The display parameter must implement the Display interface.
 // Returns a pointer to a T8Go instance that can be used for drawing operations.
 func New(display IDisplay) IDisplayDrawer {
+	t := &T8Go{state: drawState{color: ColorOn}}
+	t.attach(display)
+	return t
+}
+
+// attach makes display the target of the context and probes its optional
+// fast paths. The internal buffers are reallocated only when they do not fit.
+func (t *T8Go) attach(display IDisplay) {
 	bufferSize := display.BufferSize()
+	_, height := display.Size()
+	spans, _ := display.(ISpanDrawer)
+	direct := DirectBuffer(display)
+
+	// Without driver spans, the direct buffer provides them as well
+	if spans == nil && direct.Data != nil {
+		spans = directSpans{direct}
+	}
+
+	t.display = display
+	t.colors, _ = display.(IColorDisplay)
+	t.spans = spans
+	t.direct = direct
+	t.brightness.known = false // The new panel's brightness is unknown
+	if len(t.buffer) != bufferSize {
+		t.buffer = make([]byte, bufferSize)
+	}
+	if len(t.rows) != int(height) {
+		t.rows = make(scanlines, height)
+	}
+	if t.stats != nil {
+		t.EnableStats(true) // Resize the written pixels to the new display
+	}
+}
+
+// SetDisplay replaces the display of the context, for example after a
+// hot-plugged panel was re-created. When both drivers expose their buffers
+// through IBufferInfo, the contents of the current buffer are carried over:
+// copied as is when both have the same size and layout, and re-plotted pixel
+// by pixel over the area they share otherwise. With an unknown layout on
+// either side the new display keeps its own contents. The graphics state is
+// kept. The new display is not flushed; call Display.
+func (t *T8Go) SetDisplay(display IDisplay) {
+	if previous := t.display; previous != nil {
+		copyContents(DirectBuffer(previous), DirectBuffer(display))
+	}
+	t.attach(display)
+}
 
-	return &T8Go{
-		display: display,
-		buffer:  make([]byte, bufferSize),
+// copyContents copies the image in from into to, converting between layouts.
+// Buffers without Data have an unknown layout and are left alone.
+func copyContents(from, to framebuf.Buffer) {
+	if from.Data == nil || to.Data == nil {
+		return
 	}
+	if from.Width == to.Width && from.Height == to.Height && from.Layout == to.Layout {
+		copy(to.Data, from.Data)
+		return
+	}
+
+	width, height := min(from.Width, to.Width), min(from.Height, to.Height)
+	for y := range height {
+		for x := range width {
+			to.SetPixel(x, y, from.GetPixel(x, y))
+		}
+	}
+}
+
+// Reinit re-runs the initialization sequence of the display and sends the
+// buffer to it again, so a panel that lost power recovers its contents.
+// Returns ErrUnsupported if the driver does not implement IReinitializer.
+func (t *T8Go) Reinit() error {
+	driver, ok := t.display.(IReinitializer)
+	if !ok {
+		return ErrUnsupported
+	}
+	if err := driver.Reinit(); err != nil {
+		t.setErr(err)
+		return err
+	}
+	t.brightness.known = false // Initialization reset the brightness
+	return t.Display()
+}
+
+// DirectBuffer returns a framebuf view of the display buffer, in its layout,
+// when the driver exposes it through IBufferInfo in a supported layout.
+// Otherwise the returned buffer has nil Data and all drawing goes through the
+// driver.
+func DirectBuffer(display IDisplay) framebuf.Buffer {
+	source, ok := display.(IBufferInfo)
+	if !ok {
+		return framebuf.Buffer{}
+	}
+
+	info := source.BufferInfo()
+	width, height := int(info.Width), int(info.Height)
+	if !info.Layout.Valid() || info.Stride != info.Layout.Stride(width, height) ||
+		len(info.Data) < info.Layout.Size(width, height) {
+		return framebuf.Buffer{}
+	}
+
+	return framebuf.Buffer{Data: info.Data, Width: width, Height: height, Layout: info.Layout}
+}
+
+// directSpans adapts a direct buffer view to ISpanDrawer.
+type directSpans struct {
+	frame framebuf.Buffer
+}
+
+// DrawHSpan sets or clears a horizontal run of pixels starting at (x, y)
+func (s directSpans) DrawHSpan(x, y, length int16, on bool) {
+	s.frame.HSpan(int(x), int(y), int(length), on)
+}
+
+// FillRect sets or clears a rectangle with its top-left corner at (x, y)
+func (s directSpans) FillRect(x, y, width, height int16, on bool) {
+	s.frame.FillRect(int(x), int(y), int(width), int(height), on)
 }
 
 // GetDisplay returns the underlying display interface
@@ -42,31 +156,337 @@ func (t *T8Go) ClearBuffer() {
 	t.display.ClearBuffer()
 }
 
+// Capabilities describes the features of the underlying display driver.
+// Drivers that do not implement ICapabilities report a monochrome panel
+// without hardware scrolling, with partial updates if they implement IRegionFlusher.
+func (t *T8Go) Capabilities() Capabilities {
+	if source, ok := t.display.(ICapabilities); ok {
+		return source.Capabilities()
+	}
+	_, partial := t.display.(IRegionFlusher)
+	return Capabilities{PartialUpdate: partial, GrayscaleBits: 1}
+}
+
+// Bounds returns the drawable area of the display as a rect at the origin.
+func (t *T8Go) Bounds() Rect {
+	width, height := t.display.Size()
+	return Rect{Width: int16(width), Height: int16(height)}
+}
+
+// ClearRegion turns off every pixel of the width x height rectangle with its
+// top-left corner at (x, y), without updating the physical display.
+// The region is clipped to the display; whole pages are cleared byte-wise
+// on the direct and span paths. No operation is performed if width or height
+// is not positive.
+func (t *T8Go) ClearRegion(x, y, width, height int16) {
+	if t.traced() {
+		t.tracer("ClearRegion", x, y, width, height)
+	}
+
+	if width <= 0 || height <= 0 {
+		return
+	}
+
+	t.writeRect(int32(x), int32(y), int32(x)+int32(width)-1, int32(y)+int32(height)-1, false)
+}
+
 // ClearDisplay clears both the buffer and the physical display.
 func (t *T8Go) ClearDisplay() {
 	t.display.ClearDisplay()
 }
 
 // Command sends a command byte to the display.
-// Returns an error if the command fails to send.
+// Returns an error if the command fails to send; the first such error is
+// also kept until the next Display call (see Err).
 func (t *T8Go) Command(cmd byte) error {
-	return t.display.Command(cmd)
+	err := t.display.Command(cmd)
+	t.setErr(err)
+	return err
 }
 
 // Display sends the current buffer contents to the physical display.
-// Returns an error if the display update fails.
+// Returns the first error recorded since the previous Display call, if any,
+// otherwise the error of the update itself. The recorded error is cleared.
 func (t *T8Go) Display() error {
-	return t.display.Display()
+	t.UpdateBrightness()
+	err := t.display.Display()
+	t.endFrame(t.display.BufferSize())
+	if t.err != nil {
+		err, t.err = t.err, nil
+	}
+	return err
+}
+
+// FlushRegion sends the part of the buffer inside region to the physical
+// display, using the driver's IRegionFlusher when available and a full
+// Display otherwise. The region is clipped to the display; nothing is sent if
+// it is empty. Like Display, it returns and clears any recorded error.
+func (t *T8Go) FlushRegion(region Rect) error {
+	flusher, ok := t.display.(IRegionFlusher)
+	if !ok {
+		return t.Display()
+	}
+
+	t.UpdateBrightness()
+	var err error
+	if region = region.Intersect(t.Bounds()); !region.Empty() {
+		corner := region.Max()
+		err = flusher.DisplayRegion(int(region.X), int(region.Y), int(corner.X), int(corner.Y))
+		t.endFrame(t.regionBytes(region))
+	}
+	if t.err != nil {
+		err, t.err = t.err, nil
+	}
+	return err
+}
+
+// FlushRegions sends several parts of the buffer to the physical display in
+// one batch (see Begin), for frames that changed in separate places.
+// Overlapping regions are merged so no area is sent twice. Falls back to a
+// full Display without IRegionFlusher. Like Display, it returns and clears
+// any recorded error.
+func (t *T8Go) FlushRegions(regions ...Rect) error {
+	flusher, ok := t.display.(IRegionFlusher)
+	if !ok {
+		return t.Display()
+	}
+
+	var storage [8]Rect
+	merged := storage[:0]
+	for _, region := range regions {
+		region = region.Intersect(t.Bounds())
+		if region.Empty() {
+			continue
+		}
+
+		// Absorb every merged region the new one overlaps, as the union may
+		// reach regions it did not overlap before
+		for i := 0; i < len(merged); {
+			if !merged[i].Overlaps(region) {
+				i++
+				continue
+			}
+			region = region.Union(merged[i])
+			merged[i] = merged[len(merged)-1]
+			merged = merged[:len(merged)-1]
+			i = 0
+		}
+		merged = append(merged, region)
+	}
+
+	t.Begin()
+	t.UpdateBrightness()
+	flushed := 0
+	for _, region := range merged {
+		corner := region.Max()
+		t.setErr(flusher.DisplayRegion(int(region.X), int(region.Y), int(corner.X), int(corner.Y)))
+		flushed += t.regionBytes(region)
+	}
+	err := t.End()
+	t.endFrame(flushed)
+	if t.err != nil {
+		err, t.err = t.err, nil
+	}
+	return err
+}
+
+// Begin starts a batch: until the matching End, drivers implementing
+// IBatcher queue commands and buffer updates, and End sends them in as few
+// bus transactions as possible. Batches nest. Without IBatcher everything is
+// sent immediately and Begin and End do nothing.
+func (t *T8Go) Begin() {
+	if batcher, ok := t.display.(IBatcher); ok {
+		batcher.Begin()
+	}
+}
+
+// End finishes a batch started with Begin and returns the error of sending
+// it. The errors of calls queued during the batch are only known here.
+func (t *T8Go) End() error {
+	batcher, ok := t.display.(IBatcher)
+	if !ok {
+		return nil
+	}
+	err := batcher.End()
+	t.setErr(err)
+	return err
+}
+
+// Err returns the first display error recorded since the last Display call,
+// or nil. Errors are recorded by Command, by DisplayAsync calls without a
+// done callback and by misuse such as an unbalanced PopState, so code that
+// ignores return values can still detect a disconnected display.
+func (t *T8Go) Err() error {
+	return t.err
+}
+
+// PushState saves the current graphics state, so code such as a widget can
+// change it freely and restore its caller's state with PopState.
+func (t *T8Go) PushState() {
+	if t.states == nil {
+		t.states = make([]drawState, 0, stateStackSize)
+	}
+	t.states = append(t.states, t.state)
+}
+
+// PopState restores the graphics state saved by the matching PushState call.
+// Without a saved state, it records ErrStateUnderflow (see Err) and leaves
+// the current state unchanged.
+func (t *T8Go) PopState() {
+	last := len(t.states) - 1
+	if last < 0 {
+		t.setErr(ErrStateUnderflow)
+		return
+	}
+	t.state = t.states[last]
+	t.states = t.states[:last]
+}
+
+// setErr records err unless an earlier error is still pending.
+func (t *T8Go) setErr(err error) {
+	if t.err == nil {
+		t.err = err
+	}
+}
+
+// DisplayAsync starts sending the current buffer contents to the physical display
+// and returns as soon as the buffer may be modified again. The done callback,
+// if non-nil, receives the result once the transfer completes.
+// Drivers without background flush support complete the flush synchronously;
+// their error is recorded (see Err) when done is nil.
+func (t *T8Go) DisplayAsync(done func(error)) {
+	t.UpdateBrightness()
+	t.endFrame(t.display.BufferSize())
+	if async, ok := t.display.(IAsyncDisplay); ok {
+		async.DisplayAsync(done)
+		return
+	}
+
+	err := t.display.Display()
+	if done == nil {
+		t.setErr(err)
+		return
+	}
+	done(err)
 }
 
 // SetPixel sets a pixel at the specified coordinates (x, y).
 // If on is true, the pixel is turned on; if false, it's turned off.
+// When the driver exposes its buffer, the pixel is written directly into it.
 func (t *T8Go) SetPixel(x, y int16, on bool) {
+	t.countWrites(int32(x), int32(y), 1, 1)
+	t.writePixel(x, y, on)
+}
+
+// writePixel sets a pixel inside the clip area through the pixel filter,
+// without counting it.
+func (t *T8Go) writePixel(x, y int16, on bool) {
+	if !t.admits(x, y) {
+		return
+	}
+	if t.filtered() {
+		var write bool
+		if on, write = t.filter(x, y, on); !write {
+			return
+		}
+	}
+	t.storePixel(x, y, on)
+}
+
+// writeColor sets a pixel of a color display to color like writePixel. The
+// pixel filter sees the color as Color.IsOn; when it changes the state, the
+// pixel is written on or off instead.
+func (t *T8Go) writeColor(x, y int16, color Color) {
+	if !t.admits(x, y) {
+		return
+	}
+	if t.filtered() {
+		on, write := t.filter(x, y, color.IsOn())
+		if !write {
+			return
+		}
+		if on != color.IsOn() {
+			t.storePixel(x, y, on)
+			return
+		}
+	}
+	t.colors.SetPixelColor(x, y, color)
+}
+
+// admits reports whether (x, y) lies inside the clip rect and clip circle.
+func (t *T8Go) admits(x, y int16) bool {
+	if !t.state.rect.contains(int32(x), int32(y), int32(x), int32(y)) {
+		return false
+	}
+	return !t.clipped() || t.inClip(int32(x), int32(y))
+}
+
+// storePixel writes a pixel into the direct buffer or through the driver.
+func (t *T8Go) storePixel(x, y int16, on bool) {
+	if t.direct.Data != nil {
+		t.direct.SetPixel(int(x), int(y), on)
+		return
+	}
 	t.display.SetPixel(x, y, on)
 }
 
 // GetPixel returns the state of a pixel at the specified coordinates (x, y).
-// Returns true if the pixel is on, false if it's off.
-func (t *T8Go) GetPixel(x, y uint8) bool {
+// Returns true if the pixel is on, false if it's off or outside the display.
+func (t *T8Go) GetPixel(x, y int16) bool {
+	if t.direct.Data != nil {
+		return t.direct.GetPixel(int(x), int(y))
+	}
 	return t.display.GetPixel(x, y)
 }
+
+// bounds returns the visible drawing area as inclusive coordinates: the
+// display, cut to the clip rect when one is set. The area is empty (min
+// above max) when the clip rect misses the display.
+func (t *T8Go) bounds() (minX, minY, maxX, maxY int16) {
+	width, height := t.display.Size()
+	minX, minY, maxX, maxY = 0, 0, int16(width)-1, int16(height)-1
+	if rect := &t.state.rect; rect.set {
+		minX, minY = max(minX, rect.minX), max(minY, rect.minY)
+		maxX, maxY = min(maxX, rect.maxX), min(maxY, rect.maxY)
+	}
+	return minX, minY, maxX, maxY
+}
+
+// visible reports whether the inclusive box (x0, y0)-(x1, y1) intersects the
+// visible drawing area. The corners may be given in any order.
+func (t *T8Go) visible(x0, y0, x1, y1 int16) bool {
+	minX, minY, maxX, maxY := t.bounds()
+	return max(x0, x1) >= minX && min(x0, x1) <= maxX &&
+		max(y0, y1) >= minY && min(y0, y1) <= maxY
+}
+
+// visibleQuadrants removes from mask the quadrants of a shape centered at
+// (centerX, centerY) with the given radii that lie entirely outside the
+// visible area. DrawNone is treated as DrawAll; the result is DrawNone when
+// nothing is visible.
+func (t *T8Go) visibleQuadrants(centerX, centerY, radiusX, radiusY int16, mask DrawQuadrants) DrawQuadrants {
+	if mask == DrawNone {
+		mask = DrawAll
+	}
+
+	minX, minY, maxX, maxY := t.bounds()
+	cx, cy := int32(centerX), int32(centerY)
+	left := cx >= int32(minX) && cx-int32(radiusX) <= int32(maxX)
+	right := cx <= int32(maxX) && cx+int32(radiusX) >= int32(minX)
+	top := cy >= int32(minY) && cy-int32(radiusY) <= int32(maxY)
+	bottom := cy <= int32(maxY) && cy+int32(radiusY) >= int32(minY)
+
+	if !left || !top {
+		mask &^= DrawTopLeft
+	}
+	if !right || !top {
+		mask &^= DrawTopRight
+	}
+	if !right || !bottom {
+		mask &^= DrawBottomRight
+	}
+	if !left || !bottom {
+		mask &^= DrawBottomLeft
+	}
+	return mask
+}
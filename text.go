@@ -0,0 +1,603 @@
+package t8go
+
+import (
+	"math"
+
+	"github.com/redghc/t8go/helpers"
+)
+
+// * ----- Fonts -----
+
+// Glyph returns the glyph of font for r, or false when the font does not
+// contain it.
+func (f *Font) Glyph(r rune) (Glyph, bool) {
+	index := f.index(r)
+	if index < 0 {
+		return Glyph{}, false
+	}
+	return f.Glyphs[index], true
+}
+
+// Advance returns the distance the pen moves after drawing r, that of the
+// Fallback glyph for runes missing from the font, or 0 when nothing is drawn.
+func (f *Font) Advance(r rune) int16 {
+	index := f.drawnIndex(r)
+	if index < 0 {
+		return 0
+	}
+	return int16(f.Glyphs[index].Advance)
+}
+
+// Kern returns the pixels added between left and right when drawn one after
+// the other, from the Kerning pairs of the font.
+func (f *Font) Kern(left, right rune) int16 {
+	low, high := 0, len(f.Kerning)
+	for low < high {
+		middle := int(uint(low+high) >> 1)
+		pair := &f.Kerning[middle]
+		if pair.Left < left || pair.Left == left && pair.Right < right {
+			low = middle + 1
+		} else {
+			high = middle
+		}
+	}
+	if low < len(f.Kerning) && f.Kerning[low].Left == left && f.Kerning[low].Right == right {
+		return int16(f.Kerning[low].Adjust)
+	}
+	return 0
+}
+
+// GlyphPixel reports whether the pixel at (x, y) of the glyph bitmap is on.
+// Pixels outside the bitmap are off.
+func (f *Font) GlyphPixel(glyph Glyph, x, y int) bool {
+	if x < 0 || y < 0 || x >= int(glyph.Width) || y >= int(glyph.Height) {
+		return false
+	}
+	bit := int(glyph.Offset)*8 + y*int(glyph.Width) + x
+	return bit>>3 < len(f.Bitmap) && f.Bitmap[bit>>3]&(0x80>>(bit&7)) != 0
+}
+
+// drawnIndex returns the position of the glyph drawn for r: its own glyph,
+// else the Fallback glyph, else -1.
+func (f *Font) drawnIndex(r rune) int {
+	index := f.index(r)
+	if index < 0 && f.Fallback != 0 {
+		index = f.index(f.Fallback)
+	}
+	return index
+}
+
+// index returns the position of the glyph for r in f.Glyphs, or -1.
+// Fonts covering a contiguous range of runes are indexed directly; other
+// fonts are searched with a binary search.
+func (f *Font) index(r rune) int {
+	if len(f.Glyphs) == 0 {
+		return -1
+	}
+	if direct := int(r - f.Glyphs[0].Rune); direct >= 0 && direct < len(f.Glyphs) && f.Glyphs[direct].Rune == r {
+		return direct
+	}
+
+	low, high := 0, len(f.Glyphs)
+	for low < high {
+		middle := int(uint(low+high) >> 1)
+		if f.Glyphs[middle].Rune < r {
+			low = middle + 1
+		} else {
+			high = middle
+		}
+	}
+	if low < len(f.Glyphs) && f.Glyphs[low].Rune == r {
+		return low
+	}
+	return -1
+}
+
+// * ----- Text drawing -----
+
+// SetFont selects the font used by DrawText; nil selects Font5x7, or in
+// t8go_minimal builds, which leave it out, a font without glyphs.
+// The font is part of the graphics state saved by PushState.
+func (t *T8Go) SetFont(font *Font) {
+	t.state.font = font
+}
+
+// GetFont returns the font used by DrawText.
+func (t *T8Go) GetFont() *Font {
+	if t.state.font == nil {
+		return defaultFont
+	}
+	return t.state.font
+}
+
+// SetTextScale draws every pixel of the text glyphs as a scale x scale block,
+// so a small font renders big clock digits and readouts; 0 and 1 draw
+// unscaled. Glyphs are scaled while drawing, without scaled copies. The
+// scale is part of the graphics state saved by PushState.
+func (t *T8Go) SetTextScale(scale uint8) {
+	t.state.textScale = scale
+}
+
+// GetTextScale returns the scale of text drawing, at least 1.
+func (t *T8Go) GetTextScale() uint8 {
+	return max(t.state.textScale, 1)
+}
+
+// MeasureText returns the size of the line DrawText draws for text with the
+// current font and scale: the pen advance of its runes, kerning included,
+// and the font's Ascent plus Descent, so text is right-aligned at x-width
+// and centered at x-width/2. Rotated text has the same size along and across its baseline.
+func (t *T8Go) MeasureText(text string) (width, height int16) {
+	font, scale := t.GetFont(), int32(t.GetTextScale())
+	return helpers.ClampInt16(runWidth(font, text) * scale),
+		helpers.ClampInt16(int32(font.Ascent+font.Descent) * scale)
+}
+
+// GlyphAdvance returns the distance DrawText moves the pen after r with the
+// current font and scale, or 0 when the font draws nothing for it. Kerning
+// with the next rune is not included (see Font.Kern).
+func (t *T8Go) GlyphAdvance(r rune) int16 {
+	return helpers.ClampInt16(int32(t.GetFont().Advance(r)) * int32(t.GetTextScale()))
+}
+
+// DrawText draws text with the current font, scale and rotation, starting
+// with the pen at x on the baseline y: unrotated glyphs extend up to the
+// font's Ascent above y and down to its Descent below, both multiplied by the
+// text scale. Text is UTF-8: each rune selects the glyph of the matching
+// character, and runes missing from the font, including invalid bytes, are
+// drawn with the font's Fallback glyph or skipped without one.
+func (t *T8Go) DrawText(x, y int16, text string) {
+	if t.traced() {
+		t.tracer("DrawText", x, y, int16(len(text)))
+	}
+	t.drawText(x, y, text)
+}
+
+// drawText draws a line of text for DrawText and the number helpers.
+func (t *T8Go) drawText(x, y int16, text string) {
+	pen := t.newPen(x, y, t.screenClip())
+	t.drawTextLine(&pen, t.GetFont(), text)
+}
+
+// DrawTextClipped draws text like DrawText, but only the pixels inside clip.
+// Glyphs crossing its edges are cut between pixel columns and rows rather
+// than skipped, so text scrolled one pixel at a time through a fixed window
+// slides in and out smoothly.
+func (t *T8Go) DrawTextClipped(x, y int16, text string, clip Rect) {
+	if t.traced() {
+		t.tracer("DrawTextClipped", x, y, int16(len(text)), clip.X, clip.Y, clip.Width, clip.Height)
+	}
+
+	box, ok := t.textClip(clip)
+	if !ok {
+		return
+	}
+	pen := t.newPen(x, y, box)
+	t.drawTextLine(&pen, t.GetFont(), text)
+}
+
+// SetTextRotation turns the text drawn by DrawText, DrawTextClipped and
+// DrawSpans around its starting point, for labels along the vertical edges
+// of the display. Glyphs are transformed while drawing. The rotation is part
+// of the graphics state saved by PushState.
+func (t *T8Go) SetTextRotation(rotation TextRotation) {
+	t.state.textRotation = rotation
+}
+
+// GetTextRotation returns the rotation of text drawing.
+func (t *T8Go) GetTextRotation() TextRotation {
+	return t.state.textRotation
+}
+
+// SetTextInvert draws text highlighted, as cleared pixels on a filled
+// background, to mark the selected row of a menu. DrawText, DrawTextClipped,
+// DrawInt and DrawFixed fill the box of the line from the font's Ascent above the
+// baseline to its Descent below; DrawTextBox fills the whole box; DrawSpans
+// inverts the spans without Invert set and restores the others. The mode is
+// part of the graphics state saved by PushState.
+func (t *T8Go) SetTextInvert(invert bool) {
+	t.state.textInvert = invert
+}
+
+// GetTextInvert reports whether text is drawn highlighted.
+func (t *T8Go) GetTextInvert() bool {
+	return t.state.textInvert
+}
+
+// * ----- Rich text -----
+
+// MeasureSpans returns the width of spans drawn one after another and the
+// ascent and descent of the block around their shared baseline, so the run
+// can be aligned as a whole. Scaled spans count with their scaled metrics.
+func (t *T8Go) MeasureSpans(spans ...TextSpan) (width, ascent, descent int16) {
+	var total, above, below int32
+	for i := range spans {
+		font, scale := t.spanStyle(&spans[i])
+		total += runWidth(font, spans[i].Text) * scale
+		above = max(above, int32(font.Ascent)*scale)
+		below = max(below, int32(font.Descent)*scale)
+	}
+	return helpers.ClampInt16(total), helpers.ClampInt16(above), helpers.ClampInt16(below)
+}
+
+// DrawSpans draws spans one after another on the baseline y, starting with
+// the pen at x, each in its own font, scale and inversion. Inverted spans
+// fill the block height given by MeasureSpans over their width and clear
+// their glyphs, so a highlighted unit lines up with the number beside it.
+func (t *T8Go) DrawSpans(x, y int16, spans ...TextSpan) {
+	if t.traced() {
+		t.tracer("DrawSpans", x, y, int16(len(spans)))
+	}
+
+	pen := t.newPen(x, y, t.screenClip())
+	_, ascent, descent := t.MeasureSpans(spans...)
+	for i := range spans {
+		span := &spans[i]
+		font, scale := t.spanStyle(span)
+		invert := span.Invert != t.state.textInvert
+		if invert {
+			width := runWidth(font, span.Text) * scale
+			t.penRect(&pen, pen.along, -int32(ascent), pen.along+width-1, int32(descent)-1, true)
+		}
+		pen.scale, pen.on = scale, !invert
+		t.drawRun(&pen, font, span.Text)
+	}
+}
+
+// spanStyle returns the font and scale a span is drawn with.
+func (t *T8Go) spanStyle(span *TextSpan) (*Font, int32) {
+	font, scale := span.Font, span.Scale
+	if font == nil {
+		font = t.GetFont()
+	}
+	if scale == 0 {
+		scale = t.GetTextScale()
+	}
+	return font, int32(scale)
+}
+
+// runWidth returns the unscaled advance of text in font, counting the glyphs
+// drawn for missing runes and the kerning between them.
+func runWidth(font *Font, text string) int32 {
+	var width int32
+	prev := rune(-1)
+	for _, r := range text {
+		if index := font.drawnIndex(r); index >= 0 {
+			width += int32(font.Kern(prev, r)) + int32(font.Glyphs[index].Advance)
+			prev = r
+		}
+	}
+	return width
+}
+
+// * ----- Text boxes -----
+
+// DrawTextBox draws text with the current font and scale in the width x
+// height box with its top-left corner at (x, y), wrapping lines between
+// words and at every '\n'. Words wider than the box are broken between
+// characters, and lines below the box or cut by its edges are clipped to it.
+// With AlignJustify the spaces of every wrapped line are widened to fill the
+// box; the last line of each paragraph is left-aligned. Text in boxes is
+// never rotated.
+func (t *T8Go) DrawTextBox(x, y, width, height int16, text string, align TextAlign) {
+	if t.traced() {
+		t.tracer("DrawTextBox", x, y, width, height, int16(len(text)), int16(align))
+	}
+
+	clip, ok := t.textClip(Rect{X: x, Y: y, Width: width, Height: height})
+	if !ok {
+		return
+	}
+
+	font := t.GetFont()
+	pen := t.newPen(x, y, clip)
+	pen.rotation = Rotate0
+	if !pen.on {
+		t.textRect(&pen, clip.minX, clip.minY, clip.maxX, clip.maxY, true)
+	}
+	boxWidth, ascent := int32(width), int32(font.Ascent)*pen.scale
+	pen.originY += ascent
+	for pen.originY-ascent <= clip.maxY {
+		// Scaled advances fit in the box exactly when unscaled ones fit in
+		// the box width divided by the scale
+		line, rest, last := wrapLine(font, text, boxWidth/pen.scale)
+		lineWidth := runWidth(font, line) * pen.scale
+
+		pen.along = 0
+		switch align {
+		case AlignCenter:
+			pen.along = (boxWidth - lineWidth) / 2
+		case AlignRight:
+			pen.along = boxWidth - lineWidth
+		case AlignJustify:
+			if !last {
+				t.drawJustified(&pen, font, line, boxWidth-lineWidth)
+				line = ""
+			}
+		}
+		t.drawRun(&pen, font, line)
+
+		if rest == "" {
+			return
+		}
+		text = rest
+		pen.originY += int32(font.LineHeight) * pen.scale
+	}
+}
+
+// wrapLine splits the first line of text that fits in width pixels from the
+// rest, breaking after the last space that fits, else before the first rune
+// that does not (a line holds at least one rune). last reports whether the
+// line ends a paragraph, at a '\n' or the end of text. The spaces at the
+// break are dropped.
+func wrapLine(font *Font, text string, width int32) (line, rest string, last bool) {
+	var lineWidth int32
+	space, prev := -1, rune(-1)
+	for i, r := range text {
+		switch r {
+		case '\n':
+			return trimSpaces(text[:i]), text[i+1:], true
+		case ' ':
+			space = i
+		}
+		if index := font.drawnIndex(r); index >= 0 {
+			lineWidth += int32(font.Kern(prev, r)) + int32(font.Glyphs[index].Advance)
+			prev = r
+		}
+		if lineWidth <= width || i == 0 || r == ' ' {
+			continue
+		}
+		if space > 0 {
+			line, rest = text[:space], text[space:]
+		} else {
+			line, rest = text[:i], text[i:]
+		}
+		for rest != "" && rest[0] == ' ' {
+			rest = rest[1:]
+		}
+		return trimSpaces(line), rest, false
+	}
+	return text, "", true
+}
+
+// trimSpaces removes the spaces at the end of line.
+func trimSpaces(line string) string {
+	for line != "" && line[len(line)-1] == ' ' {
+		line = line[:len(line)-1]
+	}
+	return line
+}
+
+// drawJustified draws line with extra pixels shared between its spaces, the
+// first ones taking one more when they do not divide evenly.
+func (t *T8Go) drawJustified(pen *textPen, font *Font, line string, extra int32) {
+	var gaps int32
+	for i := 0; i < len(line); i++ {
+		if line[i] == ' ' {
+			gaps++
+		}
+	}
+
+	var gap int32
+	prev := rune(-1)
+	for _, r := range line {
+		index := font.drawnIndex(r)
+		if index < 0 {
+			continue
+		}
+		glyph := &font.Glyphs[index]
+		pen.along += int32(font.Kern(prev, r)) * pen.scale
+		prev = r
+		t.drawGlyph(pen, font, glyph)
+		pen.along += int32(glyph.Advance) * pen.scale
+		if r == ' ' && extra > 0 {
+			pen.along += extra / gaps
+			if gap < extra%gaps {
+				pen.along++
+			}
+			gap++
+		}
+	}
+}
+
+// * ----- Glyph rasterizer -----
+
+// textClip is the inclusive box text is drawn in, inside the display.
+type textClip struct {
+	minX, minY, maxX, maxY int32
+}
+
+// textPen places glyphs along a baseline. Glyph pixels are addressed by
+// their distance along the baseline from its origin and across it (positive
+// below an unrotated baseline) and turned to the display by the rotation.
+// A mapped pen works in the coordinates of the caller and draws through the
+// transform.
+type textPen struct {
+	originX, originY int32        // Origin of the baseline
+	along            int32        // Pen position along the baseline
+	scale            int32        // Size of each glyph pixel in display pixels
+	rotation         TextRotation // Direction of the baseline
+	on               bool         // Whether glyph pixels are set or cleared
+	mapped           bool         // Glyphs turn or scale with the transform (see mapBox)
+	clip             textClip     // Area drawn into
+}
+
+// newPen returns a pen at (x, y) with the text scale and rotation of the
+// graphics state, setting pixels inside clip. A transform that only moves
+// moves the pen; others map the glyphs pixel by pixel, with the pen and clip
+// left in the coordinates of the caller.
+func (t *T8Go) newPen(x, y int16, clip textClip) textPen {
+	mapped := t.transformed && !t.transform.translation()
+	if t.transformed && !mapped {
+		x, y = t.transform.Apply(x, y)
+	}
+	return textPen{
+		originX:  int32(x),
+		originY:  int32(y),
+		scale:    int32(t.GetTextScale()),
+		rotation: t.state.textRotation,
+		on:       !t.state.textInvert,
+		mapped:   mapped,
+		clip:     clip,
+	}
+}
+
+// box returns the display area of the inclusive rectangle from along0 to
+// along1 and across0 to across1 in the frame of the baseline.
+func (p *textPen) box(along0, across0, along1, across1 int32) (minX, minY, maxX, maxY int32) {
+	x, y := p.originX, p.originY
+	switch p.rotation {
+	case Rotate90:
+		return x + across0, y - along1, x + across1, y - along0
+	case Rotate180:
+		return x - along1, y - across1, x - along0, y - across0
+	case Rotate270:
+		return x - across1, y + along0, x - across0, y + along1
+	default:
+		return x + along0, y + across0, x + along1, y + across1
+	}
+}
+
+// screenClip returns the clip of text drawn without a clip rectangle: the
+// visible area, or no limit when the glyphs are mapped through the
+// transform, which clips them itself.
+func (t *T8Go) screenClip() textClip {
+	if t.transformed && !t.transform.translation() {
+		return textClip{math.MinInt32, math.MinInt32, math.MaxInt32, math.MaxInt32}
+	}
+	minX, minY, maxX, maxY := t.bounds()
+	return textClip{int32(minX), int32(minY), int32(maxX), int32(maxY)}
+}
+
+// textClip returns the part of clip inside the display, or false when none
+// is. Under a transform that turns or scales, clip is in the coordinates of
+// the caller and taken whole; a transform that only moves moves it.
+func (t *T8Go) textClip(clip Rect) (textClip, bool) {
+	if t.transformed {
+		if !t.transform.translation() {
+			left, top, right, bottom := clip.edges()
+			return textClip{left, top, right - 1, bottom - 1}, left < right && top < bottom
+		}
+		clip.X, clip.Y = t.transform.Apply(clip.X, clip.Y)
+	}
+
+	minX, minY, maxX, maxY := t.bounds()
+	left, top, right, bottom := clip.edges()
+	box := textClip{
+		minX: max(left, int32(minX)),
+		minY: max(top, int32(minY)),
+		maxX: min(right-1, int32(maxX)),
+		maxY: min(bottom-1, int32(maxY)),
+	}
+	return box, box.minX <= box.maxX && box.minY <= box.maxY
+}
+
+// drawTextLine draws text in font at the pen like drawRun, on the filled box of
+// the line when the pen clears glyph pixels.
+func (t *T8Go) drawTextLine(pen *textPen, font *Font, text string) {
+	if !pen.on {
+		width := runWidth(font, text) * pen.scale
+		t.penRect(pen, pen.along, -int32(font.Ascent)*pen.scale, pen.along+width-1, int32(font.Descent)*pen.scale-1, true)
+	}
+	t.drawRun(pen, font, text)
+}
+
+// drawRun draws text in font at the pen and moves the pen after it.
+func (t *T8Go) drawRun(pen *textPen, font *Font, text string) {
+	if font == defaultFont && len(font.Glyphs) == 0 {
+		// t8go_minimal build without a font selected
+		t.setErr(ErrUnsupported)
+		return
+	}
+
+	prev := rune(-1)
+	for _, r := range text {
+		index := font.drawnIndex(r)
+		if index < 0 {
+			continue
+		}
+		glyph := &font.Glyphs[index]
+		pen.along += int32(font.Kern(prev, r)) * pen.scale
+		t.drawGlyph(pen, font, glyph)
+		pen.along += int32(glyph.Advance) * pen.scale
+		prev = r
+	}
+}
+
+// drawGlyph draws the bitmap of glyph at the pen, filling each run of pixels
+// of a row with a single rectangle. Runs are cut to the clip box of the pen,
+// so glyphs crossing its edges are drawn in part.
+func (t *T8Go) drawGlyph(pen *textPen, font *Font, glyph *Glyph) {
+	scale := pen.scale
+	left := pen.along + int32(glyph.XOffset)*scale
+	top := int32(glyph.YOffset) * scale
+	width, height := int32(glyph.Width), int32(glyph.Height)
+	if width == 0 || height == 0 {
+		return
+	}
+	minX, minY, maxX, maxY := pen.box(left, top, left+width*scale-1, top+height*scale-1)
+	clip := &pen.clip
+	if minX > clip.maxX || maxX < clip.minX || minY > clip.maxY || maxY < clip.minY {
+		return
+	}
+	if t.cachedGlyph(pen, font, glyph, minX, minY, maxX, maxY) {
+		return
+	}
+
+	bit := int(glyph.Offset) * 8
+	for row := range height {
+		rowTop := top + row*scale
+		runStart := int32(-1)
+		for col := range width {
+			set := bit>>3 < len(font.Bitmap) && font.Bitmap[bit>>3]&(0x80>>(bit&7)) != 0
+			bit++
+			switch {
+			case set && runStart < 0:
+				runStart = col
+			case !set && runStart >= 0:
+				t.penRect(pen, left+runStart*scale, rowTop, left+col*scale-1, rowTop+scale-1, pen.on)
+				runStart = -1
+			}
+		}
+		if runStart >= 0 {
+			t.penRect(pen, left+runStart*scale, rowTop, left+width*scale-1, rowTop+scale-1, pen.on)
+		}
+	}
+}
+
+// penRect draws the part inside the clip box of the pen of the inclusive
+// rectangle from along0 to along1 and across0 to across1 in the frame of the
+// baseline, with the ink when on and with its opposite otherwise.
+func (t *T8Go) penRect(pen *textPen, along0, across0, along1, across1 int32, on bool) {
+	minX, minY, maxX, maxY := pen.box(along0, across0, along1, across1)
+	clip := &pen.clip
+	minX, minY = max(minX, clip.minX), max(minY, clip.minY)
+	maxX, maxY = min(maxX, clip.maxX), min(maxY, clip.maxY)
+	t.textRect(pen, minX, minY, maxX, maxY, on)
+}
+
+// textRect draws the inclusive rectangle (minX, minY)-(maxX, maxY) of text
+// drawn with pen, with the ink when on and with its opposite otherwise,
+// through the transform when the pen is mapped.
+func (t *T8Go) textRect(pen *textPen, minX, minY, maxX, maxY int32, on bool) {
+	if minX > maxX || minY > maxY {
+		return
+	}
+	ink, _ := t.ink()
+	if pen.mapped {
+		t.mapBox(minX, minY, maxX, maxY, func(y, startX, endX int32) {
+			if on || t.state.mode == ModeXOR {
+				t.hspan(startX, endX, y)
+			} else {
+				t.writeRect(startX, y, endX, y, !ink)
+			}
+		})
+		return
+	}
+	if on || t.state.mode == ModeXOR {
+		t.fillRect(minX, minY, maxX, maxY)
+		return
+	}
+	// Cleared pixels take the opposite of the ink
+	t.writeRect(minX, minY, maxX, maxY, !ink)
+}
@@ -0,0 +1,36 @@
+// Package textfmt draws text formatted with fmt verbs on a t8go context. It
+// is kept out of the core package so programs that never format text do not
+// link fmt, which is large on TinyGo. For numbers redrawn every frame, the
+// context's DrawInt and DrawFixed draw without allocating.
+package textfmt
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/redghc/t8go"
+)
+
+// Printer formats text into a buffer it keeps and draws it on a context.
+// Once the buffer has grown to the longest text drawn, formatting reuses it,
+// but passing arguments through ...any still allocates for most values that
+// are not constants, typically one allocation per call.
+type Printer struct {
+	ctx t8go.IDisplayDrawer // Context that draws the text
+	buf []byte              // Reused text buffer
+}
+
+// New creates a printer drawing on ctx with room for capacity bytes of text.
+// Longer text grows the buffer as needed.
+func New(ctx t8go.IDisplayDrawer, capacity int) *Printer {
+	return &Printer{ctx: ctx, buf: make([]byte, 0, max(capacity, 0))}
+}
+
+// Printf formats text like fmt.Sprintf and draws it like DrawText, with the
+// pen at x on the baseline y.
+func (p *Printer) Printf(x, y int16, format string, args ...any) {
+	p.buf = fmt.Appendf(p.buf[:0], format, args...)
+
+	// The string shares the buffer; it is only read while drawing
+	p.ctx.DrawText(x, y, unsafe.String(unsafe.SliceData(p.buf), len(p.buf)))
+}
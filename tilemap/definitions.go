@@ -0,0 +1,42 @@
+package tilemap
+
+import (
+	"errors"
+
+	"github.com/redghc/t8go"
+)
+
+// Sheet is a sprite sheet: a grid of equally sized tiles stored as a
+// page-layout bitmap (see the framebuf package), numbered left to right and
+// top to bottom. Tiles can be drawn with t8go on a memory display whose
+// Buffer becomes the sheet data.
+type Sheet struct {
+	Data       []byte // Page-layout bitmap, Width * ((Height + 7) / 8) bytes
+	Width      uint16 // Sheet width in pixels
+	Height     uint16 // Sheet height in pixels
+	TileWidth  uint8  // Tile width in pixels
+	TileHeight uint8  // Tile height in pixels
+}
+
+// TileMap is a grid of tile indices into a Sheet, usually larger than the
+// screen, drawn through a camera into a viewport.
+type TileMap struct {
+	sheet   *Sheet    // Tile bitmaps
+	columns int       // Map width in tiles
+	rows    int       // Map height in tiles
+	tiles   []uint8   // Tile indices, row by row
+	view    t8go.Rect // Screen area the map is drawn in
+
+	camera t8go.Point // World position shown at the top-left corner of the view
+	drawn  t8go.Point // Camera position of the last Draw
+	valid  bool       // Whether the view holds the map as of drawn
+	dirty  []bool     // Tile columns changed since the last Draw
+}
+
+// Common errors returned by the tilemap package.
+var (
+	ErrNilSheet          = errors.New("sprite sheet cannot be nil")                   // Nil sheet passed to New
+	ErrInvalidSheet      = errors.New("sprite sheet has no tiles or too little data") // Sheet smaller than one tile or Data shorter than its size
+	ErrInvalidDimensions = errors.New("invalid map dimensions")                       // Zero columns or rows, or a world larger than int16 pixels
+	ErrTilesSize         = errors.New("tile data does not match the map size")        // Load called with columns * rows indices missing
+)
@@ -0,0 +1,250 @@
+// Package tilemap provides a scrolling tile map for games: a world of tiles
+// from a sprite sheet, larger than the screen, viewed through a camera.
+//
+// Draw only redraws what changed since the previous frame. When the camera
+// moves horizontally, the view is shifted in the display buffer and only the
+// newly exposed pixel columns are drawn; tiles changed with SetTile redraw
+// their column. Vertical camera moves, and views not aligned to the 8-pixel
+// pages of the buffer, redraw the whole view.
+package tilemap
+
+import (
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/framebuf"
+)
+
+// New creates a columns x rows map of tile 0 drawn into view, the screen area
+// it occupies. The world may not exceed 32767 pixels in either direction.
+func New(sheet *Sheet, columns, rows uint16, view t8go.Rect) (*TileMap, error) {
+	if sheet == nil {
+		return nil, ErrNilSheet
+	}
+	if sheet.TileWidth == 0 || sheet.TileHeight == 0 ||
+		sheet.Width < uint16(sheet.TileWidth) || sheet.Height < uint16(sheet.TileHeight) ||
+		len(sheet.Data) < framebuf.Size(int(sheet.Width), int(sheet.Height)) {
+		return nil, ErrInvalidSheet
+	}
+	if columns == 0 || rows == 0 ||
+		int(columns)*int(sheet.TileWidth) > 0x7FFF || int(rows)*int(sheet.TileHeight) > 0x7FFF {
+		return nil, ErrInvalidDimensions
+	}
+
+	return &TileMap{
+		sheet:   sheet,
+		columns: int(columns),
+		rows:    int(rows),
+		tiles:   make([]uint8, int(columns)*int(rows)),
+		view:    view,
+		dirty:   make([]bool, columns),
+	}, nil
+}
+
+// * ----- Tiles -----
+
+// Load replaces every tile of the map with tiles, given row by row.
+func (m *TileMap) Load(tiles []uint8) error {
+	if len(tiles) != len(m.tiles) {
+		return ErrTilesSize
+	}
+	copy(m.tiles, tiles)
+	m.valid = false
+	return nil
+}
+
+// SetTile sets the tile at (column, row) and marks its column for redraw.
+// Positions outside the map are ignored.
+func (m *TileMap) SetTile(column, row uint16, tile uint8) {
+	if int(column) >= m.columns || int(row) >= m.rows {
+		return
+	}
+	index := int(row)*m.columns + int(column)
+	if m.tiles[index] != tile {
+		m.tiles[index] = tile
+		m.dirty[column] = true
+	}
+}
+
+// Tile returns the tile at (column, row), or 0 outside the map.
+func (m *TileMap) Tile(column, row uint16) uint8 {
+	if int(column) >= m.columns || int(row) >= m.rows {
+		return 0
+	}
+	return m.tiles[int(row)*m.columns+int(column)]
+}
+
+// TileAt returns the tile covering the world pixel (x, y), or 0 outside the
+// map, for collision checks.
+func (m *TileMap) TileAt(x, y int16) uint8 {
+	if x < 0 || y < 0 {
+		return 0
+	}
+	return m.Tile(uint16(x)/uint16(m.sheet.TileWidth), uint16(y)/uint16(m.sheet.TileHeight))
+}
+
+// Size returns the size of the world in pixels.
+func (m *TileMap) Size() (width, height int16) {
+	return int16(m.columns * int(m.sheet.TileWidth)), int16(m.rows * int(m.sheet.TileHeight))
+}
+
+// * ----- Camera -----
+
+// SetCamera moves the camera so the world pixel (x, y) is shown at the
+// top-left corner of the view. The camera is clamped to keep the view
+// inside the world.
+func (m *TileMap) SetCamera(x, y int16) {
+	worldWidth, worldHeight := m.Size()
+	m.camera = t8go.Point{
+		X: clamp(x, worldWidth-m.view.Width),
+		Y: clamp(y, worldHeight-m.view.Height),
+	}
+}
+
+// Camera returns the world pixel shown at the top-left corner of the view.
+func (m *TileMap) Camera() t8go.Point {
+	return m.camera
+}
+
+// CenterOn moves the camera so the world pixel (x, y) is in the middle of the
+// view, as far as the world edges allow.
+func (m *TileMap) CenterOn(x, y int16) {
+	m.SetCamera(x-m.view.Width/2, y-m.view.Height/2)
+}
+
+// ToScreen converts a world position to screen coordinates, for drawing
+// sprites on top of the map.
+func (m *TileMap) ToScreen(world t8go.Point) t8go.Point {
+	return t8go.Point{
+		X: world.X - m.camera.X + m.view.X,
+		Y: world.Y - m.camera.Y + m.view.Y,
+	}
+}
+
+// View returns the screen area the map is drawn in.
+func (m *TileMap) View() t8go.Rect {
+	return m.view
+}
+
+// Invalidate makes the next Draw redraw the whole view, for example after
+// something else was drawn over it.
+func (m *TileMap) Invalidate() {
+	m.valid = false
+}
+
+// * ----- Drawing -----
+
+// Draw brings the view up to date with the camera and the tiles, and returns
+// the screen area that changed (empty if nothing did), ready to pass to
+// FlushRegion.
+func (m *TileMap) Draw(ctx t8go.IDisplayDrawer) t8go.Rect {
+	view := m.view.Intersect(ctx.Bounds())
+	if view.Empty() {
+		return t8go.Rect{}
+	}
+
+	width, height := ctx.Size()
+	buffer := framebuf.Buffer{Data: ctx.Buffer(), Width: int(width), Height: int(height)}
+	direct := len(buffer.Data) >= framebuf.Size(buffer.Width, buffer.Height) &&
+		t8go.DirectBuffer(ctx.GetDisplay()).Layout == t8go.LayoutPageMajor
+
+	shiftX := int(m.camera.X) - int(m.drawn.X)
+	scrollable := m.valid && direct && m.camera.Y == m.drawn.Y && view == m.view &&
+		view.Y%8 == 0 && view.Height%8 == 0 && abs(shiftX) < int(view.Width)
+	m.drawn = m.camera
+
+	if !scrollable {
+		m.valid = true
+		clear(m.dirty)
+		m.drawColumns(ctx, buffer, direct, view, view.X, view.Max().X)
+		return view
+	}
+
+	var changed t8go.Rect
+	if shiftX != 0 {
+		m.shift(buffer, view, shiftX)
+		changed = view
+		if shiftX > 0 {
+			m.drawColumns(ctx, buffer, direct, view, view.Max().X+1-int16(shiftX), view.Max().X)
+		} else {
+			m.drawColumns(ctx, buffer, direct, view, view.X, view.X-int16(shiftX)-1)
+		}
+	}
+
+	// Redraw the visible part of changed tile columns
+	tileWidth := int16(m.sheet.TileWidth)
+	for column, dirty := range m.dirty {
+		if !dirty {
+			continue
+		}
+		m.dirty[column] = false
+
+		left := int16(column)*tileWidth - m.camera.X + view.X
+		columns := t8go.Rect{X: left, Y: view.Y, Width: tileWidth, Height: view.Height}.Intersect(view)
+		if columns.Empty() {
+			continue
+		}
+		m.drawColumns(ctx, buffer, direct, view, columns.X, columns.Max().X)
+		changed = changed.Union(columns)
+	}
+	return changed
+}
+
+// shift moves the pages of view in buffer left by shiftX pixels (right when
+// negative), as the camera moved right by shiftX.
+func (m *TileMap) shift(buffer framebuf.Buffer, view t8go.Rect, shiftX int) {
+	for page := int(view.Y) / 8; page < int(view.Y+view.Height)/8; page++ {
+		start := page*buffer.Width + int(view.X)
+		row := buffer.Data[start : start+int(view.Width)]
+		if shiftX > 0 {
+			copy(row, row[shiftX:])
+		} else {
+			copy(row[-shiftX:], row)
+		}
+	}
+}
+
+// drawColumns draws the screen columns minX..maxX (inclusive) of view from
+// the current camera position, into buffer when direct and through ctx
+// otherwise.
+func (m *TileMap) drawColumns(ctx t8go.IDisplayDrawer, buffer framebuf.Buffer, direct bool, view t8go.Rect, minX, maxX int16) {
+	sheet := m.sheet
+	tileWidth, tileHeight := int(sheet.TileWidth), int(sheet.TileHeight)
+	sheetColumns := int(sheet.Width) / tileWidth
+	sheetTiles := sheetColumns * (int(sheet.Height) / tileHeight)
+	tiles := framebuf.Buffer{Data: sheet.Data, Width: int(sheet.Width), Height: int(sheet.Height)}
+
+	for x := minX; x <= maxX; x++ {
+		worldX := int(m.camera.X) + int(x-view.X)
+		column, tileX := worldX/tileWidth, worldX%tileWidth
+
+		for y := view.Y; y < view.Y+view.Height; y++ {
+			worldY := int(m.camera.Y) + int(y-view.Y)
+			row, tileY := worldY/tileHeight, worldY%tileHeight
+
+			on := false
+			if column < m.columns && row < m.rows {
+				if tile := int(m.tiles[row*m.columns+column]); tile < sheetTiles {
+					on = tiles.GetPixel(tile%sheetColumns*tileWidth+tileX, tile/sheetColumns*tileHeight+tileY)
+				}
+			}
+
+			if direct {
+				buffer.SetPixel(int(x), int(y), on)
+			} else {
+				ctx.SetPixel(x, y, on)
+			}
+		}
+	}
+}
+
+// clamp limits value to 0..limit, or to 0 when limit is negative.
+func clamp(value, limit int16) int16 {
+	return max(min(value, limit), 0)
+}
+
+// abs returns the absolute value of value.
+func abs(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
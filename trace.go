@@ -0,0 +1,25 @@
+package t8go
+
+// Tracer receives every drawing call made on a context, with the method name
+// as op (for example "DrawLine") and the integer arguments in declaration
+// order. Angles and quadrant masks are widened to int16. The args slice is
+// only valid during the call.
+type Tracer func(op string, args ...int16)
+
+// SetTracer installs tracer to record every drawing call, for debugging
+// flicker and over-draw or for generating golden tests. Every call is
+// reported once: primitives built on other primitives (such as DrawRoundBox)
+// do not report the calls they make. Pass nil to remove the tracer; an unset tracer costs a single nil check per
+// call. t8go_minimal builds compile the hooks out entirely and record
+// ErrUnsupported (see Err) when a tracer is set.
+func (t *T8Go) SetTracer(tracer Tracer) {
+	if !traceEnabled && tracer != nil {
+		t.setErr(ErrUnsupported)
+	}
+	t.tracer = tracer
+}
+
+// traced reports whether drawing calls must be reported to the tracer.
+func (t *T8Go) traced() bool {
+	return traceEnabled && t.tracer != nil
+}
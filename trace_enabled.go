@@ -0,0 +1,5 @@
+//go:build !t8go_minimal
+
+package t8go
+
+const traceEnabled = true // Drawing calls are reported to the tracer set with SetTracer
@@ -0,0 +1,41 @@
+package t8go_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/redghc/t8go"
+)
+
+func TestTracerReportsEachCallOnce(t *testing.T) {
+	tests := []struct {
+		op   string
+		draw func(ctx t8go.IDisplayDrawer)
+	}{
+		{"DrawVLine", func(ctx t8go.IDisplayDrawer) { ctx.DrawVLine(4, 4, 10) }},
+		{"DrawLineAngle", func(ctx t8go.IDisplayDrawer) { ctx.DrawLineAngle(20, 20, 10, 40) }},
+		{"DrawBoxCoords", func(ctx t8go.IDisplayDrawer) { ctx.DrawBoxCoords(30, 20, 10, 5) }},
+		{"DrawBoxFillCoords", func(ctx t8go.IDisplayDrawer) { ctx.DrawBoxFillCoords(30, 20, 10, 5) }},
+		{"DrawRoundBox", func(ctx t8go.IDisplayDrawer) { ctx.DrawRoundBox(10, 10, 30, 20, 0) }},
+		{"DrawLineThick", func(ctx t8go.IDisplayDrawer) { ctx.DrawLineThick(2, 2, 40, 30, 1) }},
+		{"DrawBoxThick", func(ctx t8go.IDisplayDrawer) { ctx.DrawBoxThick(10, 10, 30, 20, 1) }},
+		{"DrawCircleThick", func(ctx t8go.IDisplayDrawer) { ctx.DrawCircleThick(30, 30, 10, 1, t8go.DrawAll) }},
+		{"DrawArcFill", func(ctx t8go.IDisplayDrawer) { ctx.DrawArcFill(30, 30, 10, 0, 0) }},
+		{"DrawArcThick", func(ctx t8go.IDisplayDrawer) { ctx.DrawArcThick(30, 30, 10, 4, 0, 0) }},
+		{"DrawTriangleFill", func(ctx t8go.IDisplayDrawer) {
+			ctx.PushTransform(t8go.IdentityTransform().Translate(20, 10).Rotate(30))
+			ctx.DrawTriangleFill(0, 0, 20, 5, 5, 20)
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.op, func(t *testing.T) {
+			ctx := newContext(t)
+			var ops []string
+			ctx.SetTracer(func(op string, args ...int16) { ops = append(ops, op) })
+			test.draw(ctx)
+			if want := []string{test.op}; !slices.Equal(ops, want) {
+				t.Errorf("traced %v, want %v", ops, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,764 @@
+package t8go
+
+import (
+	"math"
+
+	"github.com/redghc/t8go/fixed"
+	"github.com/redghc/t8go/helpers"
+)
+
+// IdentityTransform returns the transform that leaves coordinates unchanged,
+// the starting point for building one with Translate, Rotate and Scale.
+func IdentityTransform() Transform {
+	return Transform{A: fixed.Q16One, D: fixed.Q16One}
+}
+
+// Translate returns m preceded by a move of (dx, dy), so drawing at (x, y)
+// through the result draws at (x+dx, y+dy) through m.
+func (m Transform) Translate(dx, dy int16) Transform {
+	return m.Mul(Transform{A: fixed.Q16One, D: fixed.Q16One, X: fixed.Q16FromInt(dx), Y: fixed.Q16FromInt(dy)})
+}
+
+// Rotate returns m preceded by a counterclockwise rotation about the origin.
+// The angle is in 0..255 units like DrawArc (64 = 90°).
+func (m Transform) Rotate(angle uint8) Transform {
+	cos := fixed.Q16(helpers.Cos256(angle)) << (fixed.Q16Shift - helpers.TrigShift)
+	sin := fixed.Q16(helpers.Sin256(angle)) << (fixed.Q16Shift - helpers.TrigShift)
+
+	// Y grows downward, so a counterclockwise turn moves +x towards -y
+	return m.Mul(Transform{A: cos, B: sin, C: -sin, D: cos})
+}
+
+// Scale returns m preceded by a scale of sx horizontally and sy vertically
+// about the origin. Negative factors mirror.
+func (m Transform) Scale(sx, sy fixed.Q16) Transform {
+	return m.Mul(Transform{A: sx, D: sy})
+}
+
+// Mul returns the transform that applies next and then m.
+func (m Transform) Mul(next Transform) Transform {
+	return Transform{
+		A: m.A.Mul(next.A) + m.B.Mul(next.C),
+		B: m.A.Mul(next.B) + m.B.Mul(next.D),
+		C: m.C.Mul(next.A) + m.D.Mul(next.C),
+		D: m.C.Mul(next.B) + m.D.Mul(next.D),
+		X: m.A.Mul(next.X) + m.B.Mul(next.Y) + m.X,
+		Y: m.C.Mul(next.X) + m.D.Mul(next.Y) + m.Y,
+	}
+}
+
+// Apply returns the pixel that m draws the pixel at (x, y) to: the one
+// holding the image of its center. Results beyond the int16 range saturate.
+func (m Transform) Apply(x, y int16) (int16, int16) {
+	px, py := m.pixel(int32(x), int32(y))
+	return helpers.ClampInt16(px), helpers.ClampInt16(py)
+}
+
+// PushTransform saves the current transform and combines it with m, which
+// applies first: after PushTransform(IdentityTransform().Translate(40, 8)),
+// a widget drawing at (0, 0) draws at (40, 8) of what was there before.
+// Every Draw* call goes through the transform until the matching
+// PopTransform. Points such as line ends, triangle corners and centers are
+// transformed exactly, and boxes turn into four-sided shapes when rotated.
+// Circles and arcs stay round, with their radius and thickness scaled by the
+// mean of the two scales and arcs turned by the rotation; ellipses keep
+// their axes level and take the width and height of the transformed
+// ellipse. Circle quadrant masks turn to the nearest quadrant. Text,
+// bitmaps, buffers and nine-patches turn and scale with the transform: each
+// display pixel they may cover takes the source pixel it maps back to, and
+// text clips and text boxes turn with their text. The glyph cache is
+// skipped while they do. SetPixel, GetPixel, ClearRegion and the clip areas
+// stay in display coordinates. The transform stack is separate from the graphics
+// state of PushState.
+func (t *T8Go) PushTransform(m Transform) {
+	if t.transforms == nil {
+		t.transforms = make([]Transform, 0, stateStackSize)
+	}
+	current := t.GetTransform()
+	t.transforms = append(t.transforms, current)
+	t.transform = current.Mul(m)
+	t.transformed = t.transform != IdentityTransform()
+}
+
+// PopTransform restores the transform saved by the matching PushTransform
+// call. Without a saved transform, it records ErrTransformUnderflow (see
+// Err) and leaves the current transform unchanged.
+func (t *T8Go) PopTransform() {
+	last := len(t.transforms) - 1
+	if last < 0 {
+		t.setErr(ErrTransformUnderflow)
+		return
+	}
+	t.transform = t.transforms[last]
+	t.transforms = t.transforms[:last]
+	t.transformed = t.transform != IdentityTransform()
+}
+
+// GetTransform returns the current transform.
+func (t *T8Go) GetTransform() Transform {
+	if len(t.transforms) == 0 {
+		return IdentityTransform()
+	}
+	return t.transform
+}
+
+// * ----- Mapping -----
+
+// pixel returns the pixel holding the image of the center of the pixel at
+// (x, y), in half-pixel units so the centers stay integers.
+func (m *Transform) pixel(x, y int32) (int32, int32) {
+	hx, hy := int64(2*x+1), int64(2*y+1)
+	px := (int64(m.A)*hx + int64(m.B)*hy + 2*int64(m.X)) >> (fixed.Q16Shift + 1)
+	py := (int64(m.C)*hx + int64(m.D)*hy + 2*int64(m.Y)) >> (fixed.Q16Shift + 1)
+	return clampInt32(px), clampInt32(py)
+}
+
+// point returns the pixel holding the image of the point (x, y), given in
+// pixels with shift fraction bits, whole at the centers of the pixels.
+func (m *Transform) point(x, y int64, shift uint) (int32, int32) {
+	hx, hy := 2*x+1<<shift, 2*y+1<<shift
+	px := (int64(m.A)*hx + int64(m.B)*hy + int64(m.X)<<(shift+1)) >> (fixed.Q16Shift + 1 + shift)
+	py := (int64(m.C)*hx + int64(m.D)*hy + int64(m.Y)<<(shift+1)) >> (fixed.Q16Shift + 1 + shift)
+	return clampInt32(px), clampInt32(py)
+}
+
+// corner returns the image of the pixel corner (x, y), rounded to the
+// nearest corner.
+func (m *Transform) corner(x, y int32) (int32, int32) {
+	half := int64(1) << (fixed.Q16Shift - 1)
+	cx := (int64(m.A)*int64(x) + int64(m.B)*int64(y) + int64(m.X) + half) >> fixed.Q16Shift
+	cy := (int64(m.C)*int64(x) + int64(m.D)*int64(y) + int64(m.Y) + half) >> fixed.Q16Shift
+	return clampInt32(cx), clampInt32(cy)
+}
+
+// box returns the pixels covered by the box around the image of the width x
+// height box of pixels at (originX, originY), in the form of boxBounds, or
+// false when it covers none.
+func (m *Transform) box(originX, originY, width, height int16) (x, y, w, h int16, ok bool) {
+	minX, minY, maxX, maxY, ok := boxBounds(originX, originY, width, height)
+	if !ok {
+		return 0, 0, 0, 0, false
+	}
+
+	left, top := int32(math.MaxInt32), int32(math.MaxInt32)
+	right, bottom := int32(math.MinInt32), int32(math.MinInt32)
+	for _, corner := range [4][2]int32{{minX, minY}, {maxX + 1, minY}, {minX, maxY + 1}, {maxX + 1, maxY + 1}} {
+		cx, cy := m.corner(corner[0], corner[1])
+		left, top = min(left, cx), min(top, cy)
+		right, bottom = max(right, cx), max(bottom, cy)
+	}
+	left, top = max(left, math.MinInt16), max(top, math.MinInt16)
+	right, bottom = min(right, math.MaxInt16), min(bottom, math.MaxInt16)
+	if right <= left || bottom <= top {
+		return 0, 0, 0, 0, false
+	}
+	return int16(left), int16(top), int16(right - left), int16(bottom - top), true
+}
+
+// aligned reports whether m keeps the edges of boxes level, turning them
+// only by multiples of 90°.
+func (m *Transform) aligned() bool {
+	return m.B == 0 && m.C == 0 || m.A == 0 && m.D == 0
+}
+
+// translation reports whether m only moves, without turning or scaling.
+func (m *Transform) translation() bool {
+	return m.A == fixed.Q16One && m.D == fixed.Q16One && m.B == 0 && m.C == 0
+}
+
+// mirrored reports whether m flips shapes over.
+func (m *Transform) mirrored() bool {
+	return int64(m.A)*int64(m.D) < int64(m.B)*int64(m.C)
+}
+
+// length scales length by the mean of the two scales of m, the square root
+// of its area ratio.
+func (m *Transform) length(length int16) int16 {
+	area := int64(m.A)*int64(m.D) - int64(m.B)*int64(m.C)
+	scale := int64(isqrt64(uint64(helpers.Abs(area)))) // Q16.16
+	return helpers.ClampInt16(clampInt32((int64(length)*scale + 1<<(fixed.Q16Shift-1)) >> fixed.Q16Shift))
+}
+
+// ellipse returns the radii of the level ellipse around the image of the
+// ellipse with radii radiusX and radiusY.
+func (m *Transform) ellipse(radiusX, radiusY int16) (int16, int16) {
+	// Extents in Q8.8, so their squares fit in 64 bits
+	ax, bx := int64(m.A)*int64(radiusX)>>8, int64(m.B)*int64(radiusY)>>8
+	ay, by := int64(m.C)*int64(radiusX)>>8, int64(m.D)*int64(radiusY)>>8
+	halfWidth := (int64(isqrt64(uint64(ax*ax+bx*bx))) + 1<<7) >> 8
+	halfHeight := (int64(isqrt64(uint64(ay*ay+by*by))) + 1<<7) >> 8
+	return helpers.ClampInt16(clampInt32(halfWidth)), helpers.ClampInt16(clampInt32(halfHeight))
+}
+
+// angles returns the arc from angleStart to angleEnd turned by m. Mirrored
+// transforms reverse the direction of the arc.
+func (m *Transform) angles(angleStart, angleEnd uint8) (uint8, uint8) {
+	turn := vectorAngle(int64(m.A), int64(m.C))
+	if m.mirrored() {
+		return turn - angleEnd, turn - angleStart
+	}
+	return turn + angleStart, turn + angleEnd
+}
+
+// quadrants returns the quadrants that the quadrants of mask turn to.
+func (m *Transform) quadrants(mask DrawQuadrants) DrawQuadrants {
+	if mask == DrawNone || mask == DrawAll {
+		return mask
+	}
+
+	var turned DrawQuadrants
+	for _, quadrant := range [4]struct {
+		flag   DrawQuadrants
+		dx, dy int64
+	}{{DrawTopLeft, -1, -1}, {DrawTopRight, 1, -1}, {DrawBottomRight, 1, 1}, {DrawBottomLeft, -1, 1}} {
+		if !mask.has(quadrant.flag) {
+			continue
+		}
+		x := int64(m.A)*quadrant.dx + int64(m.B)*quadrant.dy
+		y := int64(m.C)*quadrant.dx + int64(m.D)*quadrant.dy
+		switch {
+		case x < 0 && y < 0:
+			turned |= DrawTopLeft
+		case y < 0:
+			turned |= DrawTopRight
+		case x < 0:
+			turned |= DrawBottomLeft
+		default:
+			turned |= DrawBottomRight
+		}
+	}
+	return turned
+}
+
+// vectorAngle returns the direction of (x, y) in 0..255 units, with 0
+// pointing right and 64 up like the angles of DrawArc.
+func vectorAngle(x, y int64) uint8 {
+	y = -y // Y grows downward
+	absX, absY := helpers.Abs(x), helpers.Abs(y)
+	for absX > math.MaxInt16 || absY > math.MaxInt16 {
+		absX, absY = absX>>1, absY>>1
+	}
+
+	// Angle within the quadrant, from the octant approximation of DrawArc
+	base := helpers.ApproxAtanUnit64(int16(absY), int16(absX))
+	if absY > absX {
+		base = 64 - helpers.ApproxAtanUnit64(int16(absX), int16(absY))
+	}
+
+	switch {
+	case x >= 0 && y >= 0:
+		return base
+	case y >= 0:
+		return 128 - base
+	case x < 0:
+		return 128 + base
+	default:
+		return -base
+	}
+}
+
+// clampInt32 saturates value to the int32 range.
+func clampInt32(value int64) int32 {
+	return int32(min(max(value, math.MinInt32), math.MaxInt32))
+}
+
+// isqrt64 returns the integer square root of value, rounded down.
+func isqrt64(value uint64) uint64 {
+	root, bit := uint64(0), uint64(1)<<62
+	for bit > value {
+		bit >>= 2
+	}
+	for bit != 0 {
+		if value >= root+bit {
+			value -= root + bit
+			root = root>>1 + bit
+		} else {
+			root >>= 1
+		}
+		bit >>= 2
+	}
+	return root
+}
+
+// * ----- Transformed drawing -----
+
+// The transformed* methods draw a call made through the transform: they map
+// its arguments to display coordinates and repeat the call with the
+// transform suspended.
+
+// transformedLine draws DrawLine through the transform.
+func (t *T8Go) transformedLine(startX, startY, endX, endY int16) {
+	startX, startY = t.transform.Apply(startX, startY)
+	endX, endY = t.transform.Apply(endX, endY)
+	t.transformed = false
+	t.drawLine(startX, startY, endX, endY)
+	t.transformed = true
+}
+
+// transformedLineThick draws DrawLineThick through the transform.
+func (t *T8Go) transformedLineThick(startX, startY, endX, endY, width int16) {
+	startX, startY = t.transform.Apply(startX, startY)
+	endX, endY = t.transform.Apply(endX, endY)
+	width = t.transform.length(width)
+	t.transformed = false
+	t.drawLineThick(startX, startY, endX, endY, width)
+	t.transformed = true
+}
+
+// transformedBox draws DrawBox through the transform, as the outline of a
+// four-sided shape when the box turns.
+func (t *T8Go) transformedBox(originX, originY, width, height int16) {
+	if !t.transform.aligned() {
+		minX, minY, maxX, maxY, ok := boxBounds(originX, originY, width, height)
+		if !ok || maxX == minX || maxY == minY {
+			return
+		}
+		path := outlinePath{t: t}
+		path.add(t.transform.pixel(minX, minY))
+		path.add(t.transform.pixel(maxX, minY))
+		path.add(t.transform.pixel(maxX, maxY))
+		path.add(t.transform.pixel(minX, maxY))
+		path.close()
+		return
+	}
+
+	x, y, w, h, ok := t.transform.box(originX, originY, width, height)
+	if !ok {
+		return
+	}
+	t.transformed = false
+	t.drawBox(x, y, w, h)
+	t.transformed = true
+}
+
+// transformedBoxThick draws DrawBoxThick through the transform, as a
+// four-sided shape with a four-sided hole when the box turns.
+func (t *T8Go) transformedBoxThick(originX, originY, width, height, thickness int16) {
+	if !t.transform.aligned() {
+		minX, minY, maxX, maxY, ok := boxBounds(originX, originY, width, height)
+		edge := int32(thickness)
+		switch {
+		case !ok:
+		case thickness <= 1:
+			t.drawBox(originX, originY, width, height)
+		case maxX-minX+1 <= 2*edge || maxY-minY+1 <= 2*edge:
+			t.drawBoxFill(originX, originY, width, height)
+		default:
+			t.fillQuadRing(minX, minY, maxX, maxY, edge)
+		}
+		return
+	}
+
+	x, y, w, h, ok := t.transform.box(originX, originY, width, height)
+	if !ok {
+		return
+	}
+	thickness = t.transform.length(thickness)
+	t.transformed = false
+	t.drawBoxThick(x, y, w, h, thickness)
+	t.transformed = true
+}
+
+// transformedRoundBox draws DrawRoundBox through the transform, as the
+// outline of a shape with corners of straight segments when the box turns.
+func (t *T8Go) transformedRoundBox(originX, originY, width, height, cornerRadius int16) {
+	if !t.transform.aligned() {
+		minX, minY, maxX, maxY, ok := boxBounds(originX, originY, width, height)
+		if !ok || maxX == minX || maxY == minY {
+			return
+		}
+		if cornerRadius <= 0 {
+			t.drawBox(originX, originY, width, height)
+			return
+		}
+		radius := min(int32(cornerRadius), min(maxX-minX, maxY-minY)/2)
+		path := outlinePath{t: t}
+		t.roundBoxPath(minX, minY, maxX, maxY, radius, path.add)
+		path.close()
+		return
+	}
+
+	x, y, w, h, ok := t.transform.box(originX, originY, width, height)
+	if !ok {
+		return
+	}
+	cornerRadius = t.transform.length(cornerRadius)
+	t.transformed = false
+	t.drawRoundBox(x, y, w, h, cornerRadius)
+	t.transformed = true
+}
+
+// transformedBoxFill draws DrawBoxFill through the transform, as a
+// four-sided shape when the box turns.
+func (t *T8Go) transformedBoxFill(originX, originY, width, height int16) {
+	if !t.transform.aligned() {
+		minX, minY, maxX, maxY, ok := boxBounds(originX, originY, width, height)
+		if !ok {
+			return
+		}
+		x1, y1 := t.transform.pixel(minX, minY)
+		x2, y2 := t.transform.pixel(maxX, minY)
+		x3, y3 := t.transform.pixel(maxX, maxY)
+		x4, y4 := t.transform.pixel(minX, maxY)
+		t.fillQuad(x1, y1, x2, y2, x3, y3, x4, y4)
+		return
+	}
+
+	x, y, w, h, ok := t.transform.box(originX, originY, width, height)
+	if !ok {
+		return
+	}
+	t.transformed = false
+	t.drawBoxFill(x, y, w, h)
+	t.transformed = true
+}
+
+// transformedRoundBoxFill draws DrawRoundBoxFill through the transform, as
+// the shape of transformedRoundBox, filled, when the box turns.
+func (t *T8Go) transformedRoundBoxFill(originX, originY, width, height, cornerRadius int16) {
+	if !t.transform.aligned() {
+		minX, minY, maxX, maxY, ok := boxBounds(originX, originY, width, height)
+		if !ok {
+			return
+		}
+		if cornerRadius <= 0 {
+			t.drawBoxFill(originX, originY, width, height)
+			return
+		}
+		_, top, _, height, ok := t.transform.box(originX, originY, width, height)
+		if !ok {
+			return
+		}
+
+		// The corners lie within a pixel of the box around the shape.
+		radius := min(int32(cornerRadius), min(maxX-minX, maxY-minY)/2)
+		minRow, maxRow := t.rows.reset(helpers.ClampInt16(int32(top)-1), helpers.ClampInt16(int32(top)+int32(height)))
+		var firstX, firstY, lastX, lastY int32
+		corners := 0
+		t.roundBoxPath(minX, minY, maxX, maxY, radius, func(x, y int32) {
+			if corners == 0 {
+				firstX, firstY = x, y
+			} else {
+				scanAddLineToSpans(t.rows, helpers.ClampInt16(lastX), helpers.ClampInt16(lastY), helpers.ClampInt16(x), helpers.ClampInt16(y))
+			}
+			lastX, lastY = x, y
+			corners++
+		})
+		scanAddLineToSpans(t.rows, helpers.ClampInt16(lastX), helpers.ClampInt16(lastY), helpers.ClampInt16(firstX), helpers.ClampInt16(firstY))
+		t.fillSpans(minRow, maxRow)
+		return
+	}
+
+	x, y, w, h, ok := t.transform.box(originX, originY, width, height)
+	if !ok {
+		return
+	}
+	cornerRadius = t.transform.length(cornerRadius)
+	t.transformed = false
+	t.drawRoundBoxFill(x, y, w, h, cornerRadius)
+	t.transformed = true
+}
+
+// transformedTriangle draws DrawTriangle through the transform.
+func (t *T8Go) transformedTriangle(x1, y1, x2, y2, x3, y3 int16) {
+	x1, y1 = t.transform.Apply(x1, y1)
+	x2, y2 = t.transform.Apply(x2, y2)
+	x3, y3 = t.transform.Apply(x3, y3)
+	t.transformed = false
+	t.drawTriangle(x1, y1, x2, y2, x3, y3)
+	t.transformed = true
+}
+
+// transformedTriangleFill draws DrawTriangleFill through the transform.
+func (t *T8Go) transformedTriangleFill(x1, y1, x2, y2, x3, y3 int16) {
+	x1, y1 = t.transform.Apply(x1, y1)
+	x2, y2 = t.transform.Apply(x2, y2)
+	x3, y3 = t.transform.Apply(x3, y3)
+	t.transformed = false
+	t.drawTriangleFill(x1, y1, x2, y2, x3, y3)
+	t.transformed = true
+}
+
+// transformedCircle draws DrawCircle through the transform.
+func (t *T8Go) transformedCircle(centerX, centerY, radius int16, mask DrawQuadrants) {
+	centerX, centerY = t.transform.Apply(centerX, centerY)
+	radius, mask = t.transform.length(radius), t.transform.quadrants(mask)
+	t.transformed = false
+	t.drawCircle(centerX, centerY, radius, mask)
+	t.transformed = true
+}
+
+// transformedCircleThick draws DrawCircleThick through the transform.
+func (t *T8Go) transformedCircleThick(centerX, centerY, radius, thickness int16, mask DrawQuadrants) {
+	centerX, centerY = t.transform.Apply(centerX, centerY)
+	radius, thickness = t.transform.length(radius), t.transform.length(thickness)
+	mask = t.transform.quadrants(mask)
+	t.transformed = false
+	t.drawCircleThick(centerX, centerY, radius, thickness, mask)
+	t.transformed = true
+}
+
+// transformedCircleFill draws DrawCircleFill through the transform.
+func (t *T8Go) transformedCircleFill(centerX, centerY, radius int16, mask DrawQuadrants) {
+	centerX, centerY = t.transform.Apply(centerX, centerY)
+	radius, mask = t.transform.length(radius), t.transform.quadrants(mask)
+	t.transformed = false
+	t.drawCircleFill(centerX, centerY, radius, mask)
+	t.transformed = true
+}
+
+// transformedEllipse draws DrawEllipse through the transform.
+func (t *T8Go) transformedEllipse(centerX, centerY, radiusX, radiusY int16, mask DrawQuadrants) {
+	if radiusX <= 0 || radiusY <= 0 {
+		return
+	}
+	centerX, centerY = t.transform.Apply(centerX, centerY)
+	radiusX, radiusY = t.transform.ellipse(radiusX, radiusY)
+	mask = t.transform.quadrants(mask)
+	t.transformed = false
+	t.drawEllipse(centerX, centerY, radiusX, radiusY, mask)
+	t.transformed = true
+}
+
+// transformedEllipseFill draws DrawEllipseFill through the transform.
+func (t *T8Go) transformedEllipseFill(centerX, centerY, radiusX, radiusY int16, mask DrawQuadrants) {
+	if radiusX <= 0 || radiusY <= 0 {
+		return
+	}
+	centerX, centerY = t.transform.Apply(centerX, centerY)
+	radiusX, radiusY = t.transform.ellipse(radiusX, radiusY)
+	mask = t.transform.quadrants(mask)
+	t.transformed = false
+	t.drawEllipseFill(centerX, centerY, radiusX, radiusY, mask)
+	t.transformed = true
+}
+
+// transformedArc draws DrawArc through the transform.
+func (t *T8Go) transformedArc(centerX, centerY, radius int16, angleStart, angleEnd uint8) {
+	centerX, centerY = t.transform.Apply(centerX, centerY)
+	radius = t.transform.length(radius)
+	angleStart, angleEnd = t.transform.angles(angleStart, angleEnd)
+	t.transformed = false
+	t.drawArc(centerX, centerY, radius, angleStart, angleEnd)
+	t.transformed = true
+}
+
+// transformedArcThick draws DrawArcThick through the transform.
+func (t *T8Go) transformedArcThick(centerX, centerY, radius, thickness int16, angleStart, angleEnd uint8) {
+	centerX, centerY = t.transform.Apply(centerX, centerY)
+	radius, thickness = t.transform.length(radius), t.transform.length(thickness)
+	angleStart, angleEnd = t.transform.angles(angleStart, angleEnd)
+	t.transformed = false
+	t.drawArcThick(centerX, centerY, radius, thickness, angleStart, angleEnd)
+	t.transformed = true
+}
+
+// transformedArcFill draws DrawArcFill through the transform.
+func (t *T8Go) transformedArcFill(centerX, centerY, radius int16, angleStart, angleEnd uint8) {
+	centerX, centerY = t.transform.Apply(centerX, centerY)
+	radius = t.transform.length(radius)
+	angleStart, angleEnd = t.transform.angles(angleStart, angleEnd)
+	t.transformed = false
+	t.drawArcFill(centerX, centerY, radius, angleStart, angleEnd)
+	t.transformed = true
+}
+
+// roundBoxPath calls corner with the corners of the outline of a rounded box
+// with the given bounds through the transform, in order around its edge.
+// Each rounded corner turns into straight segments, more for larger radii.
+func (t *T8Go) roundBoxPath(minX, minY, maxX, maxY, radius int32, corner func(x, y int32)) {
+	centers := [4][2]int32{
+		{maxX - radius, minY + radius},
+		{minX + radius, minY + radius},
+		{minX + radius, maxY - radius},
+		{maxX - radius, maxY - radius},
+	}
+	steps := min(1+isqrt32(uint32(t.transform.length(int16(radius)))), 16)
+	for quarter, center := range centers {
+		for step := int32(0); step <= steps; step++ {
+			angle := uint8(quarter*64) + uint8(step*64/steps)
+			x := int64(center[0])<<helpers.TrigShift + int64(radius)*int64(helpers.Cos256(angle))
+			y := int64(center[1])<<helpers.TrigShift - int64(radius)*int64(helpers.Sin256(angle))
+			corner(t.transform.point(x, y, helpers.TrigShift))
+		}
+	}
+}
+
+// fillQuadRing fills the image through the transform of the box with the
+// given bounds, leaving out the image of the box edge pixels further in.
+func (t *T8Go) fillQuadRing(minX, minY, maxX, maxY, edge int32) {
+	if len(t.holes) != len(t.rows) {
+		t.holes = make(scanlines, len(t.rows))
+	}
+
+	var outer, inner [4][2]int32
+	for i, corner := range [4][2]int32{{minX, minY}, {maxX, minY}, {maxX, maxY}, {minX, maxY}} {
+		outer[i][0], outer[i][1] = t.transform.pixel(corner[0], corner[1])
+	}
+	for i, corner := range [4][2]int32{{minX + edge, minY + edge}, {maxX - edge, minY + edge}, {maxX - edge, maxY - edge}, {minX + edge, maxY - edge}} {
+		inner[i][0], inner[i][1] = t.transform.pixel(corner[0], corner[1])
+	}
+
+	minRow, maxRow := t.rows.reset(
+		helpers.ClampInt16(min(outer[0][1], outer[1][1], outer[2][1], outer[3][1])),
+		helpers.ClampInt16(max(outer[0][1], outer[1][1], outer[2][1], outer[3][1])),
+	)
+	scanAddQuadToSpans(t.rows, outer[0][0], outer[0][1], outer[1][0], outer[1][1], outer[2][0], outer[2][1], outer[3][0], outer[3][1])
+	minHole, maxHole := t.holes.reset(
+		helpers.ClampInt16(min(inner[0][1], inner[1][1], inner[2][1], inner[3][1])),
+		helpers.ClampInt16(max(inner[0][1], inner[1][1], inner[2][1], inner[3][1])),
+	)
+	scanAddQuadToSpans(t.holes, inner[0][0], inner[0][1], inner[1][0], inner[1][1], inner[2][0], inner[2][1], inner[3][0], inner[3][1])
+
+	for y := minRow; y <= maxRow; y++ {
+		row := t.rows[y]
+		if !row.initialized {
+			continue
+		}
+		hole := t.holes[y]
+		if y < minHole || y > maxHole || !hole.initialized {
+			t.hspan(int32(row.minX), int32(row.maxX), int32(y))
+			continue
+		}
+		if hole.minX > row.minX {
+			t.hspan(int32(row.minX), int32(hole.minX)-1, int32(y))
+		}
+		if hole.maxX < row.maxX {
+			t.hspan(int32(hole.maxX)+1, int32(row.maxX), int32(y))
+		}
+	}
+}
+
+// * ----- Inverse mapping -----
+
+// unmap returns the position the inverse of m takes the center of the
+// display pixel (x, y) to, as numerators over the positive denominator det:
+// the source pixel is (floor(sx/det), floor(sy/det)). det is 0 when m
+// flattens shapes to a line.
+func (m *Transform) unmap(x, y int32) (sx, sy, det int64) {
+	det = int64(m.A)*int64(m.D) - int64(m.B)*int64(m.C)
+	u := int64(2*x+1)<<(fixed.Q16Shift-1) - int64(m.X)
+	v := int64(2*y+1)<<(fixed.Q16Shift-1) - int64(m.Y)
+	sx = int64(m.D)*u - int64(m.B)*v
+	sy = int64(m.A)*v - int64(m.C)*u
+	if det < 0 {
+		return -sx, -sy, -det
+	}
+	return sx, sy, det
+}
+
+// source returns the source pixel whose image holds the center of the
+// display pixel (x, y). m must not flatten shapes.
+func (m *Transform) source(x, y int32) (int32, int32) {
+	sx, sy, det := m.unmap(x, y)
+	return clampInt32(floorDiv64(sx, det)), clampInt32(floorDiv64(sy, det))
+}
+
+// floorDiv64 returns floor(a / b) for b > 0.
+func floorDiv64(a, b int64) int64 {
+	quotient := a / b
+	if a%b != 0 && a < 0 {
+		quotient--
+	}
+	return quotient
+}
+
+// mapBox calls run with the visible runs of display pixels, one per row,
+// whose centers the inverse of the transform takes into the inclusive box
+// (minX, minY)-(maxX, maxY) of source pixels. Boxes sharing an edge share no
+// display pixels, so shapes built from boxes, such as glyphs, draw every
+// pixel once.
+func (t *T8Go) mapBox(minX, minY, maxX, maxY int32, run func(y, startX, endX int32)) {
+	m := &t.transform
+	left, top := int32(math.MaxInt32), int32(math.MaxInt32)
+	right, bottom := int32(math.MinInt32), int32(math.MinInt32)
+	for _, corner := range [4][2]int32{{minX, minY}, {maxX + 1, minY}, {minX, maxY + 1}, {maxX + 1, maxY + 1}} {
+		cx, cy := m.corner(corner[0], corner[1])
+		left, top = min(left, cx), min(top, cy)
+		right, bottom = max(right, cx), max(bottom, cy)
+	}
+
+	// The box around the image, a pixel wider for rounding
+	boundsMinX, boundsMinY, boundsMaxX, boundsMaxY := t.bounds()
+	left, top = max(left-1, int32(boundsMinX)), max(top-1, int32(boundsMinY))
+	right, bottom = min(right, int32(boundsMaxX)), min(bottom, int32(boundsMaxY))
+
+	// Moving one pixel right moves the source position by a fixed step
+	stepX, stepY := int64(m.D)<<fixed.Q16Shift, -int64(m.C)<<fixed.Q16Shift
+	if int64(m.A)*int64(m.D) < int64(m.B)*int64(m.C) {
+		stepX, stepY = -stepX, -stepY
+	}
+
+	for y := top; y <= bottom; y++ {
+		sx, sy, det := m.unmap(left, y)
+		if det == 0 {
+			return
+		}
+		lowX, highX := int64(minX)*det, int64(maxX+1)*det
+		lowY, highY := int64(minY)*det, int64(maxY+1)*det
+		startX, endX := int32(0), int32(-1)
+		for x := left; x <= right; x++ {
+			if sx >= lowX && sx < highX && sy >= lowY && sy < highY {
+				if endX < startX {
+					startX = x
+				}
+				endX = x
+			}
+			sx, sy = sx+stepX, sy+stepY
+		}
+		if startX <= endX {
+			run(y, startX, endX)
+		}
+	}
+}
+
+// drawImage draws the width x height image with its top-left corner at (x,
+// y) through the transform: every display pixel whose center maps back into
+// the image takes the pixel under it, lit as reported by lit, and is drawn
+// like stamp. Unlit pixels are skipped unless opaque.
+func (t *T8Go) drawImage(x, y, width, height int32, opaque bool, lit func(col, row int32) bool) {
+	t.mapBox(x, y, x+width-1, y+height-1, func(py, startX, endX int32) {
+		for px := startX; px <= endX; px++ {
+			sx, sy := t.transform.source(px, py)
+			col, row := min(max(sx-x, 0), width-1), min(max(sy-y, 0), height-1)
+			if on := lit(col, row); on || opaque {
+				t.stamp(int16(px), int16(py), on)
+			}
+		}
+	})
+}
+
+// * ----- Outlines -----
+
+// add draws the edge from the latest corner to (x, y), which becomes the
+// latest corner.
+func (p *outlinePath) add(x, y int32) {
+	switch {
+	case p.corners == 0:
+		p.firstX, p.firstY = x, y
+	case x == p.lastX && y == p.lastY:
+		return
+	case p.corners == 1:
+		p.first = newLineSegment(p.lastX, p.lastY, x, y)
+		p.previous = p.first
+		p.t.drawSegment(&p.first, nil, nil)
+	default:
+		edge := newLineSegment(p.lastX, p.lastY, x, y)
+		p.t.drawSegment(&edge, &p.previous, &p.first)
+		p.previous = edge
+	}
+	p.lastX, p.lastY = x, y
+	p.corners++
+}
+
+// close draws the edge back to the first corner. An outline of one corner
+// draws a point.
+func (p *outlinePath) close() {
+	switch {
+	case p.corners == 1:
+		p.t.plot(p.firstX, p.firstY)
+	case p.lastX != p.firstX || p.lastY != p.firstY:
+		edge := newLineSegment(p.lastX, p.lastY, p.firstX, p.firstY)
+		p.t.drawSegment(&edge, &p.previous, &p.first)
+	}
+}
@@ -0,0 +1,36 @@
+package transition
+
+import (
+	"errors"
+
+	"github.com/redghc/t8go/anim"
+)
+
+// Direction defines the direction in which the incoming screen moves.
+type Direction uint8
+
+const (
+	DirectionLeft  Direction = iota // Incoming screen enters from the right edge
+	DirectionRight                  // Incoming screen enters from the left edge
+	DirectionUp                     // Incoming screen enters from the bottom edge
+	DirectionDown                   // Incoming screen enters from the top edge
+)
+
+// Renderer composes a single transition frame into dst from the outgoing (from)
+// and incoming (to) buffers. All buffers use the SSD1306-style page layout and
+// share the same dimensions. Progress ranges from 0 (only from) to anim.Unit (only to).
+type Renderer func(dst, from, to []byte, width, height uint16, progress uint16)
+
+// Transition plays a Renderer over a fixed number of frames.
+type Transition struct {
+	renderer Renderer    // Effect used to compose each frame
+	easing   anim.Easing // Progress curve (defaults to anim.Linear)
+	frames   uint16      // Total number of frames
+	frame    uint16      // Frames rendered so far
+	pages    []byte      // Page-layout from, to and output screens for other layouts (allocated on first use)
+}
+
+// Common errors returned by the transition package.
+var (
+	ErrBufferSize = errors.New("transition buffers do not match the display buffer size") // Buffer length mismatch
+)
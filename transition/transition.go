@@ -0,0 +1,255 @@
+// Package transition provides screen transition effects (wipes, slides and
+// dither-based dissolves) that blend two off-screen buffers over a number of
+// frames. Off-screen buffers can be rendered with the memory driver and any
+// t8go drawing context.
+package transition
+
+import (
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/anim"
+	"github.com/redghc/t8go/framebuf"
+)
+
+// New creates a transition that plays renderer over the given number of frames.
+// A frame count of zero is treated as a single frame (an instant cut).
+func New(renderer Renderer, frames uint16) *Transition {
+	if frames == 0 {
+		frames = 1
+	}
+
+	return &Transition{
+		renderer: renderer,
+		easing:   anim.Linear,
+		frames:   frames,
+	}
+}
+
+// SetEasing sets the progress curve used by the transition.
+func (tr *Transition) SetEasing(easing anim.Easing) {
+	if easing == nil {
+		easing = anim.Linear
+	}
+	tr.easing = easing
+}
+
+// Step renders the next frame of the transition into the display buffer.
+// Call Display on the context afterwards to show it. The from and to buffers
+// use the layout of the display buffer. Renderers work in the page layout, so
+// displays exposing another layout through t8go.IBufferInfo go through
+// page-layout copies of the screens and receive the frame through SetPixel.
+// Returns true while more frames remain, or an error if the buffer sizes differ.
+func (tr *Transition) Step(dst t8go.IDisplay, from, to []byte) (bool, error) {
+	buffer := dst.Buffer()
+	if len(from) != len(buffer) || len(to) != len(buffer) {
+		return false, ErrBufferSize
+	}
+	if tr.Done() {
+		return false, nil
+	}
+
+	tr.frame++
+	// Wipes cannot go past either end, so overshooting curves are clamped
+	eased := tr.easing(int16(uint32(tr.frame) * anim.Unit / uint32(tr.frames)))
+	progress := uint16(min(max(eased, 0), anim.Unit))
+
+	width, height := dst.Size()
+	layout := t8go.DirectBuffer(dst).Layout
+	if layout == t8go.LayoutPageMajor {
+		tr.renderer(buffer, from, to, width, height, progress)
+		return !tr.Done(), nil
+	}
+
+	w, h := int(width), int(height)
+	if size := framebuf.Size(w, h); len(tr.pages) != 3*size {
+		tr.pages = make([]byte, 3*size)
+	}
+	size := len(tr.pages) / 3
+	pageFrom, pageTo, pageOut := tr.pages[:size], tr.pages[size:2*size], tr.pages[2*size:]
+	source := framebuf.Buffer{Data: from, Width: w, Height: h, Layout: layout}
+	target := framebuf.Buffer{Data: to, Width: w, Height: h, Layout: layout}
+	for y := range h {
+		for x := range w {
+			setPixel(pageFrom, w, x, y, source.GetPixel(x, y))
+			setPixel(pageTo, w, x, y, target.GetPixel(x, y))
+		}
+	}
+
+	tr.renderer(pageOut, pageFrom, pageTo, width, height, progress)
+	for y := range h {
+		for x := range w {
+			dst.SetPixel(int16(x), int16(y), getPixel(pageOut, w, x, y))
+		}
+	}
+	return !tr.Done(), nil
+}
+
+// Done reports whether all frames have been rendered.
+func (tr *Transition) Done() bool {
+	return tr.frame >= tr.frames
+}
+
+// Reset rewinds the transition so it can be played again.
+func (tr *Transition) Reset() {
+	tr.frame = 0
+}
+
+// * ----- Renderers -----
+
+// Wipe reveals the incoming screen behind a moving edge, without moving either screen.
+func Wipe(direction Direction) Renderer {
+	return func(dst, from, to []byte, width, height uint16, progress uint16) {
+		w, h := int(width), int(height)
+		pages := (h + 7) / 8
+
+		switch direction {
+		case DirectionLeft, DirectionRight:
+			reveal := scale(w, progress)
+			for page := range pages {
+				row := page * w
+				for x := range w {
+					incoming := x < reveal
+					if direction == DirectionLeft {
+						incoming = x >= w-reveal
+					}
+					if incoming {
+						dst[row+x] = to[row+x]
+					} else {
+						dst[row+x] = from[row+x]
+					}
+				}
+			}
+
+		default:
+			reveal := scale(h, progress)
+			startY, endY := 0, reveal
+			if direction == DirectionUp {
+				startY, endY = h-reveal, h
+			}
+			for page := range pages {
+				mask := rowMask(page, startY, endY)
+				row := page * w
+				for x := range w {
+					dst[row+x] = from[row+x]&^mask | to[row+x]&mask
+				}
+			}
+		}
+	}
+}
+
+// Slide moves the incoming screen in while pushing the outgoing screen out.
+func Slide(direction Direction) Renderer {
+	return func(dst, from, to []byte, width, height uint16, progress uint16) {
+		w, h := int(width), int(height)
+		pages := (h + 7) / 8
+
+		switch direction {
+		case DirectionLeft, DirectionRight:
+			offset := scale(w, progress)
+			for page := range pages {
+				row := page * w
+				for x := range w {
+					switch {
+					case direction == DirectionLeft && x < w-offset:
+						dst[row+x] = from[row+x+offset]
+					case direction == DirectionLeft:
+						dst[row+x] = to[row+x-(w-offset)]
+					case x < offset:
+						dst[row+x] = to[row+x+w-offset]
+					default:
+						dst[row+x] = from[row+x-offset]
+					}
+				}
+			}
+
+		default:
+			offset := scale(h, progress)
+			for y := range h {
+				for x := range w {
+					var on bool
+					switch {
+					case direction == DirectionUp && y < h-offset:
+						on = getPixel(from, w, x, y+offset)
+					case direction == DirectionUp:
+						on = getPixel(to, w, x, y-(h-offset))
+					case y < offset:
+						on = getPixel(to, w, x, y+h-offset)
+					default:
+						on = getPixel(from, w, x, y-offset)
+					}
+					setPixel(dst, w, x, y, on)
+				}
+			}
+		}
+	}
+}
+
+// bayer4x4 is the ordered dither matrix used by Dissolve (values 0..15).
+var bayer4x4 = [4][4]uint8{
+	{0, 8, 2, 10},
+	{12, 4, 14, 6},
+	{3, 11, 1, 9},
+	{15, 7, 13, 5},
+}
+
+// Dissolve fades between the screens using a 4x4 ordered dither pattern.
+func Dissolve() Renderer {
+	return func(dst, from, to []byte, width, height uint16, progress uint16) {
+		w, h := int(width), int(height)
+		pages := (h + 7) / 8
+		level := uint8(scale(16, progress))
+
+		// A page byte covers 8 rows, so the dither pattern repeats twice per byte
+		// and only depends on the column.
+		var masks [4]uint8
+		for column := range 4 {
+			for bit := range 8 {
+				if bayer4x4[bit&3][column] < level {
+					masks[column] |= 1 << bit
+				}
+			}
+		}
+
+		for page := range pages {
+			row := page * w
+			for x := range w {
+				mask := masks[x&3]
+				dst[row+x] = from[row+x]&^mask | to[row+x]&mask
+			}
+		}
+	}
+}
+
+// * ----- Buffer helpers -----
+
+// scale maps progress (0..anim.Unit) onto 0..size.
+func scale(size int, progress uint16) int {
+	value := size * int(progress) / anim.Unit
+	return max(0, min(value, size))
+}
+
+// rowMask returns the bits of the given page that fall within rows [startY, endY).
+func rowMask(page, startY, endY int) uint8 {
+	var mask uint8
+	for bit := range 8 {
+		y := page*8 + bit
+		if y >= startY && y < endY {
+			mask |= 1 << bit
+		}
+	}
+	return mask
+}
+
+// getPixel reads a pixel from a page-layout buffer.
+func getPixel(buffer []byte, width, x, y int) bool {
+	return buffer[x+(y>>3)*width]&(1<<(y&7)) != 0
+}
+
+// setPixel writes a pixel into a page-layout buffer.
+func setPixel(buffer []byte, width, x, y int, on bool) {
+	index := x + (y>>3)*width
+	if on {
+		buffer[index] |= 1 << (y & 7)
+	} else {
+		buffer[index] &^= 1 << (y & 7)
+	}
+}
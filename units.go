@@ -0,0 +1,91 @@
+package t8go
+
+import "github.com/redghc/t8go/helpers"
+
+// DefaultPixelPitch is the pixel pitch in micrometers assumed for drivers
+// that do not report one: a 0.96" 128x64 SSD1306 OLED (about 149 DPI).
+const DefaultPixelPitch = 170
+
+// micrometersPerInch converts between pixel pitch and DPI.
+const micrometersPerInch = 25400
+
+// Units returns the converter between physical lengths and pixels for the
+// display, from the pixel pitch reported in Capabilities. Missing pitches
+// default to DefaultPixelPitch, and a missing vertical pitch to the
+// horizontal one (square pixels).
+func (t *T8Go) Units() Units {
+	caps := t.Capabilities()
+	pitchX, pitchY := caps.PixelPitchX, caps.PixelPitchY
+	if pitchX == 0 {
+		pitchX = pitchY
+	}
+	if pitchX == 0 {
+		pitchX = DefaultPixelPitch
+	}
+	if pitchY == 0 {
+		pitchY = pitchX
+	}
+	return Units{PitchX: pitchX, PitchY: pitchY}
+}
+
+// PitchFromDPI returns the pixel pitch in micrometers of a panel with the
+// given resolution in dots per inch, or 0 for a zero resolution. Use it to
+// configure drivers from a datasheet DPI.
+func PitchFromDPI(dpi uint16) uint16 {
+	if dpi == 0 {
+		return 0
+	}
+	return uint16((micrometersPerInch + uint32(dpi)/2) / uint32(dpi))
+}
+
+// DPI returns the horizontal and vertical resolution in dots per inch.
+// The conversion is symmetric, so PitchFromDPI converts pitches as well.
+func (u Units) DPI() (x, y uint16) {
+	return PitchFromDPI(u.PitchX), PitchFromDPI(u.PitchY)
+}
+
+// MMX returns the number of pixels closest to mm millimeters horizontally.
+func (u Units) MMX(mm int16) int16 {
+	return u.MicrometersX(int32(mm) * 1000)
+}
+
+// MMY returns the number of pixels closest to mm millimeters vertically.
+func (u Units) MMY(mm int16) int16 {
+	return u.MicrometersY(int32(mm) * 1000)
+}
+
+// MicrometersX returns the number of pixels closest to um micrometers
+// horizontally, for lengths with sub-millimeter precision.
+func (u Units) MicrometersX(um int32) int16 {
+	return toPixels(um, u.PitchX)
+}
+
+// MicrometersY returns the number of pixels closest to um micrometers
+// vertically, for lengths with sub-millimeter precision.
+func (u Units) MicrometersY(um int32) int16 {
+	return toPixels(um, u.PitchY)
+}
+
+// Rect converts a rectangle given in millimeters to pixels.
+func (u Units) Rect(x, y, width, height int16) Rect {
+	return Rect{X: u.MMX(x), Y: u.MMY(y), Width: u.MMX(width), Height: u.MMY(height)}
+}
+
+// ToMicrometers returns the physical size in micrometers of a distance of
+// dx by dy pixels.
+func (u Units) ToMicrometers(dx, dy int16) (x, y int32) {
+	return int32(dx) * int32(u.PitchX), int32(dy) * int32(u.PitchY)
+}
+
+// toPixels divides um by pitch rounding to the nearest pixel, saturating at
+// the int16 limits. A zero pitch uses DefaultPixelPitch.
+func toPixels(um int32, pitch uint16) int16 {
+	if pitch == 0 {
+		pitch = DefaultPixelPitch
+	}
+	half := int32(pitch) / 2
+	if um < 0 {
+		half = -half
+	}
+	return helpers.ClampInt16((um + half) / int32(pitch))
+}
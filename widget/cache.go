@@ -0,0 +1,132 @@
+package widget
+
+import (
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/framebuf"
+)
+
+// * ----- Cached -----
+
+// Bounds returns the area covered by the wrapped widget.
+func (c *Cached) Bounds() t8go.Rect {
+	if c.Widget == nil {
+		return t8go.Rect{}
+	}
+	return c.Widget.Bounds()
+}
+
+// Invalidate makes the next Draw rasterize the widget again, for changes its
+// StateKey does not reflect (or widgets without one).
+func (c *Cached) Invalidate() {
+	c.valid = false
+}
+
+// StateKey returns the key of the wrapped widget, so cached widgets can be
+// nested inside a cached container.
+func (c *Cached) StateKey() uint32 {
+	if stateful, ok := c.Widget.(IStateful); ok {
+		return stateful.StateKey()
+	}
+	return 0
+}
+
+// Draw copies the cached bitmap to the widget area, rasterizing the widget
+// into it first when the bitmap is stale. The widget is drawn with the font
+// of ctx on a cleared bitmap.
+func (c *Cached) Draw(ctx t8go.IDisplayDrawer) {
+	r := c.Bounds()
+	if r.Empty() {
+		return
+	}
+
+	font := ctx.GetFont()
+	if c.canvas == nil || c.canvas.bounds != r {
+		c.resize(r)
+	} else if c.valid && c.font == font && c.key == c.StateKey() {
+		ctx.DrawBuffer(r.X, r.Y, c.canvas.bitmap)
+		return
+	}
+
+	c.canvas.ClearBuffer()
+	c.ctx.SetFont(font)
+	c.Widget.Draw(c.ctx)
+	c.font, c.key, c.valid = font, c.StateKey(), true
+	ctx.DrawBuffer(r.X, r.Y, c.canvas.bitmap)
+}
+
+// resize creates the bitmap and drawing context for a widget covering r.
+func (c *Cached) resize(r t8go.Rect) {
+	size := framebuf.Size(int(r.Width), int(r.Height))
+	var data []byte
+	if c.canvas != nil && cap(c.canvas.bitmap.Data) >= size {
+		data = c.canvas.bitmap.Data[:size]
+	} else {
+		data = make([]byte, size)
+	}
+
+	c.canvas = &canvas{
+		bitmap: framebuf.Buffer{Data: data, Width: int(r.Width), Height: int(r.Height)},
+		bounds: r,
+	}
+	c.ctx = t8go.New(c.canvas)
+	c.valid = false
+}
+
+// * ----- canvas -----
+
+// Size reports the display as reaching the bottom-right corner of the cached
+// area, so widgets keep drawing in screen coordinates.
+func (c *canvas) Size() (width, height uint16) {
+	r := c.bounds
+	return uint16(max(int32(r.X)+int32(r.Width), 0)), uint16(max(int32(r.Y)+int32(r.Height), 0))
+}
+
+// BufferSize returns the size of the bitmap
+func (c *canvas) BufferSize() int {
+	return len(c.bitmap.Data)
+}
+
+// Buffer returns the bitmap
+func (c *canvas) Buffer() []byte {
+	return c.bitmap.Data
+}
+
+// ClearBuffer turns off every pixel of the bitmap
+func (c *canvas) ClearBuffer() {
+	clear(c.bitmap.Data)
+}
+
+// ClearDisplay turns off every pixel of the bitmap
+func (c *canvas) ClearDisplay() {
+	clear(c.bitmap.Data)
+}
+
+// Command does nothing, as there is no panel to configure
+func (c *canvas) Command(cmd byte) error {
+	return nil
+}
+
+// Display does nothing; the bitmap is copied to the screen by Cached
+func (c *canvas) Display() error {
+	return nil
+}
+
+// SetPixel sets the pixel at screen coordinates (x, y) in the bitmap
+func (c *canvas) SetPixel(x, y int16, on bool) {
+	c.bitmap.SetPixel(int(x)-int(c.bounds.X), int(y)-int(c.bounds.Y), on)
+}
+
+// GetPixel returns the pixel at screen coordinates (x, y) of the bitmap
+func (c *canvas) GetPixel(x, y int16) bool {
+	return c.bitmap.GetPixel(int(x)-int(c.bounds.X), int(y)-int(c.bounds.Y))
+}
+
+// DrawHSpan sets or clears a horizontal run of pixels starting at (x, y)
+func (c *canvas) DrawHSpan(x, y, length int16, on bool) {
+	c.bitmap.HSpan(int(x)-int(c.bounds.X), int(y)-int(c.bounds.Y), int(length), on)
+}
+
+// FillRect sets or clears a rectangle with its top-left corner at (x, y)
+func (c *canvas) FillRect(x, y, width, height int16, on bool) {
+	c.bitmap.FillRect(int(x)-int(c.bounds.X), int(y)-int(c.bounds.Y), int(width), int(height), on)
+}
@@ -0,0 +1,134 @@
+package widget
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/input"
+)
+
+// weekdayInitials holds the first letter of every weekday, Sunday first.
+const weekdayInitials = "SMTWTFS"
+
+// calendarRows is the number of week rows of a Calendar, enough for any month.
+const calendarRows = 6
+
+// * ----- Calendar -----
+
+// Bounds returns the area covered by the calendar.
+func (c *Calendar) Bounds() t8go.Rect {
+	return c.Rect
+}
+
+// StateKey combines the selected day and today. Marked days are not part of
+// it; invalidate a Cached calendar when they change.
+func (c *Calendar) StateKey() uint32 {
+	selected := uint32(c.Selected.Year())<<9 | uint32(c.Selected.YearDay())
+	today := uint32(c.Today.Year())<<9 | uint32(c.Today.YearDay())
+	return (2166136261^selected)*16777619 ^ today
+}
+
+// HandleInput moves the selection a day with Left, Right and every encoder
+// detent, a week with Up and Down, and calls OnSelect on Enter. Other events
+// are not consumed.
+func (c *Calendar) HandleInput(event input.Event) bool {
+	days := 0
+	switch event.Kind {
+	case input.KindRotate:
+		days = int(event.Delta)
+	case input.KindPress:
+		switch event.Key {
+		case input.KeyLeft:
+			days = -1
+		case input.KeyRight:
+			days = 1
+		case input.KeyUp:
+			days = -7
+		case input.KeyDown:
+			days = 7
+		case input.KeyEnter:
+			if c.OnSelect != nil {
+				c.OnSelect(c.Selected)
+			}
+			return true
+		default:
+			return false
+		}
+	default:
+		return false
+	}
+
+	c.Selected = c.Selected.AddDate(0, 0, days)
+	return true
+}
+
+// column returns the grid column of weekday.
+func (c *Calendar) column(weekday time.Weekday) int {
+	if c.MondayFirst {
+		return (int(weekday) + 6) % 7
+	}
+	return int(weekday)
+}
+
+// Draw clears the calendar and draws the title, the weekday initials and
+// the days of the selected month in a 7 x 6 grid.
+func (c *Calendar) Draw(ctx t8go.IDisplayDrawer) {
+	r := c.Rect
+	ctx.ClearRegion(r.X, r.Y, r.Width, r.Height)
+
+	font := c.Font
+	if font == nil {
+		font = ctx.GetFont()
+	} else {
+		ctx.PushState()
+		ctx.SetFont(font)
+		defer ctx.PopState()
+	}
+
+	cellWidth := r.Width / 7
+	cellHeight := (r.Height - 2*font.LineHeight) / calendarRows
+	if cellWidth <= 0 || cellHeight <= 0 {
+		return
+	}
+	right := r.X + r.Width
+
+	year, month, selected := c.Selected.Date()
+	title := append(c.label[:0], month.String()...)
+	title = strconv.AppendInt(append(title, ' '), int64(year), 10)
+	drawLine(ctx, font, title, r.X+(r.Width-lineWidth(font, title))/2, r.Y+font.Ascent, right)
+
+	baseline := r.Y + font.LineHeight + font.Ascent
+	for weekday := range time.Weekday(7) {
+		initial := append(c.label[:0], weekdayInitials[weekday])
+		x := r.X + int16(c.column(weekday))*cellWidth + (cellWidth-lineWidth(font, initial))/2
+		drawLine(ctx, font, initial, x, baseline, right)
+	}
+
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	offset := c.column(first.Weekday())
+	days := first.AddDate(0, 1, -1).Day()
+	top := r.Y + 2*font.LineHeight
+	textTop := (cellHeight - font.Ascent - font.Descent) / 2
+	for day := 1; day <= days; day++ {
+		cell := offset + day - 1
+		x := r.X + int16(cell%7)*cellWidth
+		y := top + int16(cell/7)*cellHeight
+
+		number := strconv.AppendInt(c.label[:0], int64(day), 10)
+		width := lineWidth(font, number)
+		textX := x + (cellWidth-width)/2
+		baseline := y + textTop + font.Ascent
+		drawLine(ctx, font, number, textX, baseline, right)
+
+		if day == selected {
+			ctx.DrawBox(x, y, cellWidth, cellHeight)
+		}
+		if todayYear, todayMonth, today := c.Today.Date(); today == day && todayMonth == month && todayYear == year {
+			ctx.DrawHLine(textX, baseline+1, width-1)
+		}
+		if c.Marked != nil && c.Marked(first.AddDate(0, 0, day-1)) {
+			ctx.DrawBoxFill(x+cellWidth-3, y+1, 2, 2)
+		}
+	}
+}
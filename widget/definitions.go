@@ -0,0 +1,338 @@
+package widget
+
+import (
+	"errors"
+	"time"
+
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/framebuf"
+	"github.com/redghc/t8go/input"
+)
+
+// IWidget is a drawable element of a screen.
+// Widgets redraw their whole area on every Draw call, so a screen can be
+// refreshed by drawing it again.
+type IWidget interface {
+	Bounds() t8go.Rect            // Bounds returns the screen area covered by the widget
+	Draw(ctx t8go.IDisplayDrawer) // Draw renders the widget with its current values
+}
+
+// IContainer is implemented by widgets that hold child widgets.
+type IContainer interface {
+	Add(child IWidget) // Add appends a child widget, drawn after the previous ones
+}
+
+// IStateful is implemented by widgets that can summarize what they show in a
+// key, so a Cached wrapper rasterizes them again only when the key changes.
+type IStateful interface {
+	StateKey() uint32 // StateKey returns a value that changes whenever the widget would draw differently
+}
+
+// ILineStore holds the scrollback of a TextScroller: the text of its lines,
+// not their pixels. Stores decide how many lines they keep and drop the
+// oldest ones when full; RingStore keeps them in RAM.
+type ILineStore interface {
+	Append(line []byte)                // Append adds a line after the newest one, dropping the oldest lines if needed
+	Len() int                          // Len returns the number of stored lines
+	Line(dst []byte, index int) []byte // Line appends the text of line index (0 = oldest) to dst and returns the result
+}
+
+// ValueFunc provides the current value of a bound widget, such as a progress bar.
+type ValueFunc func() int16
+
+// FlagFunc provides the current state of a bound on/off widget, such as an indicator.
+type FlagFunc func() bool
+
+// Shadow selects how a popup separates itself from the screen below it.
+type Shadow uint8
+
+const (
+	ShadowNone Shadow = iota // Nothing is drawn around the area
+	ShadowHalo               // A 1 pixel gap is cleared around the area
+	ShadowDrop               // A halo plus a dithered shadow below and to the right
+)
+
+// ----------
+
+// Panel groups child widgets and optionally draws a (rounded) border around
+// them. A panel with a Shadow is drawn as a popup: the shadow goes first and
+// the panel area is cleared, so it stands out from whatever is below.
+type Panel struct {
+	Rect     t8go.Rect       // Area covered by the panel
+	Border   bool            // Draw an outline around the panel
+	Radius   int16           // Corner radius of the border (0 for square corners)
+	Shadow   Shadow          // Separation from the screen below (ShadowNone keeps the panel transparent)
+	Skin     *t8go.NinePatch // Frame bitmap stretched over the panel instead of the border (nil if unused)
+	Children []IWidget       // Child widgets, drawn in order
+}
+
+// ProgressBar shows Value between Min and Max as a filled bar inside an outline.
+type ProgressBar struct {
+	Rect  t8go.Rect // Area covered by the bar, including the outline
+	Min   int16     // Value shown as an empty bar
+	Max   int16     // Value shown as a full bar
+	Value ValueFunc // Current value (nil shows Min)
+}
+
+// Indicator shows On as a filled (on) or outlined (off) circle, centered in its area.
+type Indicator struct {
+	Rect t8go.Rect // Area covered by the indicator
+	On   FlagFunc  // Current state (nil shows off)
+}
+
+// LinearGauge is a bar meter with a tick scale, for VU meters and tank
+// levels. The bar fills from the left (or from the bottom when Vertical)
+// towards Value between Min and Max; Targets are marked across the bar and,
+// with PeakHold, a marker stays at the highest recent value.
+type LinearGauge struct {
+	Rect     t8go.Rect     // Area covered by the gauge, including the scale
+	Min      int16         // Value shown as an empty bar
+	Max      int16         // Value shown as a full bar
+	Value    ValueFunc     // Current value (nil shows Min)
+	Vertical bool          // Fill from the bottom up, with the scale on the right
+	Ticks    int16         // Number of scale intervals, marked below (or right of) the bar (0 for no scale)
+	Targets  []int16       // Values marked across the bar
+	PeakHold time.Duration // How long the peak marker stays at the highest value (0 disables it)
+
+	peak     int16     // Highest value within the hold time
+	peakTime time.Time // When peak was reached
+	peaked   bool      // Whether peak holds a value
+}
+
+// Dial shows Value as a marker on a ring with the number in the center, and
+// changes it by Step for every encoder detent it receives through
+// HandleInput. With Wrap the value runs around from Max to Min and the
+// marker covers the whole ring (headings); otherwise it stops at the ends of
+// a 270 degree sweep (volume).
+type Dial struct {
+	Rect     t8go.Rect         // Area covered by the dial
+	Min      int16             // Lowest value
+	Max      int16             // Highest value
+	Step     int16             // Change per detent (0 selects 1)
+	Wrap     bool              // Run around from Max to Min instead of stopping
+	Value    int16             // Current value, changed by HandleInput
+	Font     *t8go.Font        // Readout font (default: the font of the drawing context)
+	OnChange func(value int16) // Called after input changed Value (optional)
+
+	readout [8]byte // Digits of the readout
+}
+
+// RoundGauge is a needle meter for round displays: a rim with Ticks scale
+// marks over a 270 degree sweep, a needle pointing at Value between Min and
+// Max and the value as text below the hub. It is drawn in the circle
+// inscribed in Rect, so on a round panel Rect is the whole screen.
+type RoundGauge struct {
+	Rect  t8go.Rect  // Square around the gauge face
+	Min   int16      // Value at the start of the sweep (bottom left)
+	Max   int16      // Value at the end of the sweep (bottom right)
+	Value ValueFunc  // Current value (nil shows Min)
+	Ticks int16      // Number of scale intervals marked on the rim (0 for no scale)
+	Font  *t8go.Font // Readout font (default: the font of the drawing context)
+
+	readout [8]byte // Digits of the readout
+}
+
+// AnalogClock is a clock face with hour marks on the rim and hour, minute
+// and optionally second hands. It is drawn in the circle inscribed in Rect,
+// so on a round panel Rect is the whole screen.
+type AnalogClock struct {
+	Rect    t8go.Rect        // Square around the clock face
+	Time    func() time.Time // Time shown (nil shows time.Now)
+	Seconds bool             // Draw the second hand
+}
+
+// TextField is a single-line editable text box with a blinking caret. It
+// takes key and text events through HandleInput, from a host keyboard or an
+// on-screen keyboard, and scrolls its content horizontally to keep the caret
+// visible. Printable ASCII characters are accepted.
+type TextField struct {
+	Rect      t8go.Rect         // Area covered by the field, including its outline
+	Font      *t8go.Font        // Text font (default: the font of the drawing context)
+	MaxLength int               // Maximum length of the text in bytes (0 for no limit)
+	Focused   bool              // Show the caret and accept input
+	Blink     time.Duration     // Time the caret stays on and off (0 selects 500 ms, negative keeps it on)
+	OnChange  func(text []byte) // Called after an edit (optional)
+	OnSubmit  func(text []byte) // Called on KeyEnter (optional)
+
+	text    []byte    // Current text
+	cursor  int       // Caret position, as an index into text
+	scroll  int16     // Pixels of text scrolled out on the left
+	blinked time.Time // When the caret last turned on
+	version uint32    // Changes with every edit and caret move
+}
+
+// Calendar shows the month of Selected as a grid of days under a title and
+// a row of weekday initials. The selected day is boxed, Today is underlined
+// and days reported by Marked get a dot. HandleInput moves the selection by
+// days (Left, Right, encoder) and weeks (Up, Down), across months as needed.
+type Calendar struct {
+	Rect        t8go.Rect                // Area covered by the calendar
+	Font        *t8go.Font               // Text font (default: the font of the drawing context)
+	Selected    time.Time                // Selected day; its month is shown (set before the first Draw)
+	Today       time.Time                // Day to underline (zero for none)
+	MondayFirst bool                     // Start weeks on Monday instead of Sunday
+	Marked      func(day time.Time) bool // Reports days with events, drawn with a dot (optional)
+	OnSelect    func(day time.Time)      // Called on KeyEnter with the selected day (optional)
+
+	label [16]byte // Text buffer for the title and day numbers
+}
+
+// TextScroller shows the newest lines of a scrollback, or older ones after
+// scrolling back, for log viewers and consoles. Only the text is stored, in
+// Store; visible lines are rendered again on every Draw.
+type TextScroller struct {
+	Rect  t8go.Rect  // Area covered by the scroller
+	Font  *t8go.Font // Text font (default: the font of the drawing context)
+	Store ILineStore // Scrollback holding the text (required)
+
+	offset  int    // Lines scrolled back from the newest one (0 = following new lines)
+	visible int    // Rows shown by the last Draw
+	version uint32 // Changes whenever the visible lines may change
+	partial []byte // Text written after the last newline
+	line    []byte // Line buffer reused by Draw
+}
+
+// ScrollText shows one line of text in Rect and, when the text is wider than
+// Rect, scrolls it sideways as a marquee, for song titles and network names
+// that do not fit. Each Draw advances the text by Speed pixels: it runs
+// around with Gap pixels before the next copy, or with Bounce it moves back
+// and forth between its ends. Text that fits is drawn still.
+type ScrollText struct {
+	Rect   t8go.Rect  // Area covered by the text
+	Font   *t8go.Font // Text font (default: the font of the drawing context)
+	Text   string     // Text to show; changing it restarts the scroll
+	Speed  int16      // Pixels advanced per Draw (0 selects 1)
+	Gap    int16      // Pixels between the end of the text and its next copy (0 selects a quarter of the width of Rect)
+	Bounce bool       // Scroll back and forth instead of running around
+	Pause  uint16     // Number of Draw calls the text rests at its start (and at its end with Bounce)
+
+	shown   string // Text scrolled by offset
+	offset  int16  // Pixels of text scrolled out on the left
+	back    bool   // Bouncing back towards the start
+	hold    uint16 // Draw calls left to rest
+	version uint32 // Changes whenever the text moves or changes
+}
+
+// Cached renders Widget into a bitmap of its own and copies the bitmap to the
+// screen on Draw, so unchanged widgets (text in particular) are not
+// rasterized again every frame. The widget is drawn again only after
+// Invalidate, when its bounds or the font of the context change, or when the
+// StateKey of an IStateful widget changes. The copy replaces every pixel of
+// the widget area, so the widget should not rely on what is drawn below it.
+type Cached struct {
+	Widget IWidget // Wrapped widget (required)
+
+	canvas *canvas             // Bitmap holding the last rendering (nil before the first Draw)
+	ctx    t8go.IDisplayDrawer // Context drawing into canvas
+	font   *t8go.Font          // Context font of the last rendering
+	key    uint32              // StateKey of the widget after the last rendering
+	valid  bool                // Whether canvas holds the current rendering
+}
+
+// canvas is an off-screen display holding the bitmap of a Cached widget. It
+// takes screen coordinates and stores the pixels inside bounds.
+type canvas struct {
+	bitmap framebuf.Buffer // Pixels of the area
+	bounds t8go.Rect       // Screen area stored in the bitmap
+}
+
+// RingStore is an ILineStore keeping up to a fixed number of lines and bytes
+// of text in preallocated ring buffers, so appending never allocates.
+type RingStore struct {
+	data  []byte    // Text of the stored lines, wrapping around
+	lines []lineRef // Stored lines, wrapping around
+	first int       // Index in lines of the oldest line
+	count int       // Number of stored lines
+	used  int       // Bytes of data in use
+	end   int       // Index in data after the newest line
+}
+
+// lineRef locates a line of a RingStore in its data.
+type lineRef struct {
+	start  uint16 // Index in data of the first byte
+	length uint16 // Length in bytes
+}
+
+// ----------
+
+// Spec is the declarative description of a widget and its children, as used
+// by Load. Positions are relative to the parent widget; the root is relative
+// to the display origin. Fields that do not apply to a widget type are ignored.
+type Spec struct {
+	Type     string  `json:"type"`                // Registered widget type ("panel", "progress", "gauge", "indicator", ...)
+	X        int16   `json:"x"`                   // Left edge relative to the parent
+	Y        int16   `json:"y"`                   // Top edge relative to the parent
+	Width    int16   `json:"width"`               // Width in pixels
+	Height   int16   `json:"height"`              // Height in pixels
+	Bind     string  `json:"bind,omitempty"`      // Name of the value provider in Bindings
+	Min      int16   `json:"min,omitempty"`       // Minimum value (progress, gauge, roundgauge)
+	Max      int16   `json:"max,omitempty"`       // Maximum value (progress, gauge, roundgauge)
+	Vertical bool    `json:"vertical,omitempty"`  // Fill from the bottom up (gauge)
+	Ticks    int16   `json:"ticks,omitempty"`     // Number of scale intervals (gauge, roundgauge)
+	Targets  []int16 `json:"targets,omitempty"`   // Marked values (gauge)
+	PeakHold uint16  `json:"peak_hold,omitempty"` // Peak hold time in milliseconds (gauge)
+	Step     int16   `json:"step,omitempty"`      // Change per encoder detent (dial)
+	Wrap     bool    `json:"wrap,omitempty"`      // Run around from max to min (dial)
+	Border   bool    `json:"border,omitempty"`    // Draw a border (panel)
+	Radius   int16   `json:"radius,omitempty"`    // Corner radius (panel)
+	Shadow   string  `json:"shadow,omitempty"`    // Popup shadow: "halo" or "drop" (panel)
+	Cached   bool    `json:"cached,omitempty"`    // Draw through a Cached bitmap
+	Children []Spec  `json:"children,omitempty"`  // Child widgets (containers only)
+}
+
+// Bindings holds the named value providers that Spec.Bind refers to.
+type Bindings struct {
+	Values map[string]ValueFunc // Numeric providers
+	Flags  map[string]FlagFunc  // On/off providers
+}
+
+// Factory builds a widget of a registered type from its spec. Bounds is the
+// absolute screen area of the widget. Children are added by Load afterwards.
+type Factory func(spec Spec, bounds t8go.Rect, bindings Bindings) (IWidget, error)
+
+// Common errors returned by the widget package.
+var (
+	ErrUnknownType    = errors.New("unknown widget type")              // Spec.Type is not registered
+	ErrUnknownBinding = errors.New("unknown binding")                  // Spec.Bind does not name a provider of the right kind
+	ErrNotContainer   = errors.New("widget type cannot have children") // Spec.Children set on a widget that is not an IContainer
+	ErrNilStore       = errors.New("text scroller has no line store")  // TextScroller.Store is nil
+	ErrUnknownShadow  = errors.New("unknown shadow")                   // Spec.Shadow is not "", "halo" or "drop"
+)
+
+var (
+	_ IWidget    = (*Panel)(nil)
+	_ IContainer = (*Panel)(nil)
+	_ IWidget    = (*ProgressBar)(nil)
+	_ IWidget    = (*Indicator)(nil)
+	_ IWidget    = (*LinearGauge)(nil)
+	_ IWidget    = (*TextScroller)(nil)
+	_ IWidget    = (*ScrollText)(nil)
+	_ IWidget    = (*Dial)(nil)
+	_ IWidget    = (*RoundGauge)(nil)
+	_ IWidget    = (*AnalogClock)(nil)
+	_ IWidget    = (*TextField)(nil)
+	_ IWidget    = (*Calendar)(nil)
+	_ IWidget    = (*Cached)(nil)
+
+	_ IStateful = (*Panel)(nil)
+	_ IStateful = (*ProgressBar)(nil)
+	_ IStateful = (*Indicator)(nil)
+	_ IStateful = (*LinearGauge)(nil)
+	_ IStateful = (*TextScroller)(nil)
+	_ IStateful = (*ScrollText)(nil)
+	_ IStateful = (*Dial)(nil)
+	_ IStateful = (*RoundGauge)(nil)
+	_ IStateful = (*AnalogClock)(nil)
+	_ IStateful = (*TextField)(nil)
+	_ IStateful = (*Calendar)(nil)
+
+	_ input.IHandler = (*Dial)(nil)
+	_ input.IHandler = (*TextField)(nil)
+	_ input.IHandler = (*Calendar)(nil)
+	_ IStateful      = (*Cached)(nil)
+
+	_ t8go.IDisplay    = (*canvas)(nil)
+	_ t8go.ISpanDrawer = (*canvas)(nil)
+
+	_ ILineStore = (*RingStore)(nil)
+)
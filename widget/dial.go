@@ -0,0 +1,109 @@
+package widget
+
+import (
+	"strconv"
+
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/helpers"
+	"github.com/redghc/t8go/input"
+)
+
+// Marker angles of a Dial without Wrap, in 0..255 units counterclockwise
+// from the right: the sweep starts at the bottom left and turns clockwise.
+const (
+	dialStart = 160 // 225 degrees
+	dialSweep = 192 // 270 degrees
+)
+
+// * ----- Dial -----
+
+// Bounds returns the area covered by the dial.
+func (d *Dial) Bounds() t8go.Rect {
+	return d.Rect
+}
+
+// StateKey returns the clamped value shown by the dial.
+func (d *Dial) StateKey() uint32 {
+	return uint32(uint16(d.value()))
+}
+
+// value returns Value clamped to Min..Max.
+func (d *Dial) value() int16 {
+	return min(max(d.Value, d.Min), d.Max)
+}
+
+// HandleInput turns the dial by Step for every detent of a KindRotate
+// event, wrapping around or stopping at the ends, and calls OnChange when
+// the value changed. Other events are not consumed.
+func (d *Dial) HandleInput(event input.Event) bool {
+	if event.Kind != input.KindRotate {
+		return false
+	}
+
+	step := int32(d.Step)
+	if step == 0 {
+		step = 1
+	}
+	value := int32(d.value()) + int32(event.Delta)*step
+	if d.Wrap {
+		span := int32(d.Max) - int32(d.Min) + 1
+		value = int32(d.Min) + ((value-int32(d.Min))%span+span)%span
+	} else {
+		value = min(max(value, int32(d.Min)), int32(d.Max))
+	}
+
+	if int16(value) != d.Value {
+		d.Value = int16(value)
+		if d.OnChange != nil {
+			d.OnChange(d.Value)
+		}
+	}
+	return true
+}
+
+// angle returns the direction of the marker for the current value.
+func (d *Dial) angle() uint8 {
+	span := int32(d.Max) - int32(d.Min)
+	if span <= 0 {
+		return 64 // Straight up
+	}
+
+	offset := int32(d.value()) - int32(d.Min)
+	if d.Wrap {
+		return uint8(64 - offset*256/(span+1))
+	}
+	return uint8(dialStart - offset*dialSweep/span)
+}
+
+// Draw clears the dial and draws the ring, a marker pointing at the current
+// value from the ring inwards, and the value centered as text.
+func (d *Dial) Draw(ctx t8go.IDisplayDrawer) {
+	r := d.Rect
+	ctx.ClearRegion(r.X, r.Y, r.Width, r.Height)
+	radius := (min(r.Width, r.Height) - 1) / 2
+	if radius <= 2 {
+		return
+	}
+
+	centerX, centerY := r.X+r.Width/2, r.Y+r.Height/2
+	ctx.DrawCircle(centerX, centerY, radius, t8go.DrawAll)
+
+	angle := d.angle()
+	length := max(radius/4, 2)
+	outerX, outerY := helpers.AngleEndpoint(centerX, centerY, radius-1, angle)
+	innerX, innerY := helpers.AngleEndpoint(centerX, centerY, radius-length, angle)
+	ctx.DrawLine(innerX, innerY, outerX, outerY)
+
+	font := d.Font
+	if font == nil {
+		font = ctx.GetFont()
+	} else {
+		ctx.PushState()
+		ctx.SetFont(font)
+		defer ctx.PopState()
+	}
+
+	text := strconv.AppendInt(d.readout[:0], int64(d.value()), 10)
+	x := centerX - lineWidth(font, text)/2
+	drawLine(ctx, font, text, x, centerY+font.Ascent/2, r.X+r.Width)
+}
@@ -0,0 +1,147 @@
+package widget
+
+import (
+	"time"
+
+	"github.com/redghc/t8go"
+)
+
+// gaugeScale is the depth in pixels of the tick scale of a LinearGauge,
+// including the gap to the bar.
+const gaugeScale = 3
+
+// * ----- LinearGauge -----
+
+// Bounds returns the area covered by the gauge.
+func (g *LinearGauge) Bounds() t8go.Rect {
+	return g.Rect
+}
+
+// StateKey combines the clamped value and the held peak.
+func (g *LinearGauge) StateKey() uint32 {
+	value := g.update()
+	return uint32(uint16(value)) | uint32(uint16(g.peak))<<16
+}
+
+// ResetPeak drops the held peak, so the marker restarts from the current value.
+func (g *LinearGauge) ResetPeak() {
+	g.peaked = false
+}
+
+// update reads the current value clamped to Min..Max (Min when unbound) and
+// moves the peak to it when it is higher or the hold time has passed.
+func (g *LinearGauge) update() int16 {
+	value := g.Min
+	if g.Value != nil {
+		value = min(max(g.Value(), g.Min), g.Max)
+	}
+
+	if g.PeakHold > 0 {
+		now := time.Now()
+		if !g.peaked || value >= g.peak || now.Sub(g.peakTime) >= g.PeakHold {
+			g.peak, g.peakTime, g.peaked = value, now, true
+		}
+	}
+	return value
+}
+
+// layout splits the gauge into the bar, with its outline, and the scale.
+func (g *LinearGauge) layout() (bar, scale t8go.Rect) {
+	bar = g.Rect
+	if g.Ticks <= 0 {
+		return bar, t8go.Rect{}
+	}
+
+	if g.Vertical {
+		bar.Width -= gaugeScale
+		scale = t8go.Rect{X: bar.X + bar.Width + 1, Y: bar.Y, Width: gaugeScale - 1, Height: bar.Height}
+	} else {
+		bar.Height -= gaugeScale
+		scale = t8go.Rect{X: bar.X, Y: bar.Y + bar.Height + 1, Width: bar.Width, Height: gaugeScale - 1}
+	}
+	return bar, scale
+}
+
+// Draw clears the gauge and draws the bar filled up to the current value,
+// the target and peak markers, and the tick scale. Markers inside the filled
+// part are drawn unlit, so they stay visible.
+func (g *LinearGauge) Draw(ctx t8go.IDisplayDrawer) {
+	r := g.Rect
+	ctx.ClearRegion(r.X, r.Y, r.Width, r.Height)
+	value := g.update()
+
+	bar, scale := g.layout()
+	if bar.Empty() {
+		return
+	}
+	ctx.DrawBox(bar.X, bar.Y, bar.Width, bar.Height)
+
+	inner := bar.Inset(2)
+	if inner.Empty() || g.Max <= g.Min {
+		return
+	}
+
+	length := inner.Width
+	if g.Vertical {
+		length = inner.Height
+	}
+	fill := g.offset(value, length)
+	if fill > 0 {
+		if g.Vertical {
+			ctx.DrawBoxFill(inner.X, inner.Y+inner.Height-fill, inner.Width, fill)
+		} else {
+			ctx.DrawBoxFill(inner.X, inner.Y, fill, inner.Height)
+		}
+	}
+
+	for _, target := range g.Targets {
+		at := min(g.offset(target, length), length-1)
+		g.marker(ctx, bar.Inset(1), inner, at, true)
+		g.marker(ctx, inner, inner, at, at >= fill)
+	}
+	if g.PeakHold > 0 && g.peak > value {
+		at := min(g.offset(g.peak, length), length-1)
+		g.marker(ctx, inner, inner, at, at >= fill)
+	}
+
+	if scale.Empty() {
+		return
+	}
+	for i := range g.Ticks + 1 {
+		at := int16(int32(i) * int32(length-1) / int32(g.Ticks))
+		depth := int16(1)
+		if i == 0 || i == g.Ticks || i*2 == g.Ticks {
+			depth = 2 // Ends and middle
+		}
+		g.marker(ctx, t8go.Rect{X: scale.X, Y: scale.Y, Width: min(depth, scale.Width), Height: min(depth, scale.Height)}, inner, at, true)
+	}
+}
+
+// offset returns how far into a bar of length pixels value reaches, with Min
+// at 0 and Max at length.
+func (g *LinearGauge) offset(value int16, length int16) int16 {
+	value = min(max(value, g.Min), g.Max)
+	return int16(int32(length) * (int32(value) - int32(g.Min)) / (int32(g.Max) - int32(g.Min)))
+}
+
+// marker draws a line across area at position at along the bar inside
+// inner, lit or unlit. Horizontal gauges get a vertical line and vertical
+// gauges, counting from the bottom, a horizontal one.
+func (g *LinearGauge) marker(ctx t8go.IDisplayDrawer, area, inner t8go.Rect, at int16, on bool) {
+	if g.Vertical {
+		y := inner.Y + inner.Height - 1 - at
+		if on {
+			ctx.DrawHLine(area.X, y, area.Width)
+		} else {
+			ctx.ClearRegion(area.X, y, area.Width, 1)
+		}
+		return
+	}
+
+	x := inner.X + at
+	if on {
+		ctx.DrawVLine(x, area.Y, area.Height)
+	} else {
+		ctx.ClearRegion(x, area.Y, 1, area.Height)
+	}
+}
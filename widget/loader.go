@@ -0,0 +1,202 @@
+package widget
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redghc/t8go"
+)
+
+// factories maps the registered widget types to their factories.
+var factories = map[string]Factory{
+	"panel":      newPanel,
+	"progress":   newProgressBar,
+	"gauge":      newLinearGauge,
+	"dial":       newDial,
+	"roundgauge": newRoundGauge,
+	"clock":      newAnalogClock,
+	"textfield":  newTextField,
+	"calendar":   newCalendar,
+	"indicator":  newIndicator,
+}
+
+// Register makes a widget type available to Load under name, replacing any
+// previous registration. It is meant to be called during initialization.
+func Register(name string, factory Factory) {
+	factories[name] = factory
+}
+
+// Load builds the widget tree described by spec, resolving Spec.Bind names
+// through bindings. Errors name the type of the widget that failed.
+func Load(spec Spec, bindings Bindings) (IWidget, error) {
+	return load(spec, t8go.Point{}, bindings)
+}
+
+// LoadJSON decodes a JSON widget description (see Spec for the field names)
+// and builds its widget tree with Load.
+func LoadJSON(data []byte, bindings Bindings) (IWidget, error) {
+	var spec Spec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, err
+	}
+	return Load(spec, bindings)
+}
+
+// load builds the widget of spec, placed relative to origin, and its children.
+func load(spec Spec, origin t8go.Point, bindings Bindings) (IWidget, error) {
+	factory, ok := factories[spec.Type]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownType, spec.Type)
+	}
+
+	bounds := t8go.Rect{X: spec.X, Y: spec.Y, Width: spec.Width, Height: spec.Height}.Add(origin)
+	widget, err := factory(spec, bounds, bindings)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", spec.Type, err)
+	}
+	if len(spec.Children) > 0 {
+		container, ok := widget.(IContainer)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrNotContainer, spec.Type)
+		}
+		for _, childSpec := range spec.Children {
+			child, err := load(childSpec, bounds.Min(), bindings)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", spec.Type, err)
+			}
+			container.Add(child)
+		}
+	}
+
+	if spec.Cached {
+		return &Cached{Widget: widget}, nil
+	}
+	return widget, nil
+}
+
+// value returns the numeric provider named by spec.Bind (nil when unbound).
+func (b Bindings) value(spec Spec) (ValueFunc, error) {
+	if spec.Bind == "" {
+		return nil, nil
+	}
+	provider, ok := b.Values[spec.Bind]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownBinding, spec.Bind)
+	}
+	return provider, nil
+}
+
+// flag returns the on/off provider named by spec.Bind (nil when unbound).
+func (b Bindings) flag(spec Spec) (FlagFunc, error) {
+	if spec.Bind == "" {
+		return nil, nil
+	}
+	provider, ok := b.Flags[spec.Bind]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownBinding, spec.Bind)
+	}
+	return provider, nil
+}
+
+// * ----- Factories -----
+
+// shadows maps the Spec.Shadow names to shadows.
+var shadows = map[string]Shadow{
+	"":     ShadowNone,
+	"halo": ShadowHalo,
+	"drop": ShadowDrop,
+}
+
+// newPanel builds a Panel from its spec.
+func newPanel(spec Spec, bounds t8go.Rect, _ Bindings) (IWidget, error) {
+	shadow, ok := shadows[spec.Shadow]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownShadow, spec.Shadow)
+	}
+	return &Panel{
+		Rect:     bounds,
+		Border:   spec.Border,
+		Radius:   spec.Radius,
+		Shadow:   shadow,
+		Children: make([]IWidget, 0, len(spec.Children)),
+	}, nil
+}
+
+// newProgressBar builds a ProgressBar from its spec. Max defaults to 100.
+func newProgressBar(spec Spec, bounds t8go.Rect, bindings Bindings) (IWidget, error) {
+	value, err := bindings.value(spec)
+	if err != nil {
+		return nil, err
+	}
+	if spec.Max == 0 && spec.Min == 0 {
+		spec.Max = 100
+	}
+	return &ProgressBar{Rect: bounds, Min: spec.Min, Max: spec.Max, Value: value}, nil
+}
+
+// newLinearGauge builds a LinearGauge from its spec. Max defaults to 100.
+func newLinearGauge(spec Spec, bounds t8go.Rect, bindings Bindings) (IWidget, error) {
+	value, err := bindings.value(spec)
+	if err != nil {
+		return nil, err
+	}
+	if spec.Max == 0 && spec.Min == 0 {
+		spec.Max = 100
+	}
+	return &LinearGauge{
+		Rect:     bounds,
+		Min:      spec.Min,
+		Max:      spec.Max,
+		Value:    value,
+		Vertical: spec.Vertical,
+		Ticks:    spec.Ticks,
+		Targets:  spec.Targets,
+		PeakHold: time.Duration(spec.PeakHold) * time.Millisecond,
+	}, nil
+}
+
+// newDial builds a Dial from its spec, starting at Min. Max defaults to 100.
+func newDial(spec Spec, bounds t8go.Rect, _ Bindings) (IWidget, error) {
+	if spec.Max == 0 && spec.Min == 0 {
+		spec.Max = 100
+	}
+	return &Dial{Rect: bounds, Min: spec.Min, Max: spec.Max, Step: spec.Step, Wrap: spec.Wrap, Value: spec.Min}, nil
+}
+
+// newRoundGauge builds a RoundGauge from its spec. Max defaults to 100.
+func newRoundGauge(spec Spec, bounds t8go.Rect, bindings Bindings) (IWidget, error) {
+	value, err := bindings.value(spec)
+	if err != nil {
+		return nil, err
+	}
+	if spec.Max == 0 && spec.Min == 0 {
+		spec.Max = 100
+	}
+	return &RoundGauge{Rect: bounds, Min: spec.Min, Max: spec.Max, Value: value, Ticks: spec.Ticks}, nil
+}
+
+// newAnalogClock builds an AnalogClock showing the current time.
+func newAnalogClock(_ Spec, bounds t8go.Rect, _ Bindings) (IWidget, error) {
+	return &AnalogClock{Rect: bounds}, nil
+}
+
+// newTextField builds an empty TextField from its spec.
+func newTextField(_ Spec, bounds t8go.Rect, _ Bindings) (IWidget, error) {
+	return &TextField{Rect: bounds}, nil
+}
+
+// newCalendar builds a Calendar from its spec, selecting the current day.
+func newCalendar(_ Spec, bounds t8go.Rect, _ Bindings) (IWidget, error) {
+	now := time.Now()
+	return &Calendar{Rect: bounds, Selected: now, Today: now}, nil
+}
+
+// newIndicator builds an Indicator from its spec.
+func newIndicator(spec Spec, bounds t8go.Rect, bindings Bindings) (IWidget, error) {
+	on, err := bindings.flag(spec)
+	if err != nil {
+		return nil, err
+	}
+	return &Indicator{Rect: bounds, On: on}, nil
+}
@@ -0,0 +1,186 @@
+package widget
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/helpers"
+)
+
+// * ----- Round layout -----
+
+// InscribedRect returns the largest square centered on center that fits in
+// the circle of radius, the area of a round display that rectangular
+// widgets can use in full.
+func InscribedRect(center t8go.Point, radius int16) t8go.Rect {
+	half := helpers.MulTrig(radius, helpers.Sin256(32)) // radius / √2
+	return t8go.Rect{X: center.X - half, Y: center.Y - half, Width: 2*half + 1, Height: 2*half + 1}
+}
+
+// RimRect places a width x height widget at angle (0..255 units, 0 = right,
+// 64 = up) as close to the rim of the circle of radius around center as its
+// corners allow, for placing labels and buttons around a round display.
+// Widgets too large for the circle are centered.
+func RimRect(center t8go.Point, radius int16, angle uint8, width, height int16) t8go.Rect {
+	// Start with the side facing the rim on the circle and move inwards
+	// until every corner is inside
+	cos := helpers.Abs(int32(helpers.Cos256(angle)))
+	sin := helpers.Abs(int32(helpers.Sin256(angle)))
+	reach := (cos*int32(width) + sin*int32(height)) >> (helpers.TrigShift + 1)
+	for distance := int32(radius) - reach; distance > 0; distance-- {
+		middle := t8go.PolarToScreen(center.X, center.Y, int16(distance), angle)
+		rect := t8go.Rect{X: middle.X - width/2, Y: middle.Y - height/2, Width: width, Height: height}
+		if insideCircle(rect, center, radius) {
+			return rect
+		}
+	}
+	return t8go.Rect{X: center.X - width/2, Y: center.Y - height/2, Width: width, Height: height}
+}
+
+// insideCircle reports whether every corner pixel of rect is inside the
+// circle of radius around center, rounded like t8go.SetClipCircle.
+func insideCircle(rect t8go.Rect, center t8go.Point, radius int16) bool {
+	limit := int32(radius)*int32(radius) + int32(radius)
+	for _, corner := range [4]t8go.Point{rect.Min(), rect.Max(), {X: rect.X, Y: rect.Max().Y}, {X: rect.Max().X, Y: rect.Y}} {
+		dx, dy := int32(corner.X)-int32(center.X), int32(corner.Y)-int32(center.Y)
+		if dx*dx+dy*dy > limit {
+			return false
+		}
+	}
+	return true
+}
+
+// face returns the center and radius of the circle inscribed in r.
+func face(r t8go.Rect) (centerX, centerY, radius int16) {
+	return r.X + r.Width/2, r.Y + r.Height/2, (min(r.Width, r.Height) - 1) / 2
+}
+
+// drawHand draws a line from the center of a face towards angle, length
+// pixels long including the center.
+func drawHand(ctx t8go.IDisplayDrawer, centerX, centerY, length int16, angle uint8) {
+	endX, endY := helpers.AngleEndpoint(centerX, centerY, length, angle)
+	ctx.DrawLine(centerX, centerY, endX, endY)
+}
+
+// drawRimTick draws a scale mark at angle from the rim of a face inwards,
+// length pixels long.
+func drawRimTick(ctx t8go.IDisplayDrawer, centerX, centerY, radius, length int16, angle uint8) {
+	outerX, outerY := helpers.AngleEndpoint(centerX, centerY, radius, angle)
+	innerX, innerY := helpers.AngleEndpoint(centerX, centerY, radius-length+1, angle)
+	ctx.DrawLine(innerX, innerY, outerX, outerY)
+}
+
+// * ----- RoundGauge -----
+
+// Bounds returns the area covered by the gauge.
+func (g *RoundGauge) Bounds() t8go.Rect {
+	return g.Rect
+}
+
+// StateKey returns the clamped value shown by the gauge.
+func (g *RoundGauge) StateKey() uint32 {
+	return uint32(uint16(g.value()))
+}
+
+// value returns the current value clamped to Min..Max, Min when unbound.
+func (g *RoundGauge) value() int16 {
+	if g.Value == nil {
+		return g.Min
+	}
+	return min(max(g.Value(), g.Min), g.Max)
+}
+
+// Draw clears the gauge and draws the rim, the scale marks, the needle with
+// its hub and the value as text.
+func (g *RoundGauge) Draw(ctx t8go.IDisplayDrawer) {
+	r := g.Rect
+	ctx.ClearRegion(r.X, r.Y, r.Width, r.Height)
+	centerX, centerY, radius := face(r)
+	if radius <= 4 {
+		return
+	}
+	ctx.DrawCircle(centerX, centerY, radius, t8go.DrawAll)
+
+	tick := max(radius/8, 2)
+	for i := int32(0); g.Ticks > 0 && i <= int32(g.Ticks); i++ {
+		drawRimTick(ctx, centerX, centerY, radius-2, tick, uint8(dialStart-i*dialSweep/int32(g.Ticks)))
+	}
+
+	angle := uint8(dialStart)
+	if span := int32(g.Max) - int32(g.Min); span > 0 {
+		angle = uint8(dialStart - (int32(g.value())-int32(g.Min))*dialSweep/span)
+	}
+	drawHand(ctx, centerX, centerY, radius-tick-2, angle)
+	ctx.DrawCircleFill(centerX, centerY, max(radius/12, 1), t8go.DrawAll)
+
+	font := g.Font
+	if font == nil {
+		font = ctx.GetFont()
+	} else {
+		ctx.PushState()
+		ctx.SetFont(font)
+		defer ctx.PopState()
+	}
+
+	text := strconv.AppendInt(g.readout[:0], int64(g.value()), 10)
+	x := centerX - lineWidth(font, text)/2
+	drawLine(ctx, font, text, x, centerY+radius/2+font.Ascent/2, r.X+r.Width)
+}
+
+// * ----- AnalogClock -----
+
+// Bounds returns the area covered by the clock.
+func (c *AnalogClock) Bounds() t8go.Rect {
+	return c.Rect
+}
+
+// StateKey returns the shown time in minutes, or in seconds with the second
+// hand, since the start of the day.
+func (c *AnalogClock) StateKey() uint32 {
+	now := c.now()
+	key := uint32(now.Hour()*60 + now.Minute())
+	if c.Seconds {
+		key = key*60 + uint32(now.Second())
+	}
+	return key
+}
+
+// now returns the time shown by the clock.
+func (c *AnalogClock) now() time.Time {
+	if c.Time == nil {
+		return time.Now()
+	}
+	return c.Time()
+}
+
+// Draw clears the clock and draws the rim with hour marks, longer at the
+// quarters, and the hands of the current time.
+func (c *AnalogClock) Draw(ctx t8go.IDisplayDrawer) {
+	r := c.Rect
+	ctx.ClearRegion(r.X, r.Y, r.Width, r.Height)
+	centerX, centerY, radius := face(r)
+	if radius <= 4 {
+		return
+	}
+	ctx.DrawCircle(centerX, centerY, radius, t8go.DrawAll)
+
+	tick := max(radius/10, 2)
+	for hour := range 12 {
+		length := tick
+		if hour%3 == 0 {
+			length *= 2
+		}
+		drawRimTick(ctx, centerX, centerY, radius-2, length, uint8(64-hour*256/12))
+	}
+
+	now := c.now()
+	seconds := int32(now.Minute()*60 + now.Second())
+	hours := int32(now.Hour()%12)*3600 + seconds
+	drawHand(ctx, centerX, centerY, radius/2, uint8(64-hours*256/43200))
+	drawHand(ctx, centerX, centerY, radius-tick-3, uint8(64-seconds*256/3600))
+	if c.Seconds {
+		drawHand(ctx, centerX, centerY, radius-3, uint8(64-int32(now.Second())*256/60))
+	}
+	ctx.DrawCircleFill(centerX, centerY, max(radius/16, 1), t8go.DrawAll)
+}
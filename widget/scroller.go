@@ -0,0 +1,273 @@
+package widget
+
+import (
+	"bytes"
+
+	"github.com/redghc/t8go"
+)
+
+// printable holds the printable ASCII characters, sliced per character so
+// lines are drawn without converting them to strings.
+const printable = " !\"#$%&'()*+,-./0123456789:;<=>?@ABCDEFGHIJKLMNOPQRSTUVWXYZ[\\]^_`abcdefghijklmnopqrstuvwxyz{|}~"
+
+// * ----- TextScroller -----
+
+// Bounds returns the area covered by the scroller.
+func (s *TextScroller) Bounds() t8go.Rect {
+	return s.Rect
+}
+
+// AddLine appends a line to the scrollback. When scrolled back, the view
+// stays on the same lines instead of following the new one.
+func (s *TextScroller) AddLine(line []byte) {
+	if s.Store == nil {
+		return
+	}
+	s.Store.Append(line)
+	s.version++
+	if s.offset > 0 {
+		s.offset++
+	}
+}
+
+// Write appends text to the scrollback, one line per newline, and keeps the
+// text after the last newline until its line is complete. It implements
+// io.Writer, so a scroller can be the output of a logger. Carriage returns
+// before newlines are dropped.
+func (s *TextScroller) Write(p []byte) (int, error) {
+	if s.Store == nil {
+		return 0, ErrNilStore
+	}
+
+	written := len(p)
+	for {
+		newline := bytes.IndexByte(p, '\n')
+		if newline < 0 {
+			s.partial = append(s.partial, p...)
+			return written, nil
+		}
+
+		line := p[:newline]
+		if len(s.partial) > 0 {
+			s.partial = append(s.partial, line...)
+			line = s.partial
+		}
+		s.AddLine(bytes.TrimSuffix(line, []byte{'\r'}))
+		s.partial = s.partial[:0]
+		p = p[newline+1:]
+	}
+}
+
+// ScrollUp shows lines older by n lines, as far as the scrollback goes, and
+// reports whether the view changed.
+func (s *TextScroller) ScrollUp(n int) bool {
+	return s.scrollTo(s.offset + n)
+}
+
+// ScrollDown shows lines newer by n lines and reports whether the view
+// changed. Reaching the newest line makes the view follow new lines again.
+func (s *TextScroller) ScrollDown(n int) bool {
+	return s.scrollTo(s.offset - n)
+}
+
+// ScrollToEnd shows the newest lines and follows new ones, and reports
+// whether the view changed.
+func (s *TextScroller) ScrollToEnd() bool {
+	return s.scrollTo(0)
+}
+
+// Offset returns how many lines the view is scrolled back from the newest
+// line; 0 means the view follows new lines.
+func (s *TextScroller) Offset() int {
+	return s.offset
+}
+
+// scrollTo sets the scroll offset, clamped to the scrollback with the number
+// of rows shown by the last Draw, and reports whether it changed.
+func (s *TextScroller) scrollTo(offset int) bool {
+	limit := 0
+	if s.Store != nil {
+		limit = max(s.Store.Len()-s.visible, 0)
+	}
+
+	offset = min(max(offset, 0), limit)
+	changed := offset != s.offset
+	if changed {
+		s.version++
+	}
+	s.offset = offset
+	return changed
+}
+
+// StateKey returns a counter advanced by every new line and scroll.
+func (s *TextScroller) StateKey() uint32 {
+	return s.version
+}
+
+// rows returns the number of lines of font that fit in the scroller.
+func (s *TextScroller) rows(font *t8go.Font) int {
+	if font.LineHeight <= 0 || s.Rect.Height <= 0 {
+		return 0
+	}
+	return int(s.Rect.Height / font.LineHeight)
+}
+
+// Draw clears the scroller and draws the visible lines, top to bottom.
+// Characters past the right edge are cut, and bytes outside printable ASCII
+// are skipped.
+func (s *TextScroller) Draw(ctx t8go.IDisplayDrawer) {
+	r := s.Rect
+	ctx.ClearRegion(r.X, r.Y, r.Width, r.Height)
+	if s.Store == nil {
+		return
+	}
+
+	font := s.Font
+	if font == nil {
+		font = ctx.GetFont()
+	} else {
+		ctx.PushState()
+		ctx.SetFont(font)
+		defer ctx.PopState()
+	}
+
+	rows := s.rows(font)
+	s.visible = rows
+	total := s.Store.Len()
+	s.offset = min(s.offset, max(total-rows, 0))
+	last := total - 1 - s.offset
+	first := max(last-rows+1, 0)
+
+	baseline := r.Y + font.Ascent
+	for index := first; index <= last; index++ {
+		s.line = s.Store.Line(s.line[:0], index)
+		drawLine(ctx, font, s.line, r.X, baseline, r.X+r.Width)
+		baseline += font.LineHeight
+	}
+}
+
+// drawLine draws the printable characters of line from x on baseline,
+// stopping at the first character that would cross right.
+func drawLine(ctx t8go.IDisplayDrawer, font *t8go.Font, line []byte, x, baseline, right int16) {
+	for _, char := range line {
+		if char == '\t' {
+			char = ' '
+		}
+		if char < ' ' || char > '~' {
+			continue
+		}
+
+		glyph, ok := font.Glyph(rune(char))
+		if !ok {
+			continue
+		}
+		advance := int16(glyph.Advance)
+		if x+advance > right {
+			return
+		}
+		index := char - ' '
+		ctx.DrawText(x, baseline, printable[index:index+1])
+		x += advance
+	}
+}
+
+// drawLineClipped draws the printable characters of line from x on baseline
+// like drawLine, but cuts the characters crossing the edges of clip instead
+// of leaving them out, so the line can scroll one pixel at a time.
+func drawLineClipped(ctx t8go.IDisplayDrawer, font *t8go.Font, line []byte, x, baseline int16, clip t8go.Rect) {
+	for _, char := range line {
+		if char == '\t' {
+			char = ' '
+		}
+		if char < ' ' || char > '~' {
+			continue
+		}
+
+		glyph, ok := font.Glyph(rune(char))
+		if !ok {
+			continue
+		}
+		if x >= clip.X+clip.Width {
+			return
+		}
+		advance := int16(glyph.Advance)
+		if x+advance > clip.X {
+			index := char - ' '
+			ctx.DrawTextClipped(x, baseline, printable[index:index+1], clip)
+		}
+		x += advance
+	}
+}
+
+// lineWidth returns the width in pixels of the printable characters of line.
+func lineWidth(font *t8go.Font, line []byte) int16 {
+	var width int16
+	for _, char := range line {
+		if glyph, ok := font.Glyph(rune(char)); ok && char >= ' ' && char <= '~' {
+			width += int16(glyph.Advance)
+		}
+	}
+	return width
+}
+
+// * ----- RingStore -----
+
+// NewRingStore creates a RingStore keeping at most lines lines and size bytes
+// of text; the oldest lines are dropped when either runs out. Both buffers
+// are allocated here, so appending never allocates.
+func NewRingStore(lines, size uint16) *RingStore {
+	return &RingStore{
+		data:  make([]byte, size),
+		lines: make([]lineRef, lines),
+	}
+}
+
+// Append adds a line after the newest one. Lines longer than the byte
+// capacity are cut to fit.
+func (s *RingStore) Append(line []byte) {
+	if len(s.lines) == 0 {
+		return
+	}
+	line = line[:min(len(line), len(s.data))]
+
+	for s.count > 0 && (s.count == len(s.lines) || s.used+len(line) > len(s.data)) {
+		s.used -= int(s.lines[s.first].length)
+		s.first = (s.first + 1) % len(s.lines)
+		s.count--
+	}
+
+	start := s.end
+	if len(s.data) > 0 {
+		copied := copy(s.data[start:], line)
+		copy(s.data, line[copied:])
+		s.end = (start + len(line)) % len(s.data)
+	}
+	s.lines[(s.first+s.count)%len(s.lines)] = lineRef{start: uint16(start), length: uint16(len(line))}
+	s.count++
+	s.used += len(line)
+}
+
+// Len returns the number of stored lines.
+func (s *RingStore) Len() int {
+	return s.count
+}
+
+// Line appends the text of line index (0 = oldest) to dst and returns the
+// result. Indexes outside the store append nothing.
+func (s *RingStore) Line(dst []byte, index int) []byte {
+	if index < 0 || index >= s.count {
+		return dst
+	}
+	ref := s.lines[(s.first+index)%len(s.lines)]
+	start, end := int(ref.start), int(ref.start)+int(ref.length)
+	if end <= len(s.data) {
+		return append(dst, s.data[start:end]...)
+	}
+	dst = append(dst, s.data[start:]...)
+	return append(dst, s.data[:end-len(s.data)]...)
+}
+
+// Reset drops every stored line.
+func (s *RingStore) Reset() {
+	s.first, s.count, s.used, s.end = 0, 0, 0, 0
+}
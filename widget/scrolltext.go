@@ -0,0 +1,110 @@
+package widget
+
+import "github.com/redghc/t8go"
+
+// * ----- ScrollText -----
+
+// Bounds returns the area covered by the text.
+func (s *ScrollText) Bounds() t8go.Rect {
+	return s.Rect
+}
+
+// Offset returns how many pixels of the text are scrolled out on the left.
+func (s *ScrollText) Offset() int16 {
+	return s.offset
+}
+
+// Reset moves the text back to its start, where it rests for Pause draws.
+func (s *ScrollText) Reset() {
+	s.offset, s.back, s.hold = 0, false, s.Pause
+	s.version++
+}
+
+// StateKey changes whenever the text moves or changes.
+func (s *ScrollText) StateKey() uint32 {
+	return s.version
+}
+
+// Draw clears the area, draws the text at its current offset and advances
+// the offset for the next call. Characters crossing the edges of Rect are
+// cut, so the text slides in and out one pixel at a time.
+func (s *ScrollText) Draw(ctx t8go.IDisplayDrawer) {
+	r := s.Rect
+	ctx.ClearRegion(r.X, r.Y, r.Width, r.Height)
+
+	font := s.Font
+	if font == nil {
+		font = ctx.GetFont()
+	} else {
+		ctx.PushState()
+		ctx.SetFont(font)
+		defer ctx.PopState()
+	}
+
+	if s.Text != s.shown {
+		s.shown = s.Text
+		s.Reset()
+	}
+
+	width, _ := ctx.MeasureText(s.Text)
+	baseline := r.Y + (r.Height-font.Ascent-font.Descent)/2 + font.Ascent
+	if width <= r.Width {
+		if s.offset != 0 {
+			s.Reset()
+		}
+		ctx.DrawTextClipped(r.X, baseline, s.Text, r)
+		return
+	}
+
+	x := r.X - s.offset
+	ctx.DrawTextClipped(x, baseline, s.Text, r)
+	if !s.Bounce {
+		if next := x + width + s.gap(); next < r.X+r.Width {
+			ctx.DrawTextClipped(next, baseline, s.Text, r)
+		}
+	}
+	s.advance(width)
+}
+
+// gap returns the space between copies of running text.
+func (s *ScrollText) gap() int16 {
+	if s.Gap > 0 {
+		return s.Gap
+	}
+	return max(s.Rect.Width/4, 1)
+}
+
+// advance moves the text by Speed pixels, unless it is resting at an end.
+// Running text starts over once a full copy and its gap have passed;
+// bouncing text turns around at either end.
+func (s *ScrollText) advance(width int16) {
+	if s.hold > 0 {
+		s.hold--
+		return
+	}
+	speed := max(s.Speed, 1)
+	s.version++
+
+	if !s.Bounce {
+		s.offset += speed
+		if period := width + s.gap(); s.offset >= period {
+			s.offset -= period
+			if s.Pause > 0 {
+				s.offset, s.hold = 0, s.Pause
+			}
+		}
+		return
+	}
+
+	limit := width - s.Rect.Width
+	if s.back {
+		s.offset -= speed
+	} else {
+		s.offset += speed
+	}
+	if s.offset >= limit || s.offset <= 0 {
+		s.offset = min(max(s.offset, 0), limit)
+		s.back = s.offset > 0
+		s.hold = s.Pause
+	}
+}
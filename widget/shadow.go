@@ -0,0 +1,57 @@
+package widget
+
+import "github.com/redghc/t8go"
+
+// shadowDepth is the distance in pixels a ShadowDrop shadow falls below and
+// to the right of the halo.
+const shadowDepth = 2
+
+// * ----- Shadows -----
+
+// DrawShadow separates the area r from the screen below before a popup is
+// drawn into it: ShadowHalo clears a 1 pixel gap around r, and ShadowDrop
+// adds a checkered shadow outside the gap, below and to the right. The inside
+// of r is left untouched. Call it before clearing and drawing the popup.
+func DrawShadow(ctx t8go.IDisplayDrawer, r t8go.Rect, shadow Shadow) {
+	if shadow == ShadowNone || r.Empty() {
+		return
+	}
+
+	halo := r.Inset(-1)
+	ctx.ClearRegion(halo.X, halo.Y, halo.Width, r.Y-halo.Y)
+	ctx.ClearRegion(halo.X, r.Y+r.Height, halo.Width, 1)
+	ctx.ClearRegion(halo.X, r.Y, 1, r.Height)
+	ctx.ClearRegion(r.X+r.Width, r.Y, 1, r.Height)
+	if shadow != ShadowDrop {
+		return
+	}
+
+	right, bottom := halo.X+halo.Width, halo.Y+halo.Height
+	checker(ctx, t8go.Rect{X: right, Y: halo.Y + shadowDepth, Width: shadowDepth, Height: halo.Height})
+	checker(ctx, t8go.Rect{X: halo.X + shadowDepth, Y: bottom, Width: halo.Width - shadowDepth, Height: shadowDepth})
+}
+
+// ShadowBounds returns the area covered by r together with its shadow.
+func ShadowBounds(r t8go.Rect, shadow Shadow) t8go.Rect {
+	switch shadow {
+	case ShadowHalo:
+		return r.Inset(-1)
+	case ShadowDrop:
+		bounds := r.Inset(-1)
+		bounds.Width += shadowDepth
+		bounds.Height += shadowDepth
+		return bounds
+	}
+	return r
+}
+
+// checker fills r with a 50% checkerboard, replacing what was below, so the
+// shadow reads the same on empty and busy backgrounds.
+func checker(ctx t8go.IDisplayDrawer, r t8go.Rect) {
+	r = r.Intersect(ctx.Bounds())
+	for y := r.Y; y < r.Y+r.Height; y++ {
+		for x := r.X; x < r.X+r.Width; x++ {
+			ctx.SetPixel(x, y, (x+y)&1 == 0)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package widget
+
+import (
+	"time"
+
+	"github.com/redghc/t8go"
+	"github.com/redghc/t8go/input"
+)
+
+// defaultBlink is the caret blink time of a TextField with a zero Blink.
+const defaultBlink = 500 * time.Millisecond
+
+// * ----- TextField -----
+
+// Bounds returns the area covered by the field.
+func (f *TextField) Bounds() t8go.Rect {
+	return f.Rect
+}
+
+// Text returns the current text. The slice is only valid until the next edit.
+func (f *TextField) Text() []byte {
+	return f.text
+}
+
+// SetText replaces the text, cut to MaxLength, and moves the caret to its
+// end. Characters outside printable ASCII are dropped. OnChange is not called.
+func (f *TextField) SetText(text string) {
+	f.text = f.text[:0]
+	for i := range len(text) {
+		if printableByte(text[i]) && (f.MaxLength <= 0 || len(f.text) < f.MaxLength) {
+			f.text = append(f.text, text[i])
+		}
+	}
+	f.cursor = len(f.text)
+	f.touch()
+}
+
+// Cursor returns the caret position as an index into Text.
+func (f *TextField) Cursor() int {
+	return f.cursor
+}
+
+// StateKey changes with every edit, caret move, focus change and caret blink.
+func (f *TextField) StateKey() uint32 {
+	key := f.version << 2
+	if f.Focused {
+		key |= 2
+	}
+	if f.caretOn() {
+		key |= 1
+	}
+	return key
+}
+
+// HandleInput edits the text of a focused field and reports whether the
+// event was consumed: KindText inserts its character at the caret, KindRotate
+// moves the caret, and the keys move it (Left, Right, Home, End), delete
+// (Backspace, Delete) or submit (Enter). Unfocused fields ignore all events.
+func (f *TextField) HandleInput(event input.Event) bool {
+	if !f.Focused {
+		return false
+	}
+
+	switch event.Kind {
+	case input.KindText:
+		if event.Rune > 0x7F || !printableByte(byte(event.Rune)) {
+			return false
+		}
+		if f.MaxLength <= 0 || len(f.text) < f.MaxLength {
+			f.text = append(f.text, 0)
+			copy(f.text[f.cursor+1:], f.text[f.cursor:])
+			f.text[f.cursor] = byte(event.Rune)
+			f.cursor++
+			f.changed()
+		}
+		return true
+	case input.KindRotate:
+		f.moveTo(f.cursor + int(event.Delta))
+		return true
+	case input.KindPress:
+		return f.press(event.Key)
+	}
+	return false
+}
+
+// press handles a pressed key and reports whether it was consumed.
+func (f *TextField) press(key input.Key) bool {
+	switch key {
+	case input.KeyLeft:
+		f.moveTo(f.cursor - 1)
+	case input.KeyRight:
+		f.moveTo(f.cursor + 1)
+	case input.KeyHome:
+		f.moveTo(0)
+	case input.KeyEnd:
+		f.moveTo(len(f.text))
+	case input.KeyBackspace:
+		if f.cursor > 0 {
+			f.text = append(f.text[:f.cursor-1], f.text[f.cursor:]...)
+			f.cursor--
+			f.changed()
+		}
+	case input.KeyDelete:
+		if f.cursor < len(f.text) {
+			f.text = append(f.text[:f.cursor], f.text[f.cursor+1:]...)
+			f.changed()
+		}
+	case input.KeyEnter:
+		if f.OnSubmit != nil {
+			f.OnSubmit(f.text)
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// moveTo places the caret at position, clamped to the text.
+func (f *TextField) moveTo(position int) {
+	f.cursor = min(max(position, 0), len(f.text))
+	f.touch()
+}
+
+// changed records an edit and calls OnChange.
+func (f *TextField) changed() {
+	f.touch()
+	if f.OnChange != nil {
+		f.OnChange(f.text)
+	}
+}
+
+// touch makes the caret visible again and marks the field for redrawing.
+func (f *TextField) touch() {
+	f.blinked = time.Now()
+	f.version++
+}
+
+// caretOn reports whether the caret is shown at this moment.
+func (f *TextField) caretOn() bool {
+	if !f.Focused {
+		return false
+	}
+	blink := f.Blink
+	if blink == 0 {
+		blink = defaultBlink
+	}
+	return blink < 0 || time.Since(f.blinked)/blink%2 == 0
+}
+
+// Draw clears the field and draws its outline, the visible part of the text
+// and, when focused, the caret. The text scrolls horizontally so the caret
+// stays inside the field; characters crossing its edges are drawn in part.
+func (f *TextField) Draw(ctx t8go.IDisplayDrawer) {
+	r := f.Rect
+	ctx.ClearRegion(r.X, r.Y, r.Width, r.Height)
+	ctx.DrawBox(r.X, r.Y, r.Width, r.Height)
+	inner := r.Inset(2)
+	if inner.Empty() {
+		return
+	}
+
+	font := f.Font
+	if font == nil {
+		font = ctx.GetFont()
+	} else {
+		ctx.PushState()
+		ctx.SetFont(font)
+		defer ctx.PopState()
+	}
+
+	// Scroll just enough to keep the caret inside the field. The caret is
+	// drawn in the spacing column left of the character it precedes, which
+	// at the start is the padding inside the outline.
+	caretX := lineWidth(font, f.text[:f.cursor])
+	total := lineWidth(font, f.text)
+	f.scroll = min(f.scroll, max(total-inner.Width, 0))
+	f.scroll = min(max(f.scroll, caretX-inner.Width), caretX)
+
+	baseline := inner.Y + (inner.Height-font.Ascent-font.Descent)/2 + font.Ascent
+	drawLineClipped(ctx, font, f.text, inner.X-f.scroll, baseline, inner)
+
+	if f.caretOn() {
+		ctx.DrawVLine(inner.X+caretX-f.scroll-1, baseline-font.Ascent, font.Ascent+font.Descent)
+	}
+}
+
+// printableByte reports whether char is a printable ASCII character.
+func printableByte(char byte) bool {
+	return char >= ' ' && char <= '~'
+}
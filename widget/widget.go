@@ -0,0 +1,119 @@
+// Package widget provides simple screen widgets for t8go and a loader that
+// builds widget trees from declarative descriptions (Go structs or JSON), so
+// layouts can change without touching render code.
+package widget
+
+import "github.com/redghc/t8go"
+
+// * ----- Panel -----
+
+// Bounds returns the area covered by the panel, including its shadow.
+func (p *Panel) Bounds() t8go.Rect {
+	return ShadowBounds(p.Rect, p.Shadow)
+}
+
+// Add appends a child widget.
+func (p *Panel) Add(child IWidget) {
+	p.Children = append(p.Children, child)
+}
+
+// StateKey combines the keys of the IStateful children; the others do not
+// take part in it.
+func (p *Panel) StateKey() uint32 {
+	key := uint32(2166136261)
+	for _, child := range p.Children {
+		if stateful, ok := child.(IStateful); ok {
+			key = (key ^ stateful.StateKey()) * 16777619
+		}
+	}
+	return key
+}
+
+// Draw draws the shadow and clears the panel (for popups), draws the skin or
+// the border (if enabled) and then every child in order.
+func (p *Panel) Draw(ctx t8go.IDisplayDrawer) {
+	if p.Shadow != ShadowNone {
+		DrawShadow(ctx, p.Rect, p.Shadow)
+		ctx.ClearRegion(p.Rect.X, p.Rect.Y, p.Rect.Width, p.Rect.Height)
+	}
+	if p.Skin != nil {
+		ctx.DrawNinePatch(p.Rect.X, p.Rect.Y, p.Rect.Width, p.Rect.Height, *p.Skin)
+	} else if p.Border {
+		ctx.DrawRoundBox(p.Rect.X, p.Rect.Y, p.Rect.Width, p.Rect.Height, p.Radius)
+	}
+	for _, child := range p.Children {
+		child.Draw(ctx)
+	}
+}
+
+// * ----- ProgressBar -----
+
+// Bounds returns the area covered by the bar.
+func (b *ProgressBar) Bounds() t8go.Rect {
+	return b.Rect
+}
+
+// StateKey returns the clamped value shown by the bar.
+func (b *ProgressBar) StateKey() uint32 {
+	return uint32(uint16(b.value()))
+}
+
+// value returns the current value clamped to Min..Max (Min when unbound).
+func (b *ProgressBar) value() int16 {
+	if b.Value == nil {
+		return b.Min
+	}
+	return min(max(b.Value(), b.Min), b.Max)
+}
+
+// Draw draws the outline and a bar proportional to the current value, which
+// is clamped to Min..Max. The inside is cleared first, so the bar can shrink.
+func (b *ProgressBar) Draw(ctx t8go.IDisplayDrawer) {
+	r := b.Rect
+	ctx.DrawBox(r.X, r.Y, r.Width, r.Height)
+
+	inside := r.Inset(1)
+	ctx.ClearRegion(inside.X, inside.Y, inside.Width, inside.Height)
+
+	bar := r.Inset(2)
+	if bar.Empty() || b.Max <= b.Min {
+		return
+	}
+
+	width := int32(bar.Width) * (int32(b.value()) - int32(b.Min)) / (int32(b.Max) - int32(b.Min))
+	if width > 0 {
+		ctx.DrawBoxFill(bar.X, bar.Y, int16(width), bar.Height)
+	}
+}
+
+// * ----- Indicator -----
+
+// Bounds returns the area covered by the indicator.
+func (i *Indicator) Bounds() t8go.Rect {
+	return i.Rect
+}
+
+// StateKey returns 1 when the indicator is on and 0 when off.
+func (i *Indicator) StateKey() uint32 {
+	if i.On != nil && i.On() {
+		return 1
+	}
+	return 0
+}
+
+// Draw draws a filled circle when the indicator is on and an outlined one when off.
+func (i *Indicator) Draw(ctx t8go.IDisplayDrawer) {
+	r := i.Rect
+	radius := (min(r.Width, r.Height) - 1) / 2
+	if radius <= 0 {
+		return
+	}
+
+	centerX, centerY := r.X+r.Width/2, r.Y+r.Height/2
+	if i.StateKey() == 1 {
+		ctx.DrawCircleFill(centerX, centerY, radius, t8go.DrawAll)
+		return
+	}
+	ctx.ClearRegion(r.X, r.Y, r.Width, r.Height)
+	ctx.DrawCircle(centerX, centerY, radius, t8go.DrawAll)
+}